@@ -32,3 +32,7 @@ func Convert_v1beta1_OrganizationSpec_To_v1alpha1_OrganizationSpec(in *garmopera
 
 	return autoConvert_v1beta1_OrganizationSpec_To_v1alpha1_OrganizationSpec(in, out, s)
 }
+
+func Convert_v1beta1_OrganizationStatus_To_v1alpha1_OrganizationStatus(in *garmoperatorv1beta1.OrganizationStatus, out *OrganizationStatus, s apiconversion.Scope) error {
+	return autoConvert_v1beta1_OrganizationStatus_To_v1alpha1_OrganizationStatus(in, out, s)
+}
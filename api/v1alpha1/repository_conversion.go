@@ -34,3 +34,7 @@ func Convert_v1beta1_RepositorySpec_To_v1alpha1_RepositorySpec(in *v1beta1.Repos
 
 	return autoConvert_v1beta1_RepositorySpec_To_v1alpha1_RepositorySpec(in, out, s)
 }
+
+func Convert_v1beta1_RepositoryStatus_To_v1alpha1_RepositoryStatus(in *v1beta1.RepositoryStatus, out *RepositoryStatus, s apiconversion.Scope) error {
+	return autoConvert_v1beta1_RepositoryStatus_To_v1alpha1_RepositoryStatus(in, out, s)
+}
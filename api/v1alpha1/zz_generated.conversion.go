@@ -120,11 +120,6 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1beta1.OrganizationStatus)(nil), (*OrganizationStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta1_OrganizationStatus_To_v1alpha1_OrganizationStatus(a.(*v1beta1.OrganizationStatus), b.(*OrganizationStatus), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*Pool)(nil), (*v1beta1.Pool)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_Pool_To_v1beta1_Pool(a.(*Pool), b.(*v1beta1.Pool), scope)
 	}); err != nil {
@@ -150,21 +145,11 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1beta1.PoolSpec)(nil), (*PoolSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta1_PoolSpec_To_v1alpha1_PoolSpec(a.(*v1beta1.PoolSpec), b.(*PoolSpec), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*PoolStatus)(nil), (*v1beta1.PoolStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_PoolStatus_To_v1beta1_PoolStatus(a.(*PoolStatus), b.(*v1beta1.PoolStatus), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1beta1.PoolStatus)(nil), (*PoolStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta1_PoolStatus_To_v1alpha1_PoolStatus(a.(*v1beta1.PoolStatus), b.(*PoolStatus), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*Repository)(nil), (*v1beta1.Repository)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_Repository_To_v1beta1_Repository(a.(*Repository), b.(*v1beta1.Repository), scope)
 	}); err != nil {
@@ -190,11 +175,6 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1beta1.RepositoryStatus)(nil), (*RepositoryStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta1_RepositoryStatus_To_v1alpha1_RepositoryStatus(a.(*v1beta1.RepositoryStatus), b.(*RepositoryStatus), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*Runner)(nil), (*v1beta1.Runner)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_Runner_To_v1beta1_Runner(a.(*Runner), b.(*v1beta1.Runner), scope)
 	}); err != nil {
@@ -265,11 +245,31 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*v1beta1.OrganizationStatus)(nil), (*OrganizationStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_OrganizationStatus_To_v1alpha1_OrganizationStatus(a.(*v1beta1.OrganizationStatus), b.(*OrganizationStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1beta1.PoolSpec)(nil), (*PoolSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_PoolSpec_To_v1alpha1_PoolSpec(a.(*v1beta1.PoolSpec), b.(*PoolSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1beta1.PoolStatus)(nil), (*PoolStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_PoolStatus_To_v1alpha1_PoolStatus(a.(*v1beta1.PoolStatus), b.(*PoolStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*v1beta1.RepositorySpec)(nil), (*RepositorySpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_RepositorySpec_To_v1alpha1_RepositorySpec(a.(*v1beta1.RepositorySpec), b.(*RepositorySpec), scope)
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*v1beta1.RepositoryStatus)(nil), (*RepositoryStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_RepositoryStatus_To_v1alpha1_RepositoryStatus(a.(*v1beta1.RepositoryStatus), b.(*RepositoryStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*v1beta1.RunnerStatus)(nil), (*RunnerStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_RunnerStatus_To_v1alpha1_RunnerStatus(a.(*v1beta1.RunnerStatus), b.(*RunnerStatus), scope)
 	}); err != nil {
@@ -366,6 +366,9 @@ func autoConvert_v1beta1_EnterpriseSpec_To_v1alpha1_EnterpriseSpec(in *v1beta1.E
 		return err
 	}
 	// WARNING: in.PoolBalancerType requires manual conversion: does not exist in peer-type
+	// WARNING: in.WebhookSecret requires manual conversion: does not exist in peer-type
+	// WARNING: in.GarmServerRef requires manual conversion: does not exist in peer-type
+	// WARNING: in.DeletionPolicy requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -571,6 +574,10 @@ func autoConvert_v1beta1_OrganizationSpec_To_v1alpha1_OrganizationSpec(in *v1bet
 		return err
 	}
 	// WARNING: in.PoolBalancerType requires manual conversion: does not exist in peer-type
+	// WARNING: in.WebhookSecret requires manual conversion: does not exist in peer-type
+	// WARNING: in.GarmServerRef requires manual conversion: does not exist in peer-type
+	// WARNING: in.Webhook requires manual conversion: does not exist in peer-type
+	// WARNING: in.DeletionPolicy requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -588,14 +595,10 @@ func Convert_v1alpha1_OrganizationStatus_To_v1beta1_OrganizationStatus(in *Organ
 func autoConvert_v1beta1_OrganizationStatus_To_v1alpha1_OrganizationStatus(in *v1beta1.OrganizationStatus, out *OrganizationStatus, s conversion.Scope) error {
 	out.ID = in.ID
 	out.Conditions = *(*[]v1.Condition)(unsafe.Pointer(&in.Conditions))
+	// WARNING: in.Webhook requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1beta1_OrganizationStatus_To_v1alpha1_OrganizationStatus is an autogenerated conversion function.
-func Convert_v1beta1_OrganizationStatus_To_v1alpha1_OrganizationStatus(in *v1beta1.OrganizationStatus, out *OrganizationStatus, s conversion.Scope) error {
-	return autoConvert_v1beta1_OrganizationStatus_To_v1alpha1_OrganizationStatus(in, out, s)
-}
-
 func autoConvert_v1alpha1_Pool_To_v1beta1_Pool(in *Pool, out *v1beta1.Pool, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1alpha1_PoolSpec_To_v1beta1_PoolSpec(&in.Spec, &out.Spec, s); err != nil {
@@ -630,7 +633,17 @@ func Convert_v1beta1_Pool_To_v1alpha1_Pool(in *v1beta1.Pool, out *Pool, s conver
 
 func autoConvert_v1alpha1_PoolList_To_v1beta1_PoolList(in *PoolList, out *v1beta1.PoolList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
-	out.Items = *(*[]v1beta1.Pool)(unsafe.Pointer(&in.Items))
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]v1beta1.Pool, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha1_Pool_To_v1beta1_Pool(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
 	return nil
 }
 
@@ -641,7 +654,17 @@ func Convert_v1alpha1_PoolList_To_v1beta1_PoolList(in *PoolList, out *v1beta1.Po
 
 func autoConvert_v1beta1_PoolList_To_v1alpha1_PoolList(in *v1beta1.PoolList, out *PoolList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
-	out.Items = *(*[]Pool)(unsafe.Pointer(&in.Items))
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Pool, len(*in))
+		for i := range *in {
+			if err := Convert_v1beta1_Pool_To_v1alpha1_Pool(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
 	return nil
 }
 
@@ -688,14 +711,16 @@ func autoConvert_v1beta1_PoolSpec_To_v1alpha1_PoolSpec(in *v1beta1.PoolSpec, out
 	out.ExtraSpecs = in.ExtraSpecs
 	out.GitHubRunnerGroup = in.GitHubRunnerGroup
 	out.RunnerPrefix = in.RunnerPrefix
+	// WARNING: in.GarmServerRef requires manual conversion: does not exist in peer-type
+	// WARNING: in.DrainTimeout requires manual conversion: does not exist in peer-type
+	// WARNING: in.ForceDeleteAfterDrainTimeout requires manual conversion: does not exist in peer-type
+	// WARNING: in.DriftPolicy requires manual conversion: does not exist in peer-type
+	// WARNING: in.RunnerLifecycle requires manual conversion: does not exist in peer-type
+	// WARNING: in.ScaleDown requires manual conversion: does not exist in peer-type
+	// WARNING: in.RunnerRemediation requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1beta1_PoolSpec_To_v1alpha1_PoolSpec is an autogenerated conversion function.
-func Convert_v1beta1_PoolSpec_To_v1alpha1_PoolSpec(in *v1beta1.PoolSpec, out *PoolSpec, s conversion.Scope) error {
-	return autoConvert_v1beta1_PoolSpec_To_v1alpha1_PoolSpec(in, out, s)
-}
-
 func autoConvert_v1alpha1_PoolStatus_To_v1beta1_PoolStatus(in *PoolStatus, out *v1beta1.PoolStatus, s conversion.Scope) error {
 	out.ID = in.ID
 	out.LongRunningIdleRunners = in.LongRunningIdleRunners
@@ -712,16 +737,25 @@ func Convert_v1alpha1_PoolStatus_To_v1beta1_PoolStatus(in *PoolStatus, out *v1be
 func autoConvert_v1beta1_PoolStatus_To_v1alpha1_PoolStatus(in *v1beta1.PoolStatus, out *PoolStatus, s conversion.Scope) error {
 	out.ID = in.ID
 	out.LongRunningIdleRunners = in.LongRunningIdleRunners
+	// WARNING: in.IdleRunners requires manual conversion: does not exist in peer-type
+	// WARNING: in.ActiveRunners requires manual conversion: does not exist in peer-type
+	// WARNING: in.PendingRunners requires manual conversion: does not exist in peer-type
+	// WARNING: in.FailedRunners requires manual conversion: does not exist in peer-type
+	// WARNING: in.TotalRunners requires manual conversion: does not exist in peer-type
+	// WARNING: in.MaxRunners requires manual conversion: does not exist in peer-type
+	// WARNING: in.ObservedGeneration requires manual conversion: does not exist in peer-type
+	// WARNING: in.LastScaleDownTime requires manual conversion: does not exist in peer-type
+	// WARNING: in.LastScaleDownReason requires manual conversion: does not exist in peer-type
+	// WARNING: in.LastDriftedFields requires manual conversion: does not exist in peer-type
+	// WARNING: in.LastRecycleTime requires manual conversion: does not exist in peer-type
+	// WARNING: in.RecycleSurge requires manual conversion: does not exist in peer-type
+	// WARNING: in.RemediatedRunners requires manual conversion: does not exist in peer-type
+	// WARNING: in.RemediationWindowStart requires manual conversion: does not exist in peer-type
 	out.Selector = in.Selector
 	out.Conditions = *(*[]v1.Condition)(unsafe.Pointer(&in.Conditions))
 	return nil
 }
 
-// Convert_v1beta1_PoolStatus_To_v1alpha1_PoolStatus is an autogenerated conversion function.
-func Convert_v1beta1_PoolStatus_To_v1alpha1_PoolStatus(in *v1beta1.PoolStatus, out *PoolStatus, s conversion.Scope) error {
-	return autoConvert_v1beta1_PoolStatus_To_v1alpha1_PoolStatus(in, out, s)
-}
-
 func autoConvert_v1alpha1_Repository_To_v1beta1_Repository(in *Repository, out *v1beta1.Repository, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1alpha1_RepositorySpec_To_v1beta1_RepositorySpec(&in.Spec, &out.Spec, s); err != nil {
@@ -812,6 +846,10 @@ func autoConvert_v1beta1_RepositorySpec_To_v1alpha1_RepositorySpec(in *v1beta1.R
 		return err
 	}
 	// WARNING: in.PoolBalancerType requires manual conversion: does not exist in peer-type
+	// WARNING: in.WebhookSecret requires manual conversion: does not exist in peer-type
+	// WARNING: in.GarmServerRef requires manual conversion: does not exist in peer-type
+	// WARNING: in.Webhook requires manual conversion: does not exist in peer-type
+	// WARNING: in.DeletionPolicy requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -829,14 +867,10 @@ func Convert_v1alpha1_RepositoryStatus_To_v1beta1_RepositoryStatus(in *Repositor
 func autoConvert_v1beta1_RepositoryStatus_To_v1alpha1_RepositoryStatus(in *v1beta1.RepositoryStatus, out *RepositoryStatus, s conversion.Scope) error {
 	out.ID = in.ID
 	out.Conditions = *(*[]v1.Condition)(unsafe.Pointer(&in.Conditions))
+	// WARNING: in.Webhook requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1beta1_RepositoryStatus_To_v1alpha1_RepositoryStatus is an autogenerated conversion function.
-func Convert_v1beta1_RepositoryStatus_To_v1alpha1_RepositoryStatus(in *v1beta1.RepositoryStatus, out *RepositoryStatus, s conversion.Scope) error {
-	return autoConvert_v1beta1_RepositoryStatus_To_v1alpha1_RepositoryStatus(in, out, s)
-}
-
 func autoConvert_v1alpha1_Runner_To_v1beta1_Runner(in *Runner, out *v1beta1.Runner, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1alpha1_RunnerSpec_To_v1beta1_RunnerSpec(&in.Spec, &out.Spec, s); err != nil {
@@ -965,7 +999,13 @@ func autoConvert_v1beta1_RunnerStatus_To_v1alpha1_RunnerStatus(in *v1beta1.Runne
 	out.Status = garmparams.RunnerStatus(in.Status)
 	out.InstanceStatus = params.InstanceStatus(in.InstanceStatus)
 	out.PoolID = in.PoolID
+	// WARNING: in.PoolRef requires manual conversion: does not exist in peer-type
 	out.ProviderFault = in.ProviderFault
+	// WARNING: in.StatusMessages requires manual conversion: does not exist in peer-type
+	// WARNING: in.CreatedAt requires manual conversion: does not exist in peer-type
+	// WARNING: in.UpdatedAt requires manual conversion: does not exist in peer-type
+	// WARNING: in.Job requires manual conversion: does not exist in peer-type
+	// WARNING: in.Labels requires manual conversion: does not exist in peer-type
 	out.GitHubRunnerGroup = in.GitHubRunnerGroup
 	// WARNING: in.Conditions requires manual conversion: does not exist in peer-type
 	return nil
@@ -17,6 +17,21 @@ type EnterpriseSpec struct {
 	// WebhookSecretRef represents a secret that should be used for the webhook
 	WebhookSecretRef SecretRef               `json:"webhookSecretRef"`
 	PoolBalancerType params.PoolBalancerType `json:"poolBalancerType,omitempty"`
+
+	// WebhookSecret lets the operator generate and rotate the secret referenced by WebhookSecretRef.
+	// +optional
+	WebhookSecret *WebhookSecretSpec `json:"webhookSecret,omitempty"`
+
+	// GarmServerRef references the GarmServer which manages this resource.
+	// If not set, the GARM server from the operator configuration is used.
+	// +optional
+	GarmServerRef *corev1.LocalObjectReference `json:"garmServerRef,omitempty"`
+
+	// DeletionPolicy decides whether the enterprise is deleted in GARM (Delete) or left in GARM (Orphan)
+	// when this resource gets deleted.
+	// +kubebuilder:default=Delete
+	// +optional
+	DeletionPolicy DeletionPolicy `json:"deletionPolicy,omitempty"`
 }
 
 // EnterpriseStatus defines the observed state of Enterprise
@@ -108,6 +123,33 @@ func (e *Enterprise) GetKind() string {
 	return e.Kind
 }
 
+func (e *Enterprise) SetID(id string) {
+	e.Status.ID = id
+}
+
+func (e *Enterprise) GetGarmServerRef() *corev1.LocalObjectReference {
+	return e.Spec.GarmServerRef
+}
+
+func (e *Enterprise) GetWebhookSecretRef() *SecretRef {
+	return &e.Spec.WebhookSecretRef
+}
+
+func (e *Enterprise) GetWebhookSecretSpec() *WebhookSecretSpec {
+	return e.Spec.WebhookSecret
+}
+
+func (e *Enterprise) GetPoolBalancerType() params.PoolBalancerType {
+	return e.Spec.PoolBalancerType
+}
+
+func (e *Enterprise) GetDeletionPolicy() DeletionPolicy {
+	if e.Spec.DeletionPolicy == "" {
+		return DeletionPolicyDelete
+	}
+	return e.Spec.DeletionPolicy
+}
+
 //+kubebuilder:object:root=true
 
 // EnterpriseList contains a list of Enterprise
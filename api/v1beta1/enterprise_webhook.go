@@ -0,0 +1,53 @@
+// SPDX-License-Identifier: MIT
+
+package v1beta1
+
+import (
+	"context"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// log is for logging in this package.
+var enterpriselog = logf.Log.WithName("enterprise-resource")
+
+func (e *Enterprise) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	c = mgr.GetClient()
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(e).
+		WithValidator(&EnterpriseValidator{}).
+		Complete()
+}
+
+//+kubebuilder:webhook:path=/validate-garm-operator-mercedes-benz-com-v1beta1-enterprise,mutating=false,failurePolicy=fail,sideEffects=None,groups=garm-operator.mercedes-benz.com,resources=enterprises,verbs=delete,versions=v1beta1,name=validate.enterprise.garm-operator.mercedes-benz.com,admissionReviewVersions=v1
+
+type EnterpriseValidator struct{}
+
+var _ webhook.CustomValidator = &EnterpriseValidator{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (e *EnterpriseValidator) ValidateCreate(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (e *EnterpriseValidator) ValidateUpdate(_ context.Context, _ runtime.Object, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (e *EnterpriseValidator) ValidateDelete(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	enterprise, ok := obj.(*Enterprise)
+	if !ok {
+		return nil, apierrors.NewBadRequest("failed to convert runtime.Object to Enterprise CRD")
+	}
+
+	enterpriselog.Info("validate delete", "name", enterprise.Name, "namespace", enterprise.Namespace)
+
+	return nil, validateScopeDeletion(ctx, enterprise, EnterpriseScope)
+}
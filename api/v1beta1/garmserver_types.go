@@ -0,0 +1,84 @@
+// SPDX-License-Identifier: MIT
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/mercedes-benz/garm-operator/pkg/conditions"
+)
+
+// GarmServerSpec defines the desired state of GarmServer
+type GarmServerSpec struct {
+	// URL of the GARM server, e.g. https://garm.example.com
+	URL string `json:"url"`
+
+	// UsernameSecretRef and PasswordSecretRef reference the credentials of the GARM admin user
+	UsernameSecretRef SecretRef `json:"usernameSecretRef"`
+	PasswordSecretRef SecretRef `json:"passwordSecretRef"`
+
+	// Email of the GARM admin user, which is used if the GARM server gets initialized by the operator
+	// +optional
+	Email string `json:"email,omitempty"`
+
+	// CACertBundleSecretRef references PEM encoded CA certificates which are trusted in addition to the system ones
+	// +optional
+	CACertBundleSecretRef SecretRef `json:"caCertBundleSecretRef,omitempty"`
+}
+
+// GarmServerStatus defines the observed state of GarmServer
+type GarmServerStatus struct {
+	ControllerID string             `json:"controllerId,omitempty"`
+	Version      string             `json:"version,omitempty"`
+	Conditions   []metav1.Condition `json:"conditions,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:resource:path=garmservers,scope=Namespaced,categories=garm
+//+kubebuilder:subresource:status
+//+kubebuilder:storageversion
+//+kubebuilder:printcolumn:name="URL",type="string",JSONPath=".spec.url",description="URL of the GARM server"
+//+kubebuilder:printcolumn:name="Version",type="string",JSONPath=".status.version",description="Version of the GARM server"
+//+kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+//+kubebuilder:printcolumn:name="Error",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].message",priority=1
+//+kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp",description="Time duration since creation of GarmServer"
+
+// GarmServer is the Schema for the garmservers API
+type GarmServer struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   GarmServerSpec   `json:"spec,omitempty"`
+	Status GarmServerStatus `json:"status,omitempty"`
+}
+
+func (s *GarmServer) InitializeConditions() {
+	if conditions.Get(s, conditions.ReadyCondition) == nil {
+		conditions.MarkUnknown(s, conditions.ReadyCondition, conditions.UnknownReason, conditions.GarmServerNotReconciledYetMsg)
+	}
+
+	if conditions.Get(s, conditions.SecretReference) == nil {
+		conditions.MarkUnknown(s, conditions.SecretReference, conditions.UnknownReason, conditions.SecretRefNotReconciledYetMsg)
+	}
+}
+
+func (s *GarmServer) SetConditions(conditions []metav1.Condition) {
+	s.Status.Conditions = conditions
+}
+
+func (s *GarmServer) GetConditions() []metav1.Condition {
+	return s.Status.Conditions
+}
+
+//+kubebuilder:object:root=true
+
+// GarmServerList contains a list of GarmServer
+type GarmServerList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []GarmServer `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&GarmServer{}, &GarmServerList{})
+}
@@ -24,6 +24,11 @@ type GitHubCredentialSpec struct {
 
 	// containing either privateKey or pat token
 	SecretRef SecretRef `json:"secretRef,omitempty"`
+
+	// GarmServerRef references the GarmServer which manages this resource.
+	// If not set, the GARM server from the operator configuration is used.
+	// +optional
+	GarmServerRef *corev1.LocalObjectReference `json:"garmServerRef,omitempty"`
 }
 
 // GitHubCredentialStatus defines the observed state of GitHubCredential
@@ -3,6 +3,7 @@
 package v1beta1
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/mercedes-benz/garm-operator/pkg/conditions"
@@ -15,6 +16,11 @@ type GitHubEndpointSpec struct {
 	UploadBaseURL         string    `json:"uploadBaseUrl,omitempty"`
 	BaseURL               string    `json:"baseUrl,omitempty"`
 	CACertBundleSecretRef SecretRef `json:"caCertBundleSecretRef,omitempty"`
+
+	// GarmServerRef references the GarmServer which manages this resource.
+	// If not set, the GARM server from the operator configuration is used.
+	// +optional
+	GarmServerRef *corev1.LocalObjectReference `json:"garmServerRef,omitempty"`
 }
 
 // GitHubEndpointStatus defines the observed state of GitHubEndpoint
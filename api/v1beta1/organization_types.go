@@ -17,12 +17,35 @@ type OrganizationSpec struct {
 	// WebhookSecretRef represents a secret that should be used for the webhook
 	WebhookSecretRef SecretRef               `json:"webhookSecretRef"`
 	PoolBalancerType params.PoolBalancerType `json:"poolBalancerType,omitempty"`
+
+	// WebhookSecret lets the operator generate and rotate the secret referenced by WebhookSecretRef.
+	// +optional
+	WebhookSecret *WebhookSecretSpec `json:"webhookSecret,omitempty"`
+
+	// GarmServerRef references the GarmServer which manages this resource.
+	// If not set, the GARM server from the operator configuration is used.
+	// +optional
+	GarmServerRef *corev1.LocalObjectReference `json:"garmServerRef,omitempty"`
+
+	// Webhook configures the installation of the GitHub webhook by GARM.
+	// +optional
+	Webhook *WebhookSpec `json:"webhook,omitempty"`
+
+	// DeletionPolicy decides whether the organization is deleted in GARM (Delete) or left in GARM (Orphan)
+	// when this resource gets deleted.
+	// +kubebuilder:default=Delete
+	// +optional
+	DeletionPolicy DeletionPolicy `json:"deletionPolicy,omitempty"`
 }
 
 // OrganizationStatus defines the observed state of Organization
 type OrganizationStatus struct {
 	ID         string             `json:"id"`
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// Webhook is the GitHub webhook installed by GARM, if spec.webhook.install is enabled.
+	// +optional
+	Webhook *WebhookStatus `json:"webhook,omitempty"`
 }
 
 //+kubebuilder:object:root=true
@@ -108,6 +131,45 @@ func (o *Organization) GetKind() string {
 	return o.Kind
 }
 
+func (o *Organization) SetID(id string) {
+	o.Status.ID = id
+}
+
+func (o *Organization) GetGarmServerRef() *corev1.LocalObjectReference {
+	return o.Spec.GarmServerRef
+}
+
+func (o *Organization) GetWebhookSecretRef() *SecretRef {
+	return &o.Spec.WebhookSecretRef
+}
+
+func (o *Organization) GetWebhookSecretSpec() *WebhookSecretSpec {
+	return o.Spec.WebhookSecret
+}
+
+func (o *Organization) GetPoolBalancerType() params.PoolBalancerType {
+	return o.Spec.PoolBalancerType
+}
+
+func (o *Organization) GetDeletionPolicy() DeletionPolicy {
+	if o.Spec.DeletionPolicy == "" {
+		return DeletionPolicyDelete
+	}
+	return o.Spec.DeletionPolicy
+}
+
+func (o *Organization) GetWebhookSpec() *WebhookSpec {
+	return o.Spec.Webhook
+}
+
+func (o *Organization) GetWebhookStatus() *WebhookStatus {
+	return o.Status.Webhook
+}
+
+func (o *Organization) SetWebhookStatus(webhook *WebhookStatus) {
+	o.Status.Webhook = webhook
+}
+
 //+kubebuilder:object:root=true
 
 // OrganizationList contains a list of Organization
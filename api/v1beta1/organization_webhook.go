@@ -0,0 +1,53 @@
+// SPDX-License-Identifier: MIT
+
+package v1beta1
+
+import (
+	"context"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// log is for logging in this package.
+var organizationlog = logf.Log.WithName("organization-resource")
+
+func (o *Organization) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	c = mgr.GetClient()
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(o).
+		WithValidator(&OrganizationValidator{}).
+		Complete()
+}
+
+//+kubebuilder:webhook:path=/validate-garm-operator-mercedes-benz-com-v1beta1-organization,mutating=false,failurePolicy=fail,sideEffects=None,groups=garm-operator.mercedes-benz.com,resources=organizations,verbs=delete,versions=v1beta1,name=validate.organization.garm-operator.mercedes-benz.com,admissionReviewVersions=v1
+
+type OrganizationValidator struct{}
+
+var _ webhook.CustomValidator = &OrganizationValidator{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (o *OrganizationValidator) ValidateCreate(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (o *OrganizationValidator) ValidateUpdate(_ context.Context, _ runtime.Object, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (o *OrganizationValidator) ValidateDelete(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	organization, ok := obj.(*Organization)
+	if !ok {
+		return nil, apierrors.NewBadRequest("failed to convert runtime.Object to Organization CRD")
+	}
+
+	organizationlog.Info("validate delete", "name", organization.Name, "namespace", organization.Namespace)
+
+	return nil, validateScopeDeletion(ctx, organization, OrganizationScope)
+}
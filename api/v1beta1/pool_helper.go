@@ -5,9 +5,11 @@ package v1beta1
 import (
 	"context"
 
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/mercedes-benz/garm-operator/pkg/client/key"
 	"github.com/mercedes-benz/garm-operator/pkg/filter"
 )
 
@@ -21,6 +23,35 @@ func (p *Pool) GetImageCR(ctx context.Context, client client.Client) (*Image, er
 	return image, nil
 }
 
+// RunnerSelector returns the label selector which matches all Runner CRs of this pool
+func (p *Pool) RunnerSelector() string {
+	return labels.SelectorFromSet(labels.Set{key.PoolLabel: p.Name}).String()
+}
+
+// GarmMinIdleRunners returns the min idle runners of the pool in GARM,
+// which includes the idle runners surged for recycling runners
+func (p *Pool) GarmMinIdleRunners() uint {
+	return p.Spec.MinIdleRunners + p.Status.RecycleSurge
+}
+
+// PoolsReferencingScope returns the names of the pools in namespace which reference the GitHub scope
+// of the given kind and name and are not being deleted.
+func PoolsReferencingScope(ctx context.Context, c client.Client, namespace string, kind GitHubScopeKind, name string) ([]string, error) {
+	pools := &PoolList{}
+	if err := c.List(ctx, pools, client.InNamespace(namespace)); err != nil {
+		return nil, err
+	}
+
+	var names []string
+	for _, pool := range filter.Match(pools.Items, MatchesGitHubScope(name, string(kind))) {
+		// we do not care about pools that are already deleted
+		if pool.GetDeletionTimestamp() == nil {
+			names = append(names, pool.Name)
+		}
+	}
+	return names, nil
+}
+
 func MatchesImage(image string) filter.Predicate[Pool] {
 	return func(p Pool) bool {
 		return p.Spec.ImageName == image
@@ -43,13 +43,178 @@ type PoolSpec struct {
 
 	// +optional
 	RunnerPrefix string `json:"runnerPrefix"`
+
+	// GarmServerRef references the GarmServer which manages this resource.
+	// If not set, the GARM server from the operator configuration is used.
+	// +optional
+	GarmServerRef *corev1.LocalObjectReference `json:"garmServerRef,omitempty"`
+
+	// DrainTimeout is the time active runners get to finish their jobs when the pool is deleted.
+	// If not set, the pool drain timeout from the operator configuration is used.
+	// +optional
+	DrainTimeout *metav1.Duration `json:"drainTimeout,omitempty"`
+
+	// ForceDeleteAfterDrainTimeout deletes the remaining active runners once the drain timeout is exceeded.
+	// If not set, the deletion of the pool waits until all runners have finished their jobs.
+	// +optional
+	ForceDeleteAfterDrainTimeout bool `json:"forceDeleteAfterDrainTimeout,omitempty"`
+
+	// DriftPolicy defines how changes of the pool in GARM, which weren't made through this Pool, are handled.
+	// Enforce reports and overwrites them, ReportOnly only reports them and Ignore neither reports nor overwrites them.
+	// Changes of the Pool spec are always applied.
+	// +kubebuilder:validation:Enum=Enforce;ReportOnly;Ignore
+	// +kubebuilder:default=Enforce
+	// +optional
+	DriftPolicy DriftPolicy `json:"driftPolicy,omitempty"`
+
+	// RunnerLifecycle defines when idle runners of the pool get recycled, even if they aren't surplus idle runners.
+	// If not set, runners are only deleted when the pool scales down.
+	// +optional
+	RunnerLifecycle *RunnerLifecycle `json:"runnerLifecycle,omitempty"`
+
+	// ScaleDown defines how idle runners of the pool are deleted when it scales down.
+	// If not set, the scale down settings from the operator configuration are used.
+	// +optional
+	ScaleDown *ScaleDownPolicy `json:"scaleDown,omitempty"`
+
+	// RunnerRemediation defines how runners which are stuck while being created or report a provider fault get remediated.
+	// If not set, up to 3 of these runners per hour get deleted, so that garm recreates them.
+	// +optional
+	RunnerRemediation *RunnerRemediation `json:"runnerRemediation,omitempty"`
+}
+
+// RunnerRemediation defines the retry budget for deleting stuck and faulty runners of a pool.
+// Runners are stuck if they are still being created after the runner bootstrap timeout of the pool.
+type RunnerRemediation struct {
+	// MaxRetries is the number of stuck or faulty runners which get deleted within the retry window.
+	// Setting it to 0 disables the remediation.
+	// +optional
+	MaxRetries *uint `json:"maxRetries,omitempty"`
+
+	// RetryWindow is the time window the retry budget applies to.
+	// If not set, the retry budget applies to one hour.
+	// +optional
+	RetryWindow *metav1.Duration `json:"retryWindow,omitempty"`
+}
+
+// ScaleDownPolicy defines how idle runners of a pool are deleted when it scales down.
+// Fields which aren't set fall back to the scale down settings from the operator configuration.
+type ScaleDownPolicy struct {
+	// MinIdleRunnersAge is the minimum age an idle runner must have to get deleted when the pool scales down.
+	// +optional
+	MinIdleRunnersAge *metav1.Duration `json:"minIdleRunnersAge,omitempty"`
+
+	// StepSize is the maximum number of idle runners which are deleted at once when the pool scales down.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	StepSize uint `json:"stepSize,omitempty"`
+
+	// Cooldown is the minimum time between two scale downs of the pool.
+	// +optional
+	Cooldown *metav1.Duration `json:"cooldown,omitempty"`
 }
 
+// RunnerLifecycle defines when runners of a pool get deleted, so that garm replaces them with fresh ones.
+// Runners which are running a job are recycled as soon as they are idle again.
+type RunnerLifecycle struct {
+	// MaxIdleAge is the time a runner may be idle before it gets recycled.
+	// +optional
+	MaxIdleAge *metav1.Duration `json:"maxIdleAge,omitempty"`
+
+	// MaxLifetime is the time a runner may exist before it gets recycled.
+	// +optional
+	MaxLifetime *metav1.Duration `json:"maxLifetime,omitempty"`
+
+	// MaxJobs is the number of jobs a runner may run before it gets recycled.
+	// Jobs are counted while the operator observes the runner, so jobs which ran before the operator started aren't taken into account.
+	// +optional
+	MaxJobs uint `json:"maxJobs,omitempty"`
+
+	// BatchSize is the maximum number of runners which are recycled at once.
+	// +kubebuilder:default=1
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	BatchSize uint `json:"batchSize,omitempty"`
+
+	// BatchInterval is the minimum time between two batches of recycled runners.
+	// If not set, a batch is recycled at most every 5 minutes.
+	// +optional
+	BatchInterval *metav1.Duration `json:"batchInterval,omitempty"`
+}
+
+// DriftPolicy defines how changes of a pool in GARM, which differ from the Pool spec, are handled
+type DriftPolicy string
+
+const (
+	// DriftPolicyEnforce reports drifted fields and overwrites them with the values of the Pool spec
+	DriftPolicyEnforce DriftPolicy = "Enforce"
+	// DriftPolicyReportOnly reports drifted fields but keeps the changes in GARM
+	DriftPolicyReportOnly DriftPolicy = "ReportOnly"
+	// DriftPolicyIgnore neither reports nor overwrites drifted fields
+	DriftPolicyIgnore DriftPolicy = "Ignore"
+)
+
 // PoolStatus defines the observed state of Pool
 type PoolStatus struct {
 	ID                     string `json:"id"`
 	LongRunningIdleRunners uint   `json:"longRunningIdleRunners"`
-	Selector               string `json:"selector"`
+
+	// IdleRunners is the number of runners which are currently idle in this pool.
+	// It is exposed as status replicas of the scale subresource.
+	IdleRunners uint `json:"idleRunners"`
+
+	// ActiveRunners is the number of runners which are currently running a job.
+	ActiveRunners uint `json:"activeRunners"`
+
+	// PendingRunners is the number of runners which are being created or are not yet registered on GitHub.
+	PendingRunners uint `json:"pendingRunners"`
+
+	// FailedRunners is the number of runners which failed to be created or to register on GitHub.
+	FailedRunners uint `json:"failedRunners"`
+
+	// TotalRunners is the number of all runners which currently exist in this pool, regardless of their state.
+	TotalRunners uint `json:"totalRunners"`
+
+	// MaxRunners is the maximum number of runners of the pool in GARM.
+	MaxRunners uint `json:"maxRunners"`
+
+	// ObservedGeneration is the generation of the Pool spec which has been applied to the pool in GARM.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// LastScaleDownTime is the time when idle runners of this pool have been deleted the last time.
+	// +optional
+	LastScaleDownTime *metav1.Time `json:"lastScaleDownTime,omitempty"`
+
+	// LastScaleDownReason is the reason why idle runners of this pool have been deleted the last time.
+	// +optional
+	LastScaleDownReason string `json:"lastScaleDownReason,omitempty"`
+
+	// LastDriftedFields are the fields of the pool in GARM which differed from the Pool spec
+	// the last time the pool in GARM has been updated or, with the drift policy ReportOnly, has been reported.
+	// +optional
+	LastDriftedFields []string `json:"lastDriftedFields,omitempty"`
+
+	// LastRecycleTime is the time when runners of this pool have been recycled the last time.
+	// +optional
+	LastRecycleTime *metav1.Time `json:"lastRecycleTime,omitempty"`
+
+	// RecycleSurge is the number of idle runners GARM keeps on top of minIdleRunners,
+	// so that runners can be recycled without dropping below minIdleRunners.
+	// +optional
+	RecycleSurge uint `json:"recycleSurge,omitempty"`
+
+	// RemediatedRunners is the number of stuck or faulty runners which have been deleted in the current retry window.
+	// +optional
+	RemediatedRunners uint `json:"remediatedRunners,omitempty"`
+
+	// RemediationWindowStart is the start of the current retry window of the runner remediation.
+	// +optional
+	RemediationWindowStart *metav1.Time `json:"remediationWindowStart,omitempty"`
+
+	// Selector is the label selector which matches the Runner CRs of this pool.
+	// It is exposed as label selector of the scale subresource.
+	Selector string `json:"selector"`
 
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
 }
@@ -71,11 +236,17 @@ func (p *Pool) GetConditions() []metav1.Condition {
 //+kubebuilder:object:root=true
 //+kubebuilder:subresource:status
 //+kubebuilder:storageversion
-//+kubebuilder:subresource:scale:specpath=.spec.minIdleRunners,statuspath=.status.longRunningIdleRunners,selectorpath=.status.selector
+//+kubebuilder:subresource:scale:specpath=.spec.minIdleRunners,statuspath=.status.idleRunners,selectorpath=.status.selector
 //+kubebuilder:resource:path=pools,scope=Namespaced,categories=garm
 //+kubebuilder:printcolumn:name="ID",type=string,JSONPath=`.status.id`
 //+kubebuilder:printcolumn:name="MinIdleRunners",type=string,JSONPath=`.spec.minIdleRunners`
 //+kubebuilder:printcolumn:name="MaxRunners",type=string,JSONPath=`.spec.maxRunners`
+//+kubebuilder:printcolumn:name="Idle",type=integer,JSONPath=`.status.idleRunners`
+//+kubebuilder:printcolumn:name="Active",type=integer,JSONPath=`.status.activeRunners`
+//+kubebuilder:printcolumn:name="Pending",type=integer,JSONPath=`.status.pendingRunners`,priority=1
+//+kubebuilder:printcolumn:name="Failed",type=integer,JSONPath=`.status.failedRunners`,priority=1
+//+kubebuilder:printcolumn:name="Total",type=integer,JSONPath=`.status.totalRunners`
+//+kubebuilder:printcolumn:name="LastScaleDown",type=date,JSONPath=`.status.lastScaleDownTime`,priority=1
 //+kubebuilder:printcolumn:name="ImageName",type=string,JSONPath=`.spec.imageName`,priority=1
 //+kubebuilder:printcolumn:name="Flavor",type=string,JSONPath=`.spec.flavor`,priority=1
 //+kubebuilder:printcolumn:name="Provider",type=string,JSONPath=`.spec.providerName`,priority=1
@@ -0,0 +1,122 @@
+// SPDX-License-Identifier: MIT
+
+package v1beta1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/mercedes-benz/garm-operator/pkg/conditions"
+)
+
+// PoolScheduleSpec defines the desired state of PoolSchedule
+type PoolScheduleSpec struct {
+	// PoolRefs is a list of pools in the same namespace which are scaled by this schedule
+	// +kubebuilder:validation:MinItems=1
+	PoolRefs []corev1.LocalObjectReference `json:"poolRefs"`
+
+	// TimeZone is the IANA time zone name (e.g. Europe/Berlin) in which windows and holidays are evaluated
+	// +kubebuilder:default=UTC
+	// +optional
+	TimeZone string `json:"timeZone,omitempty"`
+
+	// Windows is a list of recurring time windows.
+	// If multiple windows are active at the same time, the first one in the list wins.
+	// +kubebuilder:validation:MinItems=1
+	Windows []PoolScheduleWindow `json:"windows"`
+
+	// Holidays is a list of dates (YYYY-MM-DD) on which no window starts
+	// +optional
+	Holidays []string `json:"holidays,omitempty"`
+
+	// Default defines the values which are applied to the pools while no window is active.
+	// If not set, the pools keep the values of the last active window.
+	// +optional
+	Default *PoolScheduleValues `json:"default,omitempty"`
+}
+
+// PoolScheduleWindow defines a recurring time window and the values which are applied to the pools while it is active
+type PoolScheduleWindow struct {
+	// Name of the window
+	Name string `json:"name"`
+
+	// Schedule is a cron expression (minute hour day-of-month month day-of-week) which defines when the window starts
+	// e.g. "0 7 * * 1-5" starts the window every working day at 07:00
+	Schedule string `json:"schedule"`
+
+	// Duration defines how long the window stays active once it started (e.g. 12h)
+	Duration metav1.Duration `json:"duration"`
+
+	PoolScheduleValues `json:",inline"`
+}
+
+// PoolScheduleValues defines the pool values which are managed by a PoolSchedule.
+// Unset values are not touched on the pools.
+type PoolScheduleValues struct {
+	// +optional
+	MinIdleRunners *uint `json:"minIdleRunners,omitempty"`
+
+	// +optional
+	MaxRunners *uint `json:"maxRunners,omitempty"`
+}
+
+// PoolScheduleStatus defines the observed state of PoolSchedule
+type PoolScheduleStatus struct {
+	// ActiveWindow is the name of the currently active window. Empty if no window is active.
+	ActiveWindow string `json:"activeWindow,omitempty"`
+
+	// NextTransitionTime is the time at which the next window starts or the active window ends
+	NextTransitionTime *metav1.Time `json:"nextTransitionTime,omitempty"`
+
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:resource:path=poolschedules,scope=Namespaced,categories=garm
+//+kubebuilder:subresource:status
+//+kubebuilder:storageversion
+//+kubebuilder:printcolumn:name="Active Window",type="string",JSONPath=".status.activeWindow",description="Currently active window"
+//+kubebuilder:printcolumn:name="Next Transition",type="string",JSONPath=".status.nextTransitionTime",description="Time of the next window transition"
+//+kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+//+kubebuilder:printcolumn:name="Error",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].message",priority=1
+//+kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp",description="Time duration since creation of PoolSchedule"
+
+// PoolSchedule is the Schema for the poolschedules API
+type PoolSchedule struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   PoolScheduleSpec   `json:"spec,omitempty"`
+	Status PoolScheduleStatus `json:"status,omitempty"`
+}
+
+func (s *PoolSchedule) InitializeConditions() {
+	if conditions.Get(s, conditions.ReadyCondition) == nil {
+		conditions.MarkUnknown(s, conditions.ReadyCondition, conditions.UnknownReason, conditions.PoolScheduleNotReconciledYetMsg)
+	}
+
+	if conditions.Get(s, conditions.PoolReference) == nil {
+		conditions.MarkUnknown(s, conditions.PoolReference, conditions.UnknownReason, conditions.PoolRefNotReconciledYetMsg)
+	}
+}
+
+func (s *PoolSchedule) SetConditions(conditions []metav1.Condition) {
+	s.Status.Conditions = conditions
+}
+
+func (s *PoolSchedule) GetConditions() []metav1.Condition {
+	return s.Status.Conditions
+}
+
+//+kubebuilder:object:root=true
+
+// PoolScheduleList contains a list of PoolSchedule
+type PoolScheduleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []PoolSchedule `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&PoolSchedule{}, &PoolScheduleList{})
+}
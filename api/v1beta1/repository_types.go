@@ -18,12 +18,35 @@ type RepositorySpec struct {
 	// WebhookSecretRef represents a secret that should be used for the webhook
 	WebhookSecretRef SecretRef               `json:"webhookSecretRef"`
 	PoolBalancerType params.PoolBalancerType `json:"poolBalancerType,omitempty"`
+
+	// WebhookSecret lets the operator generate and rotate the secret referenced by WebhookSecretRef.
+	// +optional
+	WebhookSecret *WebhookSecretSpec `json:"webhookSecret,omitempty"`
+
+	// GarmServerRef references the GarmServer which manages this resource.
+	// If not set, the GARM server from the operator configuration is used.
+	// +optional
+	GarmServerRef *corev1.LocalObjectReference `json:"garmServerRef,omitempty"`
+
+	// Webhook configures the installation of the GitHub webhook by GARM.
+	// +optional
+	Webhook *WebhookSpec `json:"webhook,omitempty"`
+
+	// DeletionPolicy decides whether the repository is deleted in GARM (Delete) or left in GARM (Orphan)
+	// when this resource gets deleted.
+	// +kubebuilder:default=Delete
+	// +optional
+	DeletionPolicy DeletionPolicy `json:"deletionPolicy,omitempty"`
 }
 
 // RepositoryStatus defines the observed state of Repository
 type RepositoryStatus struct {
 	ID         string             `json:"id"`
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// Webhook is the GitHub webhook installed by GARM, if spec.webhook.install is enabled.
+	// +optional
+	Webhook *WebhookStatus `json:"webhook,omitempty"`
 }
 
 //+kubebuilder:object:root=true
@@ -109,6 +132,49 @@ func (r *Repository) GetKind() string {
 	return r.Kind
 }
 
+func (r *Repository) SetID(id string) {
+	r.Status.ID = id
+}
+
+func (r *Repository) GetGarmServerRef() *corev1.LocalObjectReference {
+	return r.Spec.GarmServerRef
+}
+
+func (r *Repository) GetWebhookSecretRef() *SecretRef {
+	return &r.Spec.WebhookSecretRef
+}
+
+func (r *Repository) GetWebhookSecretSpec() *WebhookSecretSpec {
+	return r.Spec.WebhookSecret
+}
+
+func (r *Repository) GetPoolBalancerType() params.PoolBalancerType {
+	return r.Spec.PoolBalancerType
+}
+
+func (r *Repository) GetDeletionPolicy() DeletionPolicy {
+	if r.Spec.DeletionPolicy == "" {
+		return DeletionPolicyDelete
+	}
+	return r.Spec.DeletionPolicy
+}
+
+func (r *Repository) GetOwner() string {
+	return r.Spec.Owner
+}
+
+func (r *Repository) GetWebhookSpec() *WebhookSpec {
+	return r.Spec.Webhook
+}
+
+func (r *Repository) GetWebhookStatus() *WebhookStatus {
+	return r.Status.Webhook
+}
+
+func (r *Repository) SetWebhookStatus(webhook *WebhookStatus) {
+	r.Status.Webhook = webhook
+}
+
 //+kubebuilder:object:root=true
 
 // RepositoryList contains a list of Repository
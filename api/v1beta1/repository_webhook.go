@@ -20,13 +20,14 @@ import (
 var repositorylog = logf.Log.WithName("repository-resource")
 
 func (r *Repository) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	c = mgr.GetClient()
 	return ctrl.NewWebhookManagedBy(mgr).
 		For(r).
 		WithValidator(&RepositoryValidator{}).
 		Complete()
 }
 
-//+kubebuilder:webhook:path=/validate-garm-operator-mercedes-benz-com-v1beta1-repository,mutating=false,failurePolicy=fail,sideEffects=None,groups=garm-operator.mercedes-benz.com,resources=repositories,verbs=update,versions=v1beta1,name=validate.repository.garm-operator.mercedes-benz.com,admissionReviewVersions=v1
+//+kubebuilder:webhook:path=/validate-garm-operator-mercedes-benz-com-v1beta1-repository,mutating=false,failurePolicy=fail,sideEffects=None,groups=garm-operator.mercedes-benz.com,resources=repositories,verbs=update;delete,versions=v1beta1,name=validate.repository.garm-operator.mercedes-benz.com,admissionReviewVersions=v1
 
 type RepositoryValidator struct{}
 
@@ -77,6 +78,13 @@ func validateRepoOwnerName(repo, oldRepo *Repository) *field.Error {
 }
 
 // ValidateDelete implements webhook.Validator so a webhook will be registered for the type
-func (r *RepositoryValidator) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
-	return nil, nil
+func (r *RepositoryValidator) ValidateDelete(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	repo, ok := obj.(*Repository)
+	if !ok {
+		return nil, apierrors.NewBadRequest("failed to convert runtime.Object to Repository CRD")
+	}
+
+	repositorylog.Info("validate delete", "name", repo.Name, "namespace", repo.Namespace)
+
+	return nil, validateScopeDeletion(ctx, repo, RepositoryScope)
 }
@@ -5,6 +5,7 @@ package v1beta1
 import (
 	commonParams "github.com/cloudbase/garm-provider-common/params"
 	"github.com/cloudbase/garm/params"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -56,15 +57,34 @@ type RunnerStatus struct {
 	// PoolID is the ID of the garm pool to which a runner belongs.
 	PoolID string `json:"poolId,omitempty"`
 
+	// PoolRef references the Pool CR to which a runner belongs.
+	// +optional
+	PoolRef *corev1.LocalObjectReference `json:"poolRef,omitempty"`
+
 	// ProviderFault holds any error messages captured from the IaaS provider that is
 	// responsible for managing the lifecycle of the runner.
 	ProviderFault string `json:"providerFault,omitempty"`
 
-	// StatusMessages is a list of status messages sent back by the runner as it sets itself
+	// StatusMessages is a list of the most recent status messages sent back by the runner as it sets itself
 	// up.
+	// +optional
+	StatusMessages []RunnerStatusMessage `json:"statusMessages,omitempty"`
+
+	// CreatedAt is the timestamp of the first status message of this runner.
+	// +optional
+	CreatedAt *metav1.Time `json:"createdAt,omitempty"`
+
+	// UpdatedAt is the timestamp of the last update to this runner.
+	// +optional
+	UpdatedAt *metav1.Time `json:"updatedAt,omitempty"`
 
-	//// UpdatedAt is the timestamp of the last update to this runner.
-	// UpdatedAt time.Time `json:"updated_at"`
+	// Job is the job which is currently running on this runner, if garm reports one.
+	// +optional
+	Job *RunnerJob `json:"job,omitempty"`
+
+	// Labels are the labels the runner is registered with on GitHub.
+	// +optional
+	Labels []string `json:"labels,omitempty"`
 
 	// GithubRunnerGroup is the github runner group to which the runner belongs.
 	// The runner group must be created by someone with access to the enterprise.
@@ -73,16 +93,67 @@ type RunnerStatus struct {
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
 }
 
+// RunnerStatusMessage is a status message sent back by the runner as it sets itself up.
+type RunnerStatusMessage struct {
+	// CreatedAt is the timestamp of the status message.
+	CreatedAt metav1.Time `json:"createdAt"`
+
+	// Message is the status message.
+	Message string `json:"message,omitempty"`
+
+	// EventType is the type of the status message. (status, ...)
+	EventType string `json:"eventType,omitempty"`
+
+	// EventLevel is the level of the status message. (info, warning, error)
+	EventLevel string `json:"eventLevel,omitempty"`
+}
+
+// RunnerJob is a GitHub workflow job which runs on a runner.
+type RunnerJob struct {
+	// ID is the GitHub ID of the job.
+	ID int64 `json:"id,omitempty"`
+
+	// RunID is the GitHub ID of the workflow run the job belongs to.
+	RunID int64 `json:"runId,omitempty"`
+
+	// Name is the name of the job.
+	Name string `json:"name,omitempty"`
+
+	// Status is the status of the job on GitHub. (queued, in_progress, completed)
+	Status string `json:"status,omitempty"`
+
+	// Repository is the repository the job has been triggered in, in the form of owner/name.
+	Repository string `json:"repository,omitempty"`
+
+	// StartedAt is the timestamp the job has been started.
+	// +optional
+	StartedAt *metav1.Time `json:"startedAt,omitempty"`
+}
+
+// InitializeConditions is a no-op, as runners don't have any conditions by default
+func (r *Runner) InitializeConditions() {}
+
+func (r *Runner) SetConditions(conditions []metav1.Condition) {
+	r.Status.Conditions = conditions
+}
+
+func (r *Runner) GetConditions() []metav1.Condition {
+	return r.Status.Conditions
+}
+
 //+kubebuilder:object:root=true
 //+kubebuilder:resource:path=runners,scope=Namespaced,categories=garm,shortName=run
 //+kubebuilder:subresource:status
 //+kubebuilder:storageversion
 //+kubebuilder:printcolumn:name="ID",type="string",JSONPath=".status.id",description="Runner ID"
-//+kubebuilder:printcolumn:name="Pool",type="string",JSONPath=".status.poolId",description="Pool CR Name"
+//+kubebuilder:printcolumn:name="Pool",type="string",JSONPath=".status.poolRef.name",description="Pool CR Name"
 //+kubebuilder:printcolumn:name="Garm Runner Status",type="string",JSONPath=".status.status",description="Garm Runner Status"
 //+kubebuilder:printcolumn:name="Provider Runner Status",type="string",JSONPath=".status.instanceStatus",description="Provider Runner Status"
 //+kubebuilder:printcolumn:name="Provider ID",type="string",JSONPath=".status.providerId",description="Provider ID",priority=1
 //+kubebuilder:printcolumn:name="Agent ID",type="string",JSONPath=".status.agentId",description="Agent ID",priority=1
+//+kubebuilder:printcolumn:name="Job",type="string",JSONPath=".status.job.name",description="Current Job",priority=1
+//+kubebuilder:printcolumn:name="Repository",type="string",JSONPath=".status.job.repository",description="Repository of the current Job",priority=1
+//+kubebuilder:printcolumn:name="Pool ID",type="string",JSONPath=".status.poolId",description="Garm Pool ID",priority=1
 //+kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 
 // Runner is the Schema for the runners API
@@ -0,0 +1,28 @@
+// SPDX-License-Identifier: MIT
+
+package v1beta1
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// validateScopeDeletion refuses the deletion of an enterprise, organization or repository
+// as long as it is referenced by pools which aren't being deleted.
+func validateScopeDeletion(ctx context.Context, scope client.Object, kind GitHubScopeKind) error {
+	name := fmt.Sprintf("%s %s", strings.ToLower(string(kind)), scope.GetName())
+
+	pools, err := PoolsReferencingScope(ctx, c, scope.GetNamespace(), kind, scope.GetName())
+	if err != nil {
+		return apierrors.NewBadRequest(fmt.Sprintf("%s can not be deleted, failed to fetch pools: %s", name, err.Error()))
+	}
+
+	if len(pools) > 0 {
+		return apierrors.NewBadRequest(fmt.Sprintf("%s can not be deleted, as it is still referenced by pools: %s", name, strings.Join(pools, ", ")))
+	}
+	return nil
+}
@@ -0,0 +1,93 @@
+// SPDX-License-Identifier: MIT
+
+package v1beta1
+
+import (
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func Test_validateScopeDeletion(t *testing.T) {
+	organization := &Organization{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-org",
+			Namespace: "default",
+		},
+	}
+
+	newPool := func(name, namespace, kind, scope string) *Pool {
+		return &Pool{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: namespace,
+			},
+			Spec: PoolSpec{
+				GitHubScopeRef: corev1.TypedLocalObjectReference{
+					Kind: kind,
+					Name: scope,
+				},
+			},
+		}
+	}
+
+	deletingPool := newPool("deleting-pool", "default", "Organization", "my-org")
+	deletingPool.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+	deletingPool.Finalizers = []string{"garm-operator.mercedes-benz.com/pool"}
+
+	tests := []struct {
+		name           string
+		runtimeObjects []runtime.Object
+		wantErr        bool
+	}{
+		{
+			name: "organization is not referenced by any pool",
+			runtimeObjects: []runtime.Object{
+				newPool("enterprise-pool", "default", "Enterprise", "my-org"),
+				newPool("other-namespace-pool", "other", "Organization", "my-org"),
+				newPool("other-org-pool", "default", "Organization", "other-org"),
+			},
+			wantErr: false,
+		},
+		{
+			name: "organization is referenced by a pool",
+			runtimeObjects: []runtime.Object{
+				newPool("org-pool", "default", "Organization", "my-org"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "organization is referenced by a pool which is in deletion",
+			runtimeObjects: []runtime.Object{
+				deletingPool,
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Register the types with the runtime scheme
+			schemeBuilder := runtime.SchemeBuilder{
+				AddToScheme,
+			}
+
+			err := schemeBuilder.AddToScheme(scheme.Scheme)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// Create a fake client with the provided runtime objects
+			c = fake.NewClientBuilder().WithScheme(scheme.Scheme).WithRuntimeObjects(tt.runtimeObjects...).Build()
+
+			err = validateScopeDeletion(t.Context(), organization, OrganizationScope)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("validateScopeDeletion() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -4,6 +4,8 @@ package v1beta1
 
 import (
 	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 type GitHubScopeKind string
@@ -33,6 +35,17 @@ func ToGitHubScopeKind(kind string) (GitHubScopeKind, error) {
 	}
 }
 
+// DeletionPolicy decides what happens to the entity in GARM when an enterprise, organization or repository is deleted.
+// +kubebuilder:validation:Enum=Delete;Orphan
+type DeletionPolicy string
+
+const (
+	// DeletionPolicyDelete deletes the entity in GARM.
+	DeletionPolicyDelete DeletionPolicy = "Delete"
+	// DeletionPolicyOrphan leaves the entity and its webhook in GARM untouched.
+	DeletionPolicyOrphan DeletionPolicy = "Orphan"
+)
+
 type SecretRef struct {
 	// Name of the kubernetes secret to use
 	Name string `json:"name"`
@@ -40,6 +53,46 @@ type SecretRef struct {
 	Key string `json:"key"`
 }
 
+// WebhookSecretSpec configures a webhook secret which is generated by the operator.
+type WebhookSecretSpec struct {
+	// Generate lets the operator generate a random webhook secret and store it in the secret referenced by
+	// webhookSecretRef. The secret gets created if it doesn't exist and is owned by the resource.
+	// +optional
+	Generate bool `json:"generate,omitempty"`
+
+	// RotationInterval is the interval after which a generated webhook secret gets rotated.
+	// The webhook secret is only rotated on request if not set.
+	// +optional
+	RotationInterval *metav1.Duration `json:"rotationInterval,omitempty"`
+}
+
+// WebhookSpec configures the GitHub webhook which sends workflow job events to GARM.
+type WebhookSpec struct {
+	// Install lets GARM install the webhook on GitHub, pointing to the webhook URL of the GARM controller.
+	// The webhook gets removed from GitHub if install is disabled again or the resource gets deleted.
+	// +optional
+	Install bool `json:"install,omitempty"`
+
+	// InsecureSSL disables the TLS verification when GitHub delivers events to GARM.
+	// +optional
+	InsecureSSL bool `json:"insecureSSL,omitempty"`
+}
+
+// WebhookStatus is the observed state of the GitHub webhook installed by GARM.
+type WebhookStatus struct {
+	// ID is the GitHub ID of the webhook.
+	ID int64 `json:"id"`
+	// URL is the URL GitHub delivers the events to.
+	URL string `json:"url,omitempty"`
+	// Active reports whether GitHub delivers events to the webhook.
+	Active bool `json:"active"`
+	// InsecureSSL reports whether TLS verification is disabled for the webhook.
+	InsecureSSL bool `json:"insecureSSL,omitempty"`
+	// SecretOutdated reports that the webhook still uses a webhook secret which has been rotated since.
+	// The webhook gets reinstalled to pick up the new webhook secret.
+	SecretOutdated bool `json:"secretOutdated,omitempty"`
+}
+
 const (
 	TrueAsString  = "True"
 	FalseAsString = "False"
@@ -8,6 +8,7 @@ package v1beta1
 
 import (
 	"github.com/cloudbase/garm-provider-common/params"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
@@ -76,6 +77,16 @@ func (in *EnterpriseSpec) DeepCopyInto(out *EnterpriseSpec) {
 	*out = *in
 	in.CredentialsRef.DeepCopyInto(&out.CredentialsRef)
 	out.WebhookSecretRef = in.WebhookSecretRef
+	if in.WebhookSecret != nil {
+		in, out := &in.WebhookSecret, &out.WebhookSecret
+		*out = new(WebhookSecretSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GarmServerRef != nil {
+		in, out := &in.GarmServerRef, &out.GarmServerRef
+		*out = new(corev1.LocalObjectReference)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnterpriseSpec.
@@ -110,6 +121,33 @@ func (in *EnterpriseStatus) DeepCopy() *EnterpriseStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GarmServer) DeepCopyInto(out *GarmServer) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GarmServer.
+func (in *GarmServer) DeepCopy() *GarmServer {
+	if in == nil {
+		return nil
+	}
+	out := new(GarmServer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GarmServer) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GarmServerConfig) DeepCopyInto(out *GarmServerConfig) {
 	*out = *in
@@ -206,6 +244,78 @@ func (in *GarmServerConfigStatus) DeepCopy() *GarmServerConfigStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GarmServerList) DeepCopyInto(out *GarmServerList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]GarmServer, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GarmServerList.
+func (in *GarmServerList) DeepCopy() *GarmServerList {
+	if in == nil {
+		return nil
+	}
+	out := new(GarmServerList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GarmServerList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GarmServerSpec) DeepCopyInto(out *GarmServerSpec) {
+	*out = *in
+	out.UsernameSecretRef = in.UsernameSecretRef
+	out.PasswordSecretRef = in.PasswordSecretRef
+	out.CACertBundleSecretRef = in.CACertBundleSecretRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GarmServerSpec.
+func (in *GarmServerSpec) DeepCopy() *GarmServerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GarmServerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GarmServerStatus) DeepCopyInto(out *GarmServerStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GarmServerStatus.
+func (in *GarmServerStatus) DeepCopy() *GarmServerStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(GarmServerStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GitHubCredential) DeepCopyInto(out *GitHubCredential) {
 	*out = *in
@@ -270,6 +380,11 @@ func (in *GitHubCredentialSpec) DeepCopyInto(out *GitHubCredentialSpec) {
 	*out = *in
 	in.EndpointRef.DeepCopyInto(&out.EndpointRef)
 	out.SecretRef = in.SecretRef
+	if in.GarmServerRef != nil {
+		in, out := &in.GarmServerRef, &out.GarmServerRef
+		*out = new(corev1.LocalObjectReference)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitHubCredentialSpec.
@@ -382,6 +497,11 @@ func (in *GitHubEndpointList) DeepCopyObject() runtime.Object {
 func (in *GitHubEndpointSpec) DeepCopyInto(out *GitHubEndpointSpec) {
 	*out = *in
 	out.CACertBundleSecretRef = in.CACertBundleSecretRef
+	if in.GarmServerRef != nil {
+		in, out := &in.GarmServerRef, &out.GarmServerRef
+		*out = new(corev1.LocalObjectReference)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitHubEndpointSpec.
@@ -584,6 +704,21 @@ func (in *OrganizationSpec) DeepCopyInto(out *OrganizationSpec) {
 	*out = *in
 	in.CredentialsRef.DeepCopyInto(&out.CredentialsRef)
 	out.WebhookSecretRef = in.WebhookSecretRef
+	if in.WebhookSecret != nil {
+		in, out := &in.WebhookSecret, &out.WebhookSecret
+		*out = new(WebhookSecretSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GarmServerRef != nil {
+		in, out := &in.GarmServerRef, &out.GarmServerRef
+		*out = new(corev1.LocalObjectReference)
+		**out = **in
+	}
+	if in.Webhook != nil {
+		in, out := &in.Webhook, &out.Webhook
+		*out = new(WebhookSpec)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationSpec.
@@ -606,6 +741,11 @@ func (in *OrganizationStatus) DeepCopyInto(out *OrganizationStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Webhook != nil {
+		in, out := &in.Webhook, &out.Webhook
+		*out = new(WebhookStatus)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationStatus.
@@ -677,6 +817,170 @@ func (in *PoolList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PoolSchedule) DeepCopyInto(out *PoolSchedule) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PoolSchedule.
+func (in *PoolSchedule) DeepCopy() *PoolSchedule {
+	if in == nil {
+		return nil
+	}
+	out := new(PoolSchedule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PoolSchedule) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PoolScheduleList) DeepCopyInto(out *PoolScheduleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]PoolSchedule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PoolScheduleList.
+func (in *PoolScheduleList) DeepCopy() *PoolScheduleList {
+	if in == nil {
+		return nil
+	}
+	out := new(PoolScheduleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PoolScheduleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PoolScheduleSpec) DeepCopyInto(out *PoolScheduleSpec) {
+	*out = *in
+	if in.PoolRefs != nil {
+		in, out := &in.PoolRefs, &out.PoolRefs
+		*out = make([]corev1.LocalObjectReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.Windows != nil {
+		in, out := &in.Windows, &out.Windows
+		*out = make([]PoolScheduleWindow, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Holidays != nil {
+		in, out := &in.Holidays, &out.Holidays
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Default != nil {
+		in, out := &in.Default, &out.Default
+		*out = new(PoolScheduleValues)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PoolScheduleSpec.
+func (in *PoolScheduleSpec) DeepCopy() *PoolScheduleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PoolScheduleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PoolScheduleStatus) DeepCopyInto(out *PoolScheduleStatus) {
+	*out = *in
+	if in.NextTransitionTime != nil {
+		in, out := &in.NextTransitionTime, &out.NextTransitionTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PoolScheduleStatus.
+func (in *PoolScheduleStatus) DeepCopy() *PoolScheduleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PoolScheduleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PoolScheduleValues) DeepCopyInto(out *PoolScheduleValues) {
+	*out = *in
+	if in.MinIdleRunners != nil {
+		in, out := &in.MinIdleRunners, &out.MinIdleRunners
+		*out = new(uint)
+		**out = **in
+	}
+	if in.MaxRunners != nil {
+		in, out := &in.MaxRunners, &out.MaxRunners
+		*out = new(uint)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PoolScheduleValues.
+func (in *PoolScheduleValues) DeepCopy() *PoolScheduleValues {
+	if in == nil {
+		return nil
+	}
+	out := new(PoolScheduleValues)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PoolScheduleWindow) DeepCopyInto(out *PoolScheduleWindow) {
+	*out = *in
+	out.Duration = in.Duration
+	in.PoolScheduleValues.DeepCopyInto(&out.PoolScheduleValues)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PoolScheduleWindow.
+func (in *PoolScheduleWindow) DeepCopy() *PoolScheduleWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(PoolScheduleWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PoolSpec) DeepCopyInto(out *PoolSpec) {
 	*out = *in
@@ -686,6 +990,31 @@ func (in *PoolSpec) DeepCopyInto(out *PoolSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.GarmServerRef != nil {
+		in, out := &in.GarmServerRef, &out.GarmServerRef
+		*out = new(corev1.LocalObjectReference)
+		**out = **in
+	}
+	if in.DrainTimeout != nil {
+		in, out := &in.DrainTimeout, &out.DrainTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.RunnerLifecycle != nil {
+		in, out := &in.RunnerLifecycle, &out.RunnerLifecycle
+		*out = new(RunnerLifecycle)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ScaleDown != nil {
+		in, out := &in.ScaleDown, &out.ScaleDown
+		*out = new(ScaleDownPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RunnerRemediation != nil {
+		in, out := &in.RunnerRemediation, &out.RunnerRemediation
+		*out = new(RunnerRemediation)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PoolSpec.
@@ -701,6 +1030,23 @@ func (in *PoolSpec) DeepCopy() *PoolSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PoolStatus) DeepCopyInto(out *PoolStatus) {
 	*out = *in
+	if in.LastScaleDownTime != nil {
+		in, out := &in.LastScaleDownTime, &out.LastScaleDownTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastDriftedFields != nil {
+		in, out := &in.LastDriftedFields, &out.LastDriftedFields
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.LastRecycleTime != nil {
+		in, out := &in.LastRecycleTime, &out.LastRecycleTime
+		*out = (*in).DeepCopy()
+	}
+	if in.RemediationWindowStart != nil {
+		in, out := &in.RemediationWindowStart, &out.RemediationWindowStart
+		*out = (*in).DeepCopy()
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make([]v1.Condition, len(*in))
@@ -799,6 +1145,21 @@ func (in *RepositorySpec) DeepCopyInto(out *RepositorySpec) {
 	*out = *in
 	in.CredentialsRef.DeepCopyInto(&out.CredentialsRef)
 	out.WebhookSecretRef = in.WebhookSecretRef
+	if in.WebhookSecret != nil {
+		in, out := &in.WebhookSecret, &out.WebhookSecret
+		*out = new(WebhookSecretSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GarmServerRef != nil {
+		in, out := &in.GarmServerRef, &out.GarmServerRef
+		*out = new(corev1.LocalObjectReference)
+		**out = **in
+	}
+	if in.Webhook != nil {
+		in, out := &in.Webhook, &out.Webhook
+		*out = new(WebhookSpec)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RepositorySpec.
@@ -821,6 +1182,11 @@ func (in *RepositoryStatus) DeepCopyInto(out *RepositoryStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Webhook != nil {
+		in, out := &in.Webhook, &out.Webhook
+		*out = new(WebhookStatus)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RepositoryStatus.
@@ -875,6 +1241,55 @@ func (in *Runner) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RunnerLifecycle) DeepCopyInto(out *RunnerLifecycle) {
+	*out = *in
+	if in.MaxIdleAge != nil {
+		in, out := &in.MaxIdleAge, &out.MaxIdleAge
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.MaxLifetime != nil {
+		in, out := &in.MaxLifetime, &out.MaxLifetime
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.BatchInterval != nil {
+		in, out := &in.BatchInterval, &out.BatchInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RunnerLifecycle.
+func (in *RunnerLifecycle) DeepCopy() *RunnerLifecycle {
+	if in == nil {
+		return nil
+	}
+	out := new(RunnerLifecycle)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RunnerJob) DeepCopyInto(out *RunnerJob) {
+	*out = *in
+	if in.StartedAt != nil {
+		in, out := &in.StartedAt, &out.StartedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RunnerJob.
+func (in *RunnerJob) DeepCopy() *RunnerJob {
+	if in == nil {
+		return nil
+	}
+	out := new(RunnerJob)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RunnerList) DeepCopyInto(out *RunnerList) {
 	*out = *in
@@ -907,6 +1322,31 @@ func (in *RunnerList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RunnerRemediation) DeepCopyInto(out *RunnerRemediation) {
+	*out = *in
+	if in.MaxRetries != nil {
+		in, out := &in.MaxRetries, &out.MaxRetries
+		*out = new(uint)
+		**out = **in
+	}
+	if in.RetryWindow != nil {
+		in, out := &in.RetryWindow, &out.RetryWindow
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RunnerRemediation.
+func (in *RunnerRemediation) DeepCopy() *RunnerRemediation {
+	if in == nil {
+		return nil
+	}
+	out := new(RunnerRemediation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RunnerSpec) DeepCopyInto(out *RunnerSpec) {
 	*out = *in
@@ -930,6 +1370,36 @@ func (in *RunnerStatus) DeepCopyInto(out *RunnerStatus) {
 		*out = make([]params.Address, len(*in))
 		copy(*out, *in)
 	}
+	if in.PoolRef != nil {
+		in, out := &in.PoolRef, &out.PoolRef
+		*out = new(corev1.LocalObjectReference)
+		**out = **in
+	}
+	if in.StatusMessages != nil {
+		in, out := &in.StatusMessages, &out.StatusMessages
+		*out = make([]RunnerStatusMessage, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.CreatedAt != nil {
+		in, out := &in.CreatedAt, &out.CreatedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.UpdatedAt != nil {
+		in, out := &in.UpdatedAt, &out.UpdatedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.Job != nil {
+		in, out := &in.Job, &out.Job
+		*out = new(RunnerJob)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make([]v1.Condition, len(*in))
@@ -949,6 +1419,47 @@ func (in *RunnerStatus) DeepCopy() *RunnerStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RunnerStatusMessage) DeepCopyInto(out *RunnerStatusMessage) {
+	*out = *in
+	in.CreatedAt.DeepCopyInto(&out.CreatedAt)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RunnerStatusMessage.
+func (in *RunnerStatusMessage) DeepCopy() *RunnerStatusMessage {
+	if in == nil {
+		return nil
+	}
+	out := new(RunnerStatusMessage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScaleDownPolicy) DeepCopyInto(out *ScaleDownPolicy) {
+	*out = *in
+	if in.MinIdleRunnersAge != nil {
+		in, out := &in.MinIdleRunnersAge, &out.MinIdleRunnersAge
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Cooldown != nil {
+		in, out := &in.Cooldown, &out.Cooldown
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScaleDownPolicy.
+func (in *ScaleDownPolicy) DeepCopy() *ScaleDownPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ScaleDownPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SecretRef) DeepCopyInto(out *SecretRef) {
 	*out = *in
@@ -963,3 +1474,53 @@ func (in *SecretRef) DeepCopy() *SecretRef {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebhookSecretSpec) DeepCopyInto(out *WebhookSecretSpec) {
+	*out = *in
+	if in.RotationInterval != nil {
+		in, out := &in.RotationInterval, &out.RotationInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebhookSecretSpec.
+func (in *WebhookSecretSpec) DeepCopy() *WebhookSecretSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(WebhookSecretSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebhookSpec) DeepCopyInto(out *WebhookSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebhookSpec.
+func (in *WebhookSpec) DeepCopy() *WebhookSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(WebhookSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebhookStatus) DeepCopyInto(out *WebhookStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebhookStatus.
+func (in *WebhookStatus) DeepCopy() *WebhookStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(WebhookStatus)
+	in.DeepCopyInto(out)
+	return out
+}
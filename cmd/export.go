@@ -0,0 +1,66 @@
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/pflag"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/mercedes-benz/garm-operator/pkg/client"
+	"github.com/mercedes-benz/garm-operator/pkg/export"
+)
+
+const exportCommand = "export"
+
+// runExport writes the manifests of all resources of an existing GARM server,
+// so they can be applied to let the operator take over the GARM server.
+func runExport(args []string) error {
+	f := pflag.NewFlagSet(exportCommand, pflag.ContinueOnError)
+	server := f.String("garm-server", os.Getenv("GARM_SERVER"), "The address of the GARM server")
+	username := f.String("garm-username", os.Getenv("GARM_USERNAME"), "The username for the GARM server")
+	password := f.String("garm-password", os.Getenv("GARM_PASSWORD"), "The password for the GARM server")
+	namespace := f.String("namespace", "garm-operator-system", "The namespace of the generated resources")
+	output := f.StringP("output", "o", "", "The file to write the manifests to (default stdout)")
+
+	if err := f.Parse(args); err != nil {
+		return err
+	}
+
+	if *server == "" || *username == "" || *password == "" {
+		return errors.New("garm-server, garm-username and garm-password must be set")
+	}
+
+	if err := client.CreateInstance(client.GarmScopeParams{
+		BaseURL:  *server,
+		Username: *username,
+		Password: *password,
+	}); err != nil {
+		return fmt.Errorf("unable to setup garm: %w", err)
+	}
+
+	objects, err := export.Export(ctrl.SetupSignalHandler(), export.NewClients(client.Client), *namespace)
+	if err != nil {
+		return fmt.Errorf("unable to export resources: %w", err)
+	}
+
+	var w io.Writer = os.Stdout
+	if *output != "" {
+		file, err := os.Create(*output)
+		if err != nil {
+			return fmt.Errorf("unable to create output file: %w", err)
+		}
+		defer file.Close()
+		w = file
+	}
+
+	if err := export.WriteManifests(w, objects); err != nil {
+		return fmt.Errorf("unable to write manifests: %w", err)
+	}
+
+	return nil
+}
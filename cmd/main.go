@@ -6,6 +6,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"os"
 
 	"gopkg.in/yaml.v2"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -27,6 +28,7 @@ import (
 	"github.com/mercedes-benz/garm-operator/pkg/client"
 	"github.com/mercedes-benz/garm-operator/pkg/config"
 	"github.com/mercedes-benz/garm-operator/pkg/flags"
+	runnerUtil "github.com/mercedes-benz/garm-operator/pkg/runners"
 	"github.com/mercedes-benz/garm-operator/pkg/version"
 )
 
@@ -50,6 +52,11 @@ func main() {
 }
 
 func run() error {
+	// the export command generates manifests from an existing garm server instead of running the operator
+	if len(os.Args) > 1 && os.Args[1] == exportCommand {
+		return runExport(os.Args[2:])
+	}
+
 	// initiate flags
 	f := flags.InitiateFlags()
 
@@ -126,13 +133,21 @@ func run() error {
 		Username: config.Config.Garm.Username,
 		Password: config.Config.Garm.Password,
 		Email:    config.Config.Garm.Email,
+		Transport: client.TransportConfig{
+			RateLimit:               config.Config.Garm.RateLimit,
+			RateLimitBurst:          config.Config.Garm.RateLimitBurst,
+			MaxRetries:              config.Config.Garm.MaxRetries,
+			RetryBackoff:            config.Config.Garm.RetryBackoff,
+			CircuitBreakerThreshold: config.Config.Garm.CircuitBreakerThreshold,
+			CircuitBreakerTimeout:   config.Config.Garm.CircuitBreakerTimeout,
+		},
 	}); err != nil {
 		return fmt.Errorf("unable to setup garm: %w", err)
 	}
 
 	// create a controller client to get controller info
 	// for the version check
-	controllerClient := client.NewControllerClient()
+	controllerClient := client.NewControllerClient(client.Client)
 	controllerInfo, err := controllerClient.GetControllerInfo()
 	if err != nil {
 		return fmt.Errorf("unable to get controller info: %w", err)
@@ -156,10 +171,14 @@ func run() error {
 		return fmt.Errorf("unable to create controller Enterprise: %w", err)
 	}
 
+	// jobs of the runners are observed by both the pool and the runner controller
+	jobTracker := runnerUtil.NewJobTracker()
+
 	if err = (&garmcontroller.PoolReconciler{
 		Client:   mgr.GetClient(),
 		Scheme:   mgr.GetScheme(),
 		Recorder: mgr.GetEventRecorderFor("pool-controller"),
+		Jobs:     jobTracker,
 	}).SetupWithManager(mgr,
 		controller.Options{
 			MaxConcurrentReconciles: config.Config.Operator.PoolConcurrency,
@@ -197,6 +216,7 @@ func run() error {
 			Client:        mgr.GetClient(),
 			Scheme:        mgr.GetScheme(),
 			ReconcileChan: make(chan event.GenericEvent),
+			Jobs:          jobTracker,
 		}
 
 		// setup controller so it can reconcile if events from runnerEvents are queued
@@ -208,10 +228,29 @@ func run() error {
 			return fmt.Errorf("unable to create controller Runner: %w", err)
 		}
 
-		// fetch runner instances periodically and enqueue reconcile events for runner ctrl if external system has changed
 		ctx, cancel := context.WithCancel(ctx)
-		go runnerReconciler.PollRunnerInstances(ctx)
 		defer cancel()
+
+		if config.Config.Operator.RunnerEventStream {
+			// consume instance events from the default garm server and fall back to polling if the event stream is disconnected,
+			// runners of additional garm servers are always polled
+			eventStream := client.NewEventStream(client.Client,
+				client.EventFilter{EntityType: client.InstanceEntityType},
+				client.EventFilter{EntityType: client.PoolEntityType, Operations: []string{client.DeleteOperation}},
+			)
+			go runnerReconciler.WatchRunnerInstances(ctx, eventStream)
+		} else {
+			// fetch runner instances periodically and enqueue reconcile events for runner ctrl if external system has changed
+			go runnerReconciler.PollRunnerInstances(ctx)
+		}
+	}
+
+	if err = (&garmcontroller.GarmServerReconciler{
+		Client:   mgr.GetClient(),
+		Scheme:   mgr.GetScheme(),
+		Recorder: mgr.GetEventRecorderFor("garm-server-controller"),
+	}).SetupWithManager(mgr); err != nil {
+		return fmt.Errorf("unable to create controller GarmServer: %w", err)
 	}
 
 	if err = (&garmcontroller.GarmServerConfigReconciler{
@@ -238,7 +277,23 @@ func run() error {
 		return fmt.Errorf("unable to create controller GitHubCredential: %w", err)
 	}
 
+	if err = (&garmcontroller.PoolScheduleReconciler{
+		Client:   mgr.GetClient(),
+		Scheme:   mgr.GetScheme(),
+		Recorder: mgr.GetEventRecorderFor("pool-schedule-controller"),
+	}).SetupWithManager(mgr); err != nil {
+		return fmt.Errorf("unable to create controller PoolSchedule: %w", err)
+	}
+
 	// webhooks
+	if err = (&garmoperatorv1beta1.Enterprise{}).SetupWebhookWithManager(mgr); err != nil {
+		return fmt.Errorf("unable to create webhook Enterprise: %w", err)
+	}
+
+	if err = (&garmoperatorv1beta1.Organization{}).SetupWebhookWithManager(mgr); err != nil {
+		return fmt.Errorf("unable to create webhook Organization: %w", err)
+	}
+
 	if err = (&garmoperatorv1beta1.Repository{}).SetupWebhookWithManager(mgr); err != nil {
 		return fmt.Errorf("unable to create webhook Repository: %w", err)
 	}
@@ -20,6 +20,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
 	garmoperatorv1beta1 "github.com/mercedes-benz/garm-operator/api/v1beta1"
+	garmClient "github.com/mercedes-benz/garm-operator/pkg/client"
 	"github.com/mercedes-benz/garm-operator/pkg/client/key"
 	"github.com/mercedes-benz/garm-operator/pkg/client/mock"
 	"github.com/mercedes-benz/garm-operator/pkg/conditions"
@@ -948,7 +949,7 @@ func TestEnterpriseReconciler_reconcileNormal(t *testing.T) {
 
 			enterprise.InitializeConditions()
 
-			_, err = reconciler.reconcileNormal(context.Background(), mockEnterpriseClient, enterprise)
+			_, err = reconciler.scopeReconciler().reconcileNormal(context.Background(), garmClient.NewEnterpriseScopeClient(mockEnterpriseClient), enterprise)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("EnterpriseReconciler.reconcileNormal() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -1068,7 +1069,7 @@ func TestEnterpriseReconciler_reconcileDelete(t *testing.T) {
 			mockEnterpriseClient := mock.NewMockEnterpriseClient(mockCtrl)
 			tt.expectGarmRequest(mockEnterpriseClient.EXPECT())
 
-			_, err = reconciler.reconcileDelete(context.Background(), mockEnterpriseClient, enterprise)
+			_, err = reconciler.scopeReconciler().reconcileDelete(context.Background(), garmClient.NewEnterpriseScopeClient(mockEnterpriseClient), mock.NewMockPoolClient(mockCtrl), enterprise)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("EnterpriseReconciler.reconcileDelete() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -0,0 +1,376 @@
+// SPDX-License-Identifier: MIT
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	garmoperatorv1beta1 "github.com/mercedes-benz/garm-operator/api/v1beta1"
+	"github.com/mercedes-benz/garm-operator/pkg/annotations"
+	garmClient "github.com/mercedes-benz/garm-operator/pkg/client"
+	"github.com/mercedes-benz/garm-operator/pkg/client/key"
+	"github.com/mercedes-benz/garm-operator/pkg/conditions"
+	"github.com/mercedes-benz/garm-operator/pkg/config"
+	"github.com/mercedes-benz/garm-operator/pkg/event"
+	"github.com/mercedes-benz/garm-operator/pkg/finalizers"
+	"github.com/mercedes-benz/garm-operator/pkg/secret"
+	"github.com/mercedes-benz/garm-operator/pkg/version"
+)
+
+// GarmServerReconciler reconciles a GarmServer object
+type GarmServerReconciler struct {
+	client.Client
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
+}
+
+//+kubebuilder:rbac:groups=core,resources=events,verbs=create;patch
+//+kubebuilder:rbac:groups=garm-operator.mercedes-benz.com,namespace=xxxxx,resources=garmservers,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=garm-operator.mercedes-benz.com,namespace=xxxxx,resources=garmservers/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=garm-operator.mercedes-benz.com,namespace=xxxxx,resources=garmservers/finalizers,verbs=update
+// +kubebuilder:rbac:groups="",namespace=xxxxx,resources=secrets,verbs=get;list;watch;
+
+func (r *GarmServerReconciler) Reconcile(ctx context.Context, req ctrl.Request) (res ctrl.Result, retErr error) {
+	log := log.FromContext(ctx)
+
+	garmServer := &garmoperatorv1beta1.GarmServer{}
+	if err := r.Get(ctx, req.NamespacedName, garmServer); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.Info("GarmServer resource not found.")
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, err
+	}
+
+	initialGarmServer := garmServer.DeepCopy()
+
+	// Ignore objects that are paused
+	if annotations.IsPaused(garmServer) {
+		log.Info("Reconciliation is paused for this object")
+		return ctrl.Result{}, nil
+	}
+
+	// ensure the finalizer
+	if finalizerAdded, err := finalizers.EnsureFinalizer(ctx, r.Client, garmServer, key.GarmServerFinalizerName); err != nil || finalizerAdded {
+		return ctrl.Result{}, err
+	}
+
+	// Initialize conditions to unknown if not set already
+	garmServer.InitializeConditions()
+
+	// always update the status
+	defer func() {
+		if !reflect.DeepEqual(garmServer.Status, initialGarmServer.Status) {
+			if err := r.Status().Update(ctx, garmServer); err != nil {
+				log.Error(err, "failed to update status")
+				res = ctrl.Result{}
+				retErr = err
+			}
+		}
+	}()
+
+	// Handle deleted garm servers
+	if !garmServer.DeletionTimestamp.IsZero() {
+		return r.reconcileDelete(ctx, garmServer)
+	}
+
+	return r.reconcileNormal(ctx, garmServer)
+}
+
+func (r *GarmServerReconciler) reconcileNormal(ctx context.Context, garmServer *garmoperatorv1beta1.GarmServer) (ctrl.Result, error) {
+	log := log.FromContext(ctx)
+	log.WithValues("garmServer", garmServer.Name)
+
+	garmParams, err := r.getGarmScopeParams(ctx, garmServer)
+	if err != nil {
+		event.Error(r.Recorder, garmServer, err.Error())
+		conditions.MarkFalse(garmServer, conditions.ReadyCondition, conditions.FetchingSecretRefFailedReason, err.Error())
+		conditions.MarkFalse(garmServer, conditions.SecretReference, conditions.FetchingSecretRefFailedReason, err.Error())
+		return ctrl.Result{}, err
+	}
+	conditions.MarkTrue(garmServer, conditions.SecretReference, conditions.FetchingSecretRefSuccessReason, "")
+
+	name := garmServerName(garmServer.Namespace, garmServer.Name)
+	client, err := garmClient.RegisterServer(name, garmParams)
+	if err != nil {
+		event.Error(r.Recorder, garmServer, err.Error())
+		conditions.MarkFalse(garmServer, conditions.ReadyCondition, conditions.LoginFailedReason, err.Error())
+		return ctrl.Result{}, err
+	}
+
+	controllerInfo, err := garmClient.NewControllerClient(client).GetControllerInfo()
+	if err != nil {
+		event.Error(r.Recorder, garmServer, err.Error())
+		conditions.MarkFalse(garmServer, conditions.ReadyCondition, conditions.GarmAPIErrorReason, err.Error())
+		return ctrl.Result{}, err
+	}
+	garmServer.Status.ControllerID = controllerInfo.Payload.ControllerID.String()
+	garmServer.Status.Version = controllerInfo.Payload.Version
+
+	// resources must not be reconciled against an incompatible server, so the client is removed again
+	if !version.EnsureMinimalVersion(controllerInfo.Payload.Version) {
+		garmClient.UnregisterServer(name)
+		msg := fmt.Sprintf("garm-operator is not compatible with Garm version %s. Minimal required version is %s", controllerInfo.Payload.Version, version.MinVersion)
+		event.Error(r.Recorder, garmServer, msg)
+		conditions.MarkFalse(garmServer, conditions.ReadyCondition, conditions.IncompatibleVersionReason, msg)
+		return ctrl.Result{}, nil
+	}
+
+	conditions.MarkTrue(garmServer, conditions.ReadyCondition, conditions.SuccessfulReconcileReason, "")
+	log.Info("reconciling garm server successfully done", "version", garmServer.Status.Version)
+
+	return ctrl.Result{}, nil
+}
+
+func (r *GarmServerReconciler) reconcileDelete(ctx context.Context, garmServer *garmoperatorv1beta1.GarmServer) (ctrl.Result, error) {
+	log := log.FromContext(ctx)
+	log.WithValues("garmServer", garmServer.Name)
+
+	// resources which are still managed by this server could not be deleted in garm anymore
+	referencedBy, err := r.getReferencingResources(ctx, garmServer)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if len(referencedBy) > 0 {
+		msg := fmt.Sprintf("GarmServer is still referenced by %s", strings.Join(referencedBy, ", "))
+		log.Info(msg)
+		conditions.MarkFalse(garmServer, conditions.ReadyCondition, conditions.DeletionFailedReason, msg)
+		return ctrl.Result{RequeueAfter: 30 * time.Second}, nil
+	}
+
+	log.Info("starting garm server deletion")
+	event.Deleting(r.Recorder, garmServer, "starting garm server deletion")
+	conditions.MarkFalse(garmServer, conditions.ReadyCondition, conditions.DeletingReason, "Deleting garm server")
+
+	garmClient.UnregisterServer(garmServerName(garmServer.Namespace, garmServer.Name))
+
+	if controllerutil.ContainsFinalizer(garmServer, key.GarmServerFinalizerName) {
+		controllerutil.RemoveFinalizer(garmServer, key.GarmServerFinalizerName)
+		if err := r.Update(ctx, garmServer); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	log.Info("garm server deletion done")
+
+	return ctrl.Result{}, nil
+}
+
+func (r *GarmServerReconciler) getGarmScopeParams(ctx context.Context, garmServer *garmoperatorv1beta1.GarmServer) (garmClient.GarmScopeParams, error) {
+	username, err := secret.FetchRef(ctx, r.Client, &garmServer.Spec.UsernameSecretRef, garmServer.Namespace)
+	if err != nil {
+		return garmClient.GarmScopeParams{}, err
+	}
+
+	password, err := secret.FetchRef(ctx, r.Client, &garmServer.Spec.PasswordSecretRef, garmServer.Namespace)
+	if err != nil {
+		return garmClient.GarmScopeParams{}, err
+	}
+
+	garmParams := garmClient.GarmScopeParams{
+		BaseURL:  garmServer.Spec.URL,
+		Username: username,
+		Password: password,
+		Email:    garmServer.Spec.Email,
+		// the rate limit, retries and circuit breaker are configured on the operator for all garm servers
+		Transport: garmClient.TransportConfig{
+			RateLimit:               config.Config.Garm.RateLimit,
+			RateLimitBurst:          config.Config.Garm.RateLimitBurst,
+			MaxRetries:              config.Config.Garm.MaxRetries,
+			RetryBackoff:            config.Config.Garm.RetryBackoff,
+			CircuitBreakerThreshold: config.Config.Garm.CircuitBreakerThreshold,
+			CircuitBreakerTimeout:   config.Config.Garm.CircuitBreakerTimeout,
+		},
+	}
+
+	// as caCertBundle is optional it is only fetched if set
+	if !reflect.ValueOf(garmServer.Spec.CACertBundleSecretRef).IsZero() {
+		caCertBundle, err := secret.FetchRef(ctx, r.Client, &garmServer.Spec.CACertBundleSecretRef, garmServer.Namespace)
+		if err != nil {
+			return garmClient.GarmScopeParams{}, err
+		}
+		garmParams.CACertBundle = []byte(caCertBundle)
+	}
+
+	return garmParams, nil
+}
+
+// getReferencingResources returns the kind and name of all resources which reference the GarmServer
+func (r *GarmServerReconciler) getReferencingResources(ctx context.Context, garmServer *garmoperatorv1beta1.GarmServer) ([]string, error) {
+	lists := []struct {
+		kind string
+		list client.ObjectList
+	}{
+		{kind: "GitHubEndpoint", list: &garmoperatorv1beta1.GitHubEndpointList{}},
+		{kind: "GitHubCredential", list: &garmoperatorv1beta1.GitHubCredentialList{}},
+		{kind: "Enterprise", list: &garmoperatorv1beta1.EnterpriseList{}},
+		{kind: "Organization", list: &garmoperatorv1beta1.OrganizationList{}},
+		{kind: "Repository", list: &garmoperatorv1beta1.RepositoryList{}},
+		{kind: "Pool", list: &garmoperatorv1beta1.PoolList{}},
+	}
+
+	var referencedBy []string
+	for _, l := range lists {
+		if err := r.List(ctx, l.list, client.InNamespace(garmServer.Namespace)); err != nil {
+			return nil, err
+		}
+
+		for _, name := range garmServerRefsOf(l.list)[garmServer.Name] {
+			referencedBy = append(referencedBy, fmt.Sprintf("%s %s", l.kind, name))
+		}
+	}
+
+	return referencedBy, nil
+}
+
+// garmServerRefsOf returns the names of the resources in list grouped by their referenced GarmServer
+func garmServerRefsOf(list client.ObjectList) map[string][]string {
+	refs := map[string][]string{}
+	add := func(ref *corev1.LocalObjectReference, name string) {
+		if ref != nil && ref.Name != "" {
+			refs[ref.Name] = append(refs[ref.Name], name)
+		}
+	}
+
+	switch l := list.(type) {
+	case *garmoperatorv1beta1.GitHubEndpointList:
+		for _, item := range l.Items {
+			add(item.Spec.GarmServerRef, item.Name)
+		}
+	case *garmoperatorv1beta1.GitHubCredentialList:
+		for _, item := range l.Items {
+			add(item.Spec.GarmServerRef, item.Name)
+		}
+	case *garmoperatorv1beta1.EnterpriseList:
+		for _, item := range l.Items {
+			add(item.Spec.GarmServerRef, item.Name)
+		}
+	case *garmoperatorv1beta1.OrganizationList:
+		for _, item := range l.Items {
+			add(item.Spec.GarmServerRef, item.Name)
+		}
+	case *garmoperatorv1beta1.RepositoryList:
+		for _, item := range l.Items {
+			add(item.Spec.GarmServerRef, item.Name)
+		}
+	case *garmoperatorv1beta1.PoolList:
+		for _, item := range l.Items {
+			add(item.Spec.GarmServerRef, item.Name)
+		}
+	}
+
+	return refs
+}
+
+func (r *GarmServerReconciler) findGarmServersForSecret(ctx context.Context, obj client.Object) []reconcile.Request {
+	secretObj, ok := obj.(*corev1.Secret)
+	if !ok {
+		return nil
+	}
+
+	var garmServers garmoperatorv1beta1.GarmServerList
+	if err := r.List(ctx, &garmServers, client.InNamespace(secretObj.Namespace)); err != nil {
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, s := range garmServers.Items {
+		if s.Spec.UsernameSecretRef.Name == secretObj.Name ||
+			s.Spec.PasswordSecretRef.Name == secretObj.Name ||
+			s.Spec.CACertBundleSecretRef.Name == secretObj.Name {
+			requests = append(requests, reconcile.Request{
+				NamespacedName: types.NamespacedName{
+					Namespace: s.Namespace,
+					Name:      s.Name,
+				},
+			})
+		}
+	}
+
+	return requests
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *GarmServerReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&garmoperatorv1beta1.GarmServer{}).
+		Watches(
+			&corev1.Secret{},
+			handler.EnqueueRequestsFromMapFunc(r.findGarmServersForSecret),
+			builder.WithPredicates(predicate.ResourceVersionChangedPredicate{}),
+		).
+		Complete(r)
+}
+
+// garmServerName returns the name under which the client of a GarmServer is registered
+func garmServerName(namespace, name string) string {
+	return types.NamespacedName{Namespace: namespace, Name: name}.String()
+}
+
+// garmServerClient returns the client of the GarmServer referenced by ref,
+// or the client of the GARM server from the operator configuration if ref is not set.
+func garmServerClient(ctx context.Context, c client.Client, namespace string, ref *corev1.LocalObjectReference) (garmClient.GarmClient, error) {
+	if ref == nil || ref.Name == "" {
+		return garmClient.Client, nil
+	}
+
+	garmServer := &garmoperatorv1beta1.GarmServer{}
+	if err := c.Get(ctx, types.NamespacedName{Namespace: namespace, Name: ref.Name}, garmServer); err != nil {
+		return nil, err
+	}
+
+	client, ok := garmClient.Server(garmServerName(namespace, ref.Name))
+	if !ok {
+		return nil, fmt.Errorf("GarmServer %s is not ready", ref.Name)
+	}
+
+	return client, nil
+}
+
+// requeueIfGarmUnavailable marks obj with the GarmUnavailable condition and returns the result to requeue it,
+// if no requests are sent to the GARM server because its circuit breaker is open.
+func requeueIfGarmUnavailable(ctx context.Context, obj conditions.ConditionStatusObject, garmServer garmClient.GarmClient) (ctrl.Result, bool) {
+	retryAfter, open := garmServer.CircuitOpen()
+	if !open {
+		return ctrl.Result{}, false
+	}
+	return markGarmUnavailable(ctx, obj, retryAfter), true
+}
+
+// handleGarmUnavailable requeues obj instead of returning err, if the reconciliation failed because the
+// circuit breaker of the GARM server opened in the meantime. Otherwise the GarmUnavailable condition is removed.
+func handleGarmUnavailable(ctx context.Context, obj conditions.ConditionStatusObject, res ctrl.Result, err error) (ctrl.Result, error) {
+	var unavailableErr *garmClient.UnavailableError
+	if errors.As(err, &unavailableErr) {
+		return markGarmUnavailable(ctx, obj, unavailableErr.RetryAfter), nil
+	}
+	conditions.Remove(obj, conditions.GarmUnavailable)
+	return res, err
+}
+
+func markGarmUnavailable(ctx context.Context, obj conditions.ConditionStatusObject, retryAfter time.Duration) ctrl.Result {
+	retryAfter = max(retryAfter, time.Second)
+	msg := fmt.Sprintf("GARM server is unavailable, retrying in %s", retryAfter.Round(time.Second))
+	log.FromContext(ctx).Info(msg)
+	conditions.MarkTrue(obj, conditions.GarmUnavailable, conditions.CircuitBreakerOpenReason, msg)
+	return ctrl.Result{RequeueAfter: retryAfter}
+}
@@ -0,0 +1,327 @@
+// SPDX-License-Identifier: MIT
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	garmoperatorv1beta1 "github.com/mercedes-benz/garm-operator/api/v1beta1"
+	garmClient "github.com/mercedes-benz/garm-operator/pkg/client"
+	"github.com/mercedes-benz/garm-operator/pkg/client/key"
+	"github.com/mercedes-benz/garm-operator/pkg/conditions"
+	"github.com/mercedes-benz/garm-operator/pkg/garmfake"
+)
+
+func TestGarmServerReconciler_reconcileNormal(t *testing.T) {
+	controllerID := uuid.MustParse("a4dd5f41-8e1e-42a7-af53-c0ba5ff6b0b3")
+
+	credentialsSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "garm-credentials",
+			Namespace: "default",
+		},
+		Data: map[string][]byte{
+			"username": []byte("admin"),
+			"password": []byte("password"),
+		},
+	}
+
+	newGarmServer := func(url string) *garmoperatorv1beta1.GarmServer {
+		return &garmoperatorv1beta1.GarmServer{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "garm-zone-a",
+				Namespace: "default",
+				Finalizers: []string{
+					key.GarmServerFinalizerName,
+				},
+			},
+			Spec: garmoperatorv1beta1.GarmServerSpec{
+				URL: url,
+				UsernameSecretRef: garmoperatorv1beta1.SecretRef{
+					Name: "garm-credentials",
+					Key:  "username",
+				},
+				PasswordSecretRef: garmoperatorv1beta1.SecretRef{
+					Name: "garm-credentials",
+					Key:  "password",
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name           string
+		version        string
+		runtimeObjects []runtime.Object
+		wantErr        bool
+		wantRegistered bool
+		expectedStatus garmoperatorv1beta1.GarmServerStatus
+	}{
+		{
+			name:           "garm server is ready",
+			version:        "v0.1.5",
+			runtimeObjects: []runtime.Object{credentialsSecret},
+			wantRegistered: true,
+			expectedStatus: garmoperatorv1beta1.GarmServerStatus{
+				ControllerID: controllerID.String(),
+				Version:      "v0.1.5",
+				Conditions: []metav1.Condition{
+					{
+						Type:   string(conditions.ReadyCondition),
+						Reason: string(conditions.SuccessfulReconcileReason),
+						Status: metav1.ConditionTrue,
+					},
+					{
+						Type:   string(conditions.SecretReference),
+						Reason: string(conditions.FetchingSecretRefSuccessReason),
+						Status: metav1.ConditionTrue,
+					},
+				},
+			},
+		},
+		{
+			name:           "garm server version is incompatible",
+			version:        "v0.1.4",
+			runtimeObjects: []runtime.Object{credentialsSecret},
+			wantRegistered: false,
+			expectedStatus: garmoperatorv1beta1.GarmServerStatus{
+				ControllerID: controllerID.String(),
+				Version:      "v0.1.4",
+				Conditions: []metav1.Condition{
+					{
+						Type:    string(conditions.ReadyCondition),
+						Reason:  string(conditions.IncompatibleVersionReason),
+						Status:  metav1.ConditionFalse,
+						Message: "garm-operator is not compatible with Garm version v0.1.4. Minimal required version is v0.1.5",
+					},
+					{
+						Type:   string(conditions.SecretReference),
+						Reason: string(conditions.FetchingSecretRefSuccessReason),
+						Status: metav1.ConditionTrue,
+					},
+				},
+			},
+		},
+		{
+			name:           "credentials secret not found",
+			version:        "v0.1.5",
+			wantErr:        true,
+			wantRegistered: false,
+			expectedStatus: garmoperatorv1beta1.GarmServerStatus{
+				Conditions: []metav1.Condition{
+					{
+						Type:    string(conditions.ReadyCondition),
+						Reason:  string(conditions.FetchingSecretRefFailedReason),
+						Status:  metav1.ConditionFalse,
+						Message: `secrets "garm-credentials" not found`,
+					},
+					{
+						Type:    string(conditions.SecretReference),
+						Reason:  string(conditions.FetchingSecretRefFailedReason),
+						Status:  metav1.ConditionFalse,
+						Message: `secrets "garm-credentials" not found`,
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schemeBuilder := runtime.SchemeBuilder{
+				garmoperatorv1beta1.AddToScheme,
+			}
+
+			err := schemeBuilder.AddToScheme(scheme.Scheme)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			server := garmfake.NewServer(garmfake.WithInitialized(), garmfake.WithControllerID(controllerID), garmfake.WithVersion(tt.version))
+			defer server.Close()
+			garmServer := newGarmServer(server.URL)
+
+			runtimeObjects := []runtime.Object{garmServer}
+			runtimeObjects = append(runtimeObjects, tt.runtimeObjects...)
+			client := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithRuntimeObjects(runtimeObjects...).WithStatusSubresource(&garmoperatorv1beta1.GarmServer{}).Build()
+
+			reconciler := &GarmServerReconciler{
+				Client:   client,
+				Recorder: record.NewFakeRecorder(3),
+			}
+
+			name := garmServerName(garmServer.Namespace, garmServer.Name)
+			t.Cleanup(func() {
+				garmClient.UnregisterServer(name)
+			})
+
+			_, err = reconciler.reconcileNormal(context.Background(), garmServer)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GarmServerReconciler.reconcileNormal() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if _, registered := garmClient.Server(name); registered != tt.wantRegistered {
+				t.Errorf("GarmServerReconciler.reconcileNormal() registered = %v, want %v", registered, tt.wantRegistered)
+			}
+
+			// clear conditions lastTransitionTime to avoid comparison errors
+			conditions.NilLastTransitionTime(garmServer)
+
+			if !reflect.DeepEqual(garmServer.Status, tt.expectedStatus) {
+				t.Errorf("GarmServerReconciler.reconcileNormal() \ngot = %#v\n want %#v", garmServer.Status, tt.expectedStatus)
+			}
+		})
+	}
+}
+
+func TestGarmServerReconciler_reconcileDelete(t *testing.T) {
+	now := metav1.NewTime(time.Now())
+
+	tests := []struct {
+		name            string
+		runtimeObjects  []runtime.Object
+		expectedResult  ctrl.Result
+		wantFinalizer   bool
+		expectedMessage string
+	}{
+		{
+			name:           "garm server is not referenced",
+			expectedResult: ctrl.Result{},
+			wantFinalizer:  false,
+		},
+		{
+			name: "garm server is still referenced by a pool",
+			runtimeObjects: []runtime.Object{
+				&garmoperatorv1beta1.Pool{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "my-pool",
+						Namespace: "default",
+					},
+					Spec: garmoperatorv1beta1.PoolSpec{
+						GarmServerRef: &corev1.LocalObjectReference{
+							Name: "garm-zone-a",
+						},
+					},
+				},
+			},
+			expectedResult:  ctrl.Result{RequeueAfter: 30 * time.Second},
+			wantFinalizer:   true,
+			expectedMessage: "GarmServer is still referenced by Pool my-pool",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schemeBuilder := runtime.SchemeBuilder{
+				garmoperatorv1beta1.AddToScheme,
+			}
+
+			err := schemeBuilder.AddToScheme(scheme.Scheme)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			garmServer := &garmoperatorv1beta1.GarmServer{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:              "garm-zone-a",
+					Namespace:         "default",
+					DeletionTimestamp: &now,
+					Finalizers: []string{
+						key.GarmServerFinalizerName,
+					},
+				},
+			}
+
+			runtimeObjects := []runtime.Object{garmServer}
+			runtimeObjects = append(runtimeObjects, tt.runtimeObjects...)
+			client := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithRuntimeObjects(runtimeObjects...).WithStatusSubresource(&garmoperatorv1beta1.GarmServer{}).Build()
+
+			reconciler := &GarmServerReconciler{
+				Client:   client,
+				Recorder: record.NewFakeRecorder(3),
+			}
+
+			if err := client.Get(context.Background(), types.NamespacedName{Namespace: "default", Name: "garm-zone-a"}, garmServer); err != nil {
+				t.Fatal(err)
+			}
+
+			result, err := reconciler.reconcileDelete(context.Background(), garmServer)
+			if err != nil {
+				t.Fatalf("GarmServerReconciler.reconcileDelete() error = %v", err)
+			}
+
+			if !reflect.DeepEqual(result, tt.expectedResult) {
+				t.Errorf("GarmServerReconciler.reconcileDelete() result = %v, want %v", result, tt.expectedResult)
+			}
+
+			if hasFinalizer := len(garmServer.Finalizers) > 0; hasFinalizer != tt.wantFinalizer {
+				t.Errorf("GarmServerReconciler.reconcileDelete() finalizer = %v, want %v", hasFinalizer, tt.wantFinalizer)
+			}
+
+			if tt.expectedMessage != "" && conditions.Get(garmServer, conditions.ReadyCondition).Message != tt.expectedMessage {
+				t.Errorf("GarmServerReconciler.reconcileDelete() message = %s, want %s", conditions.Get(garmServer, conditions.ReadyCondition).Message, tt.expectedMessage)
+			}
+		})
+	}
+}
+
+func TestHandleGarmUnavailable(t *testing.T) {
+	tests := []struct {
+		name            string
+		err             error
+		existingCond    bool
+		wantResult      ctrl.Result
+		wantErr         bool
+		wantUnavailable bool
+	}{
+		{
+			name:            "circuit breaker open",
+			err:             fmt.Errorf("failed to list pools: %w", &garmClient.UnavailableError{RetryAfter: 20 * time.Second}),
+			wantResult:      ctrl.Result{RequeueAfter: 20 * time.Second},
+			wantUnavailable: true,
+		},
+		{
+			name:         "garm api error",
+			err:          errors.New("garm api error"),
+			existingCond: true,
+			wantErr:      true,
+		},
+		{
+			name:         "garm available again",
+			existingCond: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pool := &garmoperatorv1beta1.Pool{}
+			if tt.existingCond {
+				conditions.MarkTrue(pool, conditions.GarmUnavailable, conditions.CircuitBreakerOpenReason, "")
+			}
+
+			result, err := handleGarmUnavailable(context.Background(), pool, ctrl.Result{}, tt.err)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("handleGarmUnavailable() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(result, tt.wantResult) {
+				t.Errorf("handleGarmUnavailable() result = %v, want %v", result, tt.wantResult)
+			}
+			if got := conditions.Has(pool, conditions.GarmUnavailable); got != tt.wantUnavailable {
+				t.Errorf("handleGarmUnavailable() GarmUnavailable condition = %v, want %v", got, tt.wantUnavailable)
+			}
+		})
+	}
+}
@@ -43,7 +43,7 @@ func (r *GarmServerConfigReconciler) Reconcile(ctx context.Context, req ctrl.Req
 	log := log.FromContext(ctx)
 	log.Info("Reconciling GarmServerConfig")
 
-	controllerClient := garmclient.NewControllerClient()
+	controllerClient := garmclient.NewControllerClient(garmclient.Client)
 
 	garmServerConfig := &garmoperatorv1beta1.GarmServerConfig{}
 	if err := r.Get(ctx, req.NamespacedName, garmServerConfig); err != nil {
@@ -75,7 +75,12 @@ func (r *GarmServerConfigReconciler) Reconcile(ctx context.Context, req ctrl.Req
 		}
 	}()
 
-	return r.reconcileNormal(ctx, controllerClient, garmServerConfig)
+	if result, unavailable := requeueIfGarmUnavailable(ctx, garmServerConfig, garmclient.Client); unavailable {
+		return result, nil
+	}
+
+	res, err := r.reconcileNormal(ctx, controllerClient, garmServerConfig)
+	return handleGarmUnavailable(ctx, garmServerConfig, res, err)
 }
 
 func (r *GarmServerConfigReconciler) reconcileNormal(ctx context.Context, controllerClient garmclient.ControllerClient, garmServerConfig *garmoperatorv1beta1.GarmServerConfig) (ctrl.Result, error) {
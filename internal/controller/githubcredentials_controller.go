@@ -73,8 +73,6 @@ func (r *GitHubCredentialReconciler) Reconcile(ctx context.Context, req ctrl.Req
 		return ctrl.Result{}, err
 	}
 
-	credentialsClient := garmClient.NewCredentialsClient()
-
 	// Initialize conditions to unknown if not set already
 	credentials.InitializeConditions()
 
@@ -89,12 +87,27 @@ func (r *GitHubCredentialReconciler) Reconcile(ctx context.Context, req ctrl.Req
 		}
 	}()
 
+	garmServer, err := garmServerClient(ctx, r.Client, credentials.Namespace, credentials.Spec.GarmServerRef)
+	if err != nil {
+		event.Error(r.Recorder, credentials, err.Error())
+		conditions.MarkFalse(credentials, conditions.ReadyCondition, conditions.GarmServerRefNotReadyReason, err.Error())
+		return ctrl.Result{}, err
+	}
+
+	if result, unavailable := requeueIfGarmUnavailable(ctx, credentials, garmServer); unavailable {
+		return result, nil
+	}
+
+	credentialsClient := garmClient.NewCredentialsClient(garmServer)
+
 	// Handle deleted credentials
 	if !credentials.DeletionTimestamp.IsZero() {
-		return r.reconcileDelete(ctx, credentialsClient, credentials)
+		res, err = r.reconcileDelete(ctx, credentialsClient, credentials)
+		return handleGarmUnavailable(ctx, credentials, res, err)
 	}
 
-	return r.reconcileNormal(ctx, credentialsClient, credentials)
+	res, err = r.reconcileNormal(ctx, credentialsClient, credentials)
+	return handleGarmUnavailable(ctx, credentials, res, err)
 }
 
 func (r *GitHubCredentialReconciler) reconcileNormal(ctx context.Context, client garmClient.CredentialsClient, credentials *garmoperatorv1beta1.GitHubCredential) (ctrl.Result, error) {
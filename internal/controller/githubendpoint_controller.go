@@ -72,8 +72,6 @@ func (r *GitHubEndpointReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 		return ctrl.Result{}, err
 	}
 
-	endpointClient := garmClient.NewEndpointClient()
-
 	// Initialize conditions to unknown if not set already
 	endpoint.InitializeConditions()
 
@@ -88,12 +86,27 @@ func (r *GitHubEndpointReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 		}
 	}()
 
+	garmServer, err := garmServerClient(ctx, r.Client, endpoint.Namespace, endpoint.Spec.GarmServerRef)
+	if err != nil {
+		event.Error(r.Recorder, endpoint, err.Error())
+		conditions.MarkFalse(endpoint, conditions.ReadyCondition, conditions.GarmServerRefNotReadyReason, err.Error())
+		return ctrl.Result{}, err
+	}
+
+	if result, unavailable := requeueIfGarmUnavailable(ctx, endpoint, garmServer); unavailable {
+		return result, nil
+	}
+
+	endpointClient := garmClient.NewEndpointClient(garmServer)
+
 	// Handle deleted endpoints
 	if !endpoint.DeletionTimestamp.IsZero() {
-		return r.reconcileDelete(ctx, endpointClient, endpoint)
+		res, err = r.reconcileDelete(ctx, endpointClient, endpoint)
+		return handleGarmUnavailable(ctx, endpoint, res, err)
 	}
 
-	return r.reconcileNormal(ctx, endpointClient, endpoint)
+	res, err = r.reconcileNormal(ctx, endpointClient, endpoint)
+	return handleGarmUnavailable(ctx, endpoint, res, err)
 }
 
 func (r *GitHubEndpointReconciler) reconcileNormal(ctx context.Context, client garmClient.EndpointClient, endpoint *garmoperatorv1beta1.GitHubEndpoint) (ctrl.Result, error) {
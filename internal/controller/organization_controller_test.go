@@ -8,21 +8,28 @@ import (
 	"testing"
 	"time"
 
+	"github.com/cloudbase/garm/client/credentials"
+	"github.com/cloudbase/garm/client/endpoints"
 	"github.com/cloudbase/garm/client/organizations"
 	"github.com/cloudbase/garm/params"
 	"go.uber.org/mock/gomock"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
 	garmoperatorv1beta1 "github.com/mercedes-benz/garm-operator/api/v1beta1"
+	garmClient "github.com/mercedes-benz/garm-operator/pkg/client"
 	"github.com/mercedes-benz/garm-operator/pkg/client/key"
 	"github.com/mercedes-benz/garm-operator/pkg/client/mock"
 	"github.com/mercedes-benz/garm-operator/pkg/conditions"
+	"github.com/mercedes-benz/garm-operator/pkg/garmfake"
 )
 
 func TestOrganizationReconciler_reconcileNormal(t *testing.T) {
@@ -167,6 +174,495 @@ func TestOrganizationReconciler_reconcileNormal(t *testing.T) {
 				}, nil)
 			},
 		},
+		{
+			name: "organization exist - install webhook",
+			object: &garmoperatorv1beta1.Organization{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "existing-organization",
+					Namespace: "default",
+					Finalizers: []string{
+						key.OrganizationFinalizerName,
+					},
+				},
+				Spec: garmoperatorv1beta1.OrganizationSpec{
+					CredentialsRef: corev1.TypedLocalObjectReference{
+						APIGroup: &garmoperatorv1beta1.GroupVersion.Group,
+						Kind:     "GitHubCredential",
+						Name:     "github-creds",
+					},
+					WebhookSecretRef: garmoperatorv1beta1.SecretRef{
+						Name: "my-webhook-secret",
+						Key:  "webhookSecret",
+					},
+					Webhook: &garmoperatorv1beta1.WebhookSpec{
+						Install: true,
+					},
+				},
+				Status: garmoperatorv1beta1.OrganizationStatus{
+					ID: "e1dbf9a6-a9f6-4594-a5ac-ae78a8f27a3e",
+				},
+			},
+			runtimeObjects: []runtime.Object{
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "default",
+						Name:      "my-webhook-secret",
+					},
+					Data: map[string][]byte{
+						"webhookSecret": []byte("foobar"),
+					},
+				},
+				&garmoperatorv1beta1.GitHubCredential{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "github-creds",
+						Namespace: "default",
+					},
+					Spec: garmoperatorv1beta1.GitHubCredentialSpec{
+						Description: "github-creds",
+						EndpointRef: corev1.TypedLocalObjectReference{},
+						AuthType:    "pat",
+						SecretRef: garmoperatorv1beta1.SecretRef{
+							Name: "github-secret",
+							Key:  "token",
+						},
+					},
+				},
+			},
+			expectedObject: &garmoperatorv1beta1.Organization{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "existing-organization",
+					Namespace: "default",
+					Finalizers: []string{
+						key.OrganizationFinalizerName,
+					},
+				},
+				Spec: garmoperatorv1beta1.OrganizationSpec{
+					CredentialsRef: corev1.TypedLocalObjectReference{
+						APIGroup: &garmoperatorv1beta1.GroupVersion.Group,
+						Kind:     "GitHubCredential",
+						Name:     "github-creds",
+					},
+					WebhookSecretRef: garmoperatorv1beta1.SecretRef{
+						Name: "my-webhook-secret",
+						Key:  "webhookSecret",
+					},
+					Webhook: &garmoperatorv1beta1.WebhookSpec{
+						Install: true,
+					},
+				},
+				Status: garmoperatorv1beta1.OrganizationStatus{
+					ID: "e1dbf9a6-a9f6-4594-a5ac-ae78a8f27a3e",
+					Webhook: &garmoperatorv1beta1.WebhookStatus{
+						ID:     123456,
+						URL:    "https://garm.example.com/webhooks/BE4B3620-D424-43AC-8EDD-5760DBD516BF",
+						Active: true,
+					},
+					Conditions: []metav1.Condition{
+						{
+							Type:               string(conditions.ReadyCondition),
+							Reason:             string(conditions.PoolManagerFailureReason),
+							Status:             metav1.ConditionFalse,
+							LastTransitionTime: metav1.NewTime(time.Now()),
+							Message:            "Pool Manager is not running",
+						},
+						{
+							Type:               string(conditions.GithubCredentialsReference),
+							Reason:             string(conditions.FetchingGithubCredentialsRefSuccessReason),
+							Status:             metav1.ConditionTrue,
+							Message:            "",
+							LastTransitionTime: metav1.NewTime(time.Now()),
+						},
+						{
+							Type:               string(conditions.PoolManager),
+							Reason:             string(conditions.PoolManagerFailureReason),
+							Status:             metav1.ConditionFalse,
+							Message:            "",
+							LastTransitionTime: metav1.NewTime(time.Now()),
+						},
+						{
+							Type:               string(conditions.Webhook),
+							Reason:             string(conditions.WebhookInstalledReason),
+							Status:             metav1.ConditionTrue,
+							Message:            "",
+							LastTransitionTime: metav1.NewTime(time.Now()),
+						},
+						{
+							Type:               string(conditions.WebhookSecretReference),
+							Reason:             string(conditions.FetchingWebhookSecretRefSuccessReason),
+							Status:             metav1.ConditionTrue,
+							Message:            "",
+							LastTransitionTime: metav1.NewTime(time.Now()),
+						},
+					},
+				},
+			},
+			expectGarmRequest: func(m *mock.MockOrganizationClientMockRecorder) {
+				m.ListOrganizations(organizations.NewListOrgsParams()).Return(&organizations.ListOrgsOK{Payload: params.Organizations{
+					{
+						ID:              "e1dbf9a6-a9f6-4594-a5ac-ae78a8f27a3e",
+						Name:            "existing-organization",
+						CredentialsName: "foobar",
+						WebhookSecret:   "foobar",
+					},
+				}}, nil)
+				m.UpdateOrganization(organizations.NewUpdateOrgParams().
+					WithOrgID("e1dbf9a6-a9f6-4594-a5ac-ae78a8f27a3e").
+					//nolint:gosec
+					WithBody(params.UpdateEntityParams{
+						CredentialsName: "github-creds",
+						WebhookSecret:   "foobar",
+					})).Return(&organizations.UpdateOrgOK{
+					//nolint:gosec
+					Payload: params.Organization{
+						ID:              "e1dbf9a6-a9f6-4594-a5ac-ae78a8f27a3e",
+						Name:            "existing-organization",
+						CredentialsName: "github-creds",
+						WebhookSecret:   "foobar",
+					},
+				}, nil)
+				m.GetOrganizationWebhookInfo(organizations.NewGetOrgWebhookInfoParams().
+					WithOrgID("e1dbf9a6-a9f6-4594-a5ac-ae78a8f27a3e")).
+					Return(nil, organizations.NewGetOrgWebhookInfoDefault(404))
+				m.InstallOrganizationWebhook(organizations.NewInstallOrgWebhookParams().
+					WithOrgID("e1dbf9a6-a9f6-4594-a5ac-ae78a8f27a3e").
+					WithBody(params.InstallWebhookParams{
+						WebhookEndpointType: params.WebhookEndpointDirect,
+					})).Return(&organizations.InstallOrgWebhookOK{
+					Payload: params.HookInfo{
+						ID:     123456,
+						URL:    "https://garm.example.com/webhooks/BE4B3620-D424-43AC-8EDD-5760DBD516BF",
+						Events: []string{"workflow_job"},
+						Active: true,
+					},
+				}, nil)
+			},
+		},
+		{
+			name: "organization exist - fail on error fetching webhook info without installing the webhook",
+			object: &garmoperatorv1beta1.Organization{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "existing-organization",
+					Namespace: "default",
+					Finalizers: []string{
+						key.OrganizationFinalizerName,
+					},
+				},
+				Spec: garmoperatorv1beta1.OrganizationSpec{
+					CredentialsRef: corev1.TypedLocalObjectReference{
+						APIGroup: &garmoperatorv1beta1.GroupVersion.Group,
+						Kind:     "GitHubCredential",
+						Name:     "github-creds",
+					},
+					WebhookSecretRef: garmoperatorv1beta1.SecretRef{
+						Name: "my-webhook-secret",
+						Key:  "webhookSecret",
+					},
+					Webhook: &garmoperatorv1beta1.WebhookSpec{
+						Install: true,
+					},
+				},
+				Status: garmoperatorv1beta1.OrganizationStatus{
+					ID: "e1dbf9a6-a9f6-4594-a5ac-ae78a8f27a3e",
+				},
+			},
+			runtimeObjects: []runtime.Object{
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "default",
+						Name:      "my-webhook-secret",
+					},
+					Data: map[string][]byte{
+						"webhookSecret": []byte("foobar"),
+					},
+				},
+				&garmoperatorv1beta1.GitHubCredential{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "github-creds",
+						Namespace: "default",
+					},
+					Spec: garmoperatorv1beta1.GitHubCredentialSpec{
+						Description: "github-creds",
+						EndpointRef: corev1.TypedLocalObjectReference{},
+						AuthType:    "pat",
+						SecretRef: garmoperatorv1beta1.SecretRef{
+							Name: "github-secret",
+							Key:  "token",
+						},
+					},
+				},
+			},
+			expectedObject: &garmoperatorv1beta1.Organization{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "existing-organization",
+					Namespace: "default",
+					Finalizers: []string{
+						key.OrganizationFinalizerName,
+					},
+				},
+				Spec: garmoperatorv1beta1.OrganizationSpec{
+					CredentialsRef: corev1.TypedLocalObjectReference{
+						APIGroup: &garmoperatorv1beta1.GroupVersion.Group,
+						Kind:     "GitHubCredential",
+						Name:     "github-creds",
+					},
+					WebhookSecretRef: garmoperatorv1beta1.SecretRef{
+						Name: "my-webhook-secret",
+						Key:  "webhookSecret",
+					},
+					Webhook: &garmoperatorv1beta1.WebhookSpec{
+						Install: true,
+					},
+				},
+				Status: garmoperatorv1beta1.OrganizationStatus{
+					ID: "e1dbf9a6-a9f6-4594-a5ac-ae78a8f27a3e",
+					Conditions: []metav1.Condition{
+						{
+							Type:               string(conditions.ReadyCondition),
+							Reason:             string(conditions.PoolManagerFailureReason),
+							Status:             metav1.ConditionFalse,
+							LastTransitionTime: metav1.NewTime(time.Now()),
+							Message:            "Pool Manager is not running",
+						},
+						{
+							Type:               string(conditions.GithubCredentialsReference),
+							Reason:             string(conditions.FetchingGithubCredentialsRefSuccessReason),
+							Status:             metav1.ConditionTrue,
+							Message:            "",
+							LastTransitionTime: metav1.NewTime(time.Now()),
+						},
+						{
+							Type:               string(conditions.PoolManager),
+							Reason:             string(conditions.PoolManagerFailureReason),
+							Status:             metav1.ConditionFalse,
+							Message:            "",
+							LastTransitionTime: metav1.NewTime(time.Now()),
+						},
+						{
+							Type:               string(conditions.Webhook),
+							Reason:             string(conditions.WebhookInstallFailedReason),
+							Status:             metav1.ConditionFalse,
+							Message:            "fetching webhook info: [GET /organizations/{orgID}/webhook][500] GetOrgWebhookInfo default {\"error\":\"\",\"details\":\"\"}",
+							LastTransitionTime: metav1.NewTime(time.Now()),
+						},
+						{
+							Type:               string(conditions.WebhookSecretReference),
+							Reason:             string(conditions.FetchingWebhookSecretRefSuccessReason),
+							Status:             metav1.ConditionTrue,
+							Message:            "",
+							LastTransitionTime: metav1.NewTime(time.Now()),
+						},
+					},
+				},
+			},
+			expectGarmRequest: func(m *mock.MockOrganizationClientMockRecorder) {
+				m.ListOrganizations(organizations.NewListOrgsParams()).Return(&organizations.ListOrgsOK{Payload: params.Organizations{
+					{
+						ID:              "e1dbf9a6-a9f6-4594-a5ac-ae78a8f27a3e",
+						Name:            "existing-organization",
+						CredentialsName: "foobar",
+						WebhookSecret:   "foobar",
+					},
+				}}, nil)
+				m.UpdateOrganization(organizations.NewUpdateOrgParams().
+					WithOrgID("e1dbf9a6-a9f6-4594-a5ac-ae78a8f27a3e").
+					//nolint:gosec
+					WithBody(params.UpdateEntityParams{
+						CredentialsName: "github-creds",
+						WebhookSecret:   "foobar",
+					})).Return(&organizations.UpdateOrgOK{
+					//nolint:gosec
+					Payload: params.Organization{
+						ID:              "e1dbf9a6-a9f6-4594-a5ac-ae78a8f27a3e",
+						Name:            "existing-organization",
+						CredentialsName: "github-creds",
+						WebhookSecret:   "foobar",
+					},
+				}, nil)
+				m.GetOrganizationWebhookInfo(organizations.NewGetOrgWebhookInfoParams().
+					WithOrgID("e1dbf9a6-a9f6-4594-a5ac-ae78a8f27a3e")).
+					Return(nil, organizations.NewGetOrgWebhookInfoDefault(500))
+			},
+			wantErr: true,
+		},
+		{
+			name: "organization exist - reinstall webhook with rotated webhook secret",
+			object: &garmoperatorv1beta1.Organization{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "existing-organization",
+					Namespace: "default",
+					Finalizers: []string{
+						key.OrganizationFinalizerName,
+					},
+				},
+				Spec: garmoperatorv1beta1.OrganizationSpec{
+					CredentialsRef: corev1.TypedLocalObjectReference{
+						APIGroup: &garmoperatorv1beta1.GroupVersion.Group,
+						Kind:     "GitHubCredential",
+						Name:     "github-creds",
+					},
+					WebhookSecretRef: garmoperatorv1beta1.SecretRef{
+						Name: "my-webhook-secret",
+						Key:  "webhookSecret",
+					},
+					Webhook: &garmoperatorv1beta1.WebhookSpec{
+						Install: true,
+					},
+				},
+				Status: garmoperatorv1beta1.OrganizationStatus{
+					ID: "e1dbf9a6-a9f6-4594-a5ac-ae78a8f27a3e",
+					Webhook: &garmoperatorv1beta1.WebhookStatus{
+						ID:             123455,
+						URL:            "https://garm.example.com/webhooks/BE4B3620-D424-43AC-8EDD-5760DBD516BF",
+						Active:         true,
+						SecretOutdated: true,
+					},
+				},
+			},
+			runtimeObjects: []runtime.Object{
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "default",
+						Name:      "my-webhook-secret",
+					},
+					Data: map[string][]byte{
+						"webhookSecret": []byte("foobar"),
+					},
+				},
+				&garmoperatorv1beta1.GitHubCredential{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "github-creds",
+						Namespace: "default",
+					},
+					Spec: garmoperatorv1beta1.GitHubCredentialSpec{
+						Description: "github-creds",
+						EndpointRef: corev1.TypedLocalObjectReference{},
+						AuthType:    "pat",
+						SecretRef: garmoperatorv1beta1.SecretRef{
+							Name: "github-secret",
+							Key:  "token",
+						},
+					},
+				},
+			},
+			expectedObject: &garmoperatorv1beta1.Organization{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "existing-organization",
+					Namespace: "default",
+					Finalizers: []string{
+						key.OrganizationFinalizerName,
+					},
+				},
+				Spec: garmoperatorv1beta1.OrganizationSpec{
+					CredentialsRef: corev1.TypedLocalObjectReference{
+						APIGroup: &garmoperatorv1beta1.GroupVersion.Group,
+						Kind:     "GitHubCredential",
+						Name:     "github-creds",
+					},
+					WebhookSecretRef: garmoperatorv1beta1.SecretRef{
+						Name: "my-webhook-secret",
+						Key:  "webhookSecret",
+					},
+					Webhook: &garmoperatorv1beta1.WebhookSpec{
+						Install: true,
+					},
+				},
+				Status: garmoperatorv1beta1.OrganizationStatus{
+					ID: "e1dbf9a6-a9f6-4594-a5ac-ae78a8f27a3e",
+					Webhook: &garmoperatorv1beta1.WebhookStatus{
+						ID:     123456,
+						URL:    "https://garm.example.com/webhooks/BE4B3620-D424-43AC-8EDD-5760DBD516BF",
+						Active: true,
+					},
+					Conditions: []metav1.Condition{
+						{
+							Type:               string(conditions.ReadyCondition),
+							Reason:             string(conditions.PoolManagerFailureReason),
+							Status:             metav1.ConditionFalse,
+							LastTransitionTime: metav1.NewTime(time.Now()),
+							Message:            "Pool Manager is not running",
+						},
+						{
+							Type:               string(conditions.GithubCredentialsReference),
+							Reason:             string(conditions.FetchingGithubCredentialsRefSuccessReason),
+							Status:             metav1.ConditionTrue,
+							Message:            "",
+							LastTransitionTime: metav1.NewTime(time.Now()),
+						},
+						{
+							Type:               string(conditions.PoolManager),
+							Reason:             string(conditions.PoolManagerFailureReason),
+							Status:             metav1.ConditionFalse,
+							Message:            "",
+							LastTransitionTime: metav1.NewTime(time.Now()),
+						},
+						{
+							Type:               string(conditions.Webhook),
+							Reason:             string(conditions.WebhookInstalledReason),
+							Status:             metav1.ConditionTrue,
+							Message:            "",
+							LastTransitionTime: metav1.NewTime(time.Now()),
+						},
+						{
+							Type:               string(conditions.WebhookSecretReference),
+							Reason:             string(conditions.FetchingWebhookSecretRefSuccessReason),
+							Status:             metav1.ConditionTrue,
+							Message:            "",
+							LastTransitionTime: metav1.NewTime(time.Now()),
+						},
+					},
+				},
+			},
+			expectGarmRequest: func(m *mock.MockOrganizationClientMockRecorder) {
+				m.ListOrganizations(organizations.NewListOrgsParams()).Return(&organizations.ListOrgsOK{Payload: params.Organizations{
+					{
+						ID:              "e1dbf9a6-a9f6-4594-a5ac-ae78a8f27a3e",
+						Name:            "existing-organization",
+						CredentialsName: "foobar",
+						WebhookSecret:   "foobar",
+					},
+				}}, nil)
+				m.UpdateOrganization(organizations.NewUpdateOrgParams().
+					WithOrgID("e1dbf9a6-a9f6-4594-a5ac-ae78a8f27a3e").
+					//nolint:gosec
+					WithBody(params.UpdateEntityParams{
+						CredentialsName: "github-creds",
+						WebhookSecret:   "foobar",
+					})).Return(&organizations.UpdateOrgOK{
+					//nolint:gosec
+					Payload: params.Organization{
+						ID:              "e1dbf9a6-a9f6-4594-a5ac-ae78a8f27a3e",
+						Name:            "existing-organization",
+						CredentialsName: "github-creds",
+						WebhookSecret:   "foobar",
+					},
+				}, nil)
+				m.GetOrganizationWebhookInfo(organizations.NewGetOrgWebhookInfoParams().
+					WithOrgID("e1dbf9a6-a9f6-4594-a5ac-ae78a8f27a3e")).
+					Return(&organizations.GetOrgWebhookInfoOK{
+						Payload: params.HookInfo{
+							ID:     123455,
+							URL:    "https://garm.example.com/webhooks/BE4B3620-D424-43AC-8EDD-5760DBD516BF",
+							Events: []string{"workflow_job"},
+							Active: true,
+						},
+					}, nil)
+				m.UninstallOrganizationWebhook(organizations.NewUninstallOrgWebhookParams().
+					WithOrgID("e1dbf9a6-a9f6-4594-a5ac-ae78a8f27a3e")).
+					Return(nil)
+				m.InstallOrganizationWebhook(organizations.NewInstallOrgWebhookParams().
+					WithOrgID("e1dbf9a6-a9f6-4594-a5ac-ae78a8f27a3e").
+					WithBody(params.InstallWebhookParams{
+						WebhookEndpointType: params.WebhookEndpointDirect,
+					})).Return(&organizations.InstallOrgWebhookOK{
+					Payload: params.HookInfo{
+						ID:     123456,
+						URL:    "https://garm.example.com/webhooks/BE4B3620-D424-43AC-8EDD-5760DBD516BF",
+						Events: []string{"workflow_job"},
+						Active: true,
+					},
+				}, nil)
+			},
+		},
 		{
 			name: "organization exist but spec has changed - update",
 			object: &garmoperatorv1beta1.Organization{
@@ -945,7 +1441,7 @@ func TestOrganizationReconciler_reconcileNormal(t *testing.T) {
 
 			organization.InitializeConditions()
 
-			_, err = reconciler.reconcileNormal(context.Background(), mockOrganizationClient, organization)
+			_, err = reconciler.scopeReconciler().reconcileNormal(context.Background(), garmClient.NewOrganizationScopeClient(mockOrganizationClient), organization)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("OrganizationReconciler.reconcileNormal() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -1038,6 +1534,72 @@ func TestOrganizationReconciler_reconcileDelete(t *testing.T) {
 				).Return(nil)
 			},
 		},
+		{
+			name: "delete organization with installed webhook",
+			object: &garmoperatorv1beta1.Organization{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "delete-organization",
+					Namespace: "default",
+					Finalizers: []string{
+						key.OrganizationFinalizerName,
+					},
+				},
+				Spec: garmoperatorv1beta1.OrganizationSpec{
+					CredentialsRef: corev1.TypedLocalObjectReference{
+						APIGroup: &garmoperatorv1beta1.GroupVersion.Group,
+						Kind:     "GitHubCredential",
+						Name:     "github-creds",
+					},
+					WebhookSecretRef: garmoperatorv1beta1.SecretRef{
+						Name: "my-webhook-secret",
+						Key:  "webhookSecret",
+					},
+				},
+				Status: garmoperatorv1beta1.OrganizationStatus{
+					ID: "e1dbf9a6-a9f6-4594-a5ac-12345",
+					Webhook: &garmoperatorv1beta1.WebhookStatus{
+						ID:     123456,
+						Active: true,
+					},
+				},
+			},
+			runtimeObjects: []runtime.Object{
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "default",
+						Name:      "my-webhook-secret",
+					},
+					Data: map[string][]byte{
+						"webhookSecret": []byte("foobar"),
+					},
+				},
+				&garmoperatorv1beta1.GitHubCredential{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "github-creds",
+						Namespace: "default",
+					},
+					Spec: garmoperatorv1beta1.GitHubCredentialSpec{
+						Description: "github-creds",
+						EndpointRef: corev1.TypedLocalObjectReference{},
+						AuthType:    "pat",
+						SecretRef: garmoperatorv1beta1.SecretRef{
+							Name: "github-secret",
+							Key:  "token",
+						},
+					},
+				},
+			},
+			expectGarmRequest: func(m *mock.MockOrganizationClientMockRecorder) {
+				m.UninstallOrganizationWebhook(
+					organizations.NewUninstallOrgWebhookParams().
+						WithOrgID("e1dbf9a6-a9f6-4594-a5ac-12345"),
+				).Return(nil)
+				m.DeleteOrganization(
+					organizations.NewDeleteOrgParams().
+						WithOrgID("e1dbf9a6-a9f6-4594-a5ac-12345"),
+				).Return(nil)
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -1064,7 +1626,7 @@ func TestOrganizationReconciler_reconcileDelete(t *testing.T) {
 			mockOrganization := mock.NewMockOrganizationClient(mockCtrl)
 			tt.expectGarmRequest(mockOrganization.EXPECT())
 
-			_, err = reconciler.reconcileDelete(context.Background(), mockOrganization, organization)
+			_, err = reconciler.scopeReconciler().reconcileDelete(context.Background(), garmClient.NewOrganizationScopeClient(mockOrganization), mock.NewMockPoolClient(mockCtrl), organization)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("OrganizationReconciler.reconcileDelete() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -1078,3 +1640,126 @@ func TestOrganizationReconciler_reconcileDelete(t *testing.T) {
 		})
 	}
 }
+
+func TestOrganizationReconciler_Reconcile(t *testing.T) {
+	server := garmfake.NewServer(garmfake.WithInitialized())
+	defer server.Close()
+
+	garmServer, err := garmClient.RegisterServer(garmServerName("default", "garm"), garmClient.GarmScopeParams{
+		BaseURL:  server.URL,
+		Username: garmfake.DefaultUsername,
+		Password: garmfake.DefaultPassword,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer garmClient.UnregisterServer(garmServerName("default", "garm"))
+
+	// the referenced credentials have to exist in garm
+	if _, err := garmClient.NewEndpointClient(garmServer).CreateEndpoint(endpoints.NewCreateGithubEndpointParams().WithBody(params.CreateGithubEndpointParams{
+		Name: "github.com",
+	})); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := garmClient.NewCredentialsClient(garmServer).CreateCredentials(credentials.NewCreateCredentialsParams().WithBody(params.CreateGithubCredentialsParams{
+		Name:     "github-creds",
+		Endpoint: "github.com",
+		AuthType: params.GithubAuthTypePAT,
+	})); err != nil {
+		t.Fatal(err)
+	}
+
+	schemeBuilder := runtime.SchemeBuilder{
+		garmoperatorv1beta1.AddToScheme,
+	}
+	if err := schemeBuilder.AddToScheme(scheme.Scheme); err != nil {
+		t.Fatal(err)
+	}
+
+	client := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithRuntimeObjects(
+		&garmoperatorv1beta1.Organization{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "my-org",
+				Namespace: "default",
+			},
+			Spec: garmoperatorv1beta1.OrganizationSpec{
+				CredentialsRef: corev1.TypedLocalObjectReference{
+					APIGroup: &garmoperatorv1beta1.GroupVersion.Group,
+					Kind:     "GitHubCredential",
+					Name:     "github-creds",
+				},
+				WebhookSecretRef: garmoperatorv1beta1.SecretRef{
+					Name: "my-webhook-secret",
+					Key:  "webhookSecret",
+				},
+				GarmServerRef: &corev1.LocalObjectReference{
+					Name: "garm",
+				},
+			},
+		},
+		&garmoperatorv1beta1.GarmServer{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "garm",
+				Namespace: "default",
+			},
+		},
+		&garmoperatorv1beta1.GitHubCredential{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "github-creds",
+				Namespace: "default",
+			},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "default",
+				Name:      "my-webhook-secret",
+			},
+			Data: map[string][]byte{
+				"webhookSecret": []byte("foobar"),
+			},
+		},
+	).WithStatusSubresource(&garmoperatorv1beta1.Organization{}).Build()
+
+	reconciler := &OrganizationReconciler{
+		Client:   client,
+		Recorder: record.NewFakeRecorder(10),
+	}
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "default", Name: "my-org"}}
+
+	// the first reconcile only adds the finalizer
+	for range 2 {
+		if _, err := reconciler.Reconcile(context.Background(), req); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	organization := &garmoperatorv1beta1.Organization{}
+	if err := client.Get(context.Background(), req.NamespacedName, organization); err != nil {
+		t.Fatal(err)
+	}
+
+	garmOrganizations := server.Organizations()
+	if len(garmOrganizations) != 1 {
+		t.Fatalf("expected 1 organization in garm, got %d", len(garmOrganizations))
+	}
+	if organization.Status.ID != garmOrganizations[0].ID || garmOrganizations[0].WebhookSecret != "foobar" {
+		t.Errorf("OrganizationReconciler.Reconcile() got = %#v, want organization %#v", organization.Status, garmOrganizations[0])
+	}
+	if ready := conditions.Get(organization, conditions.ReadyCondition); ready == nil || ready.Status != metav1.ConditionTrue {
+		t.Errorf("OrganizationReconciler.Reconcile() organization is not ready")
+	}
+
+	if err := client.Delete(context.Background(), organization); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := reconciler.Reconcile(context.Background(), req); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(server.Organizations()) != 0 {
+		t.Errorf("OrganizationReconciler.Reconcile() organization still exists in garm")
+	}
+	if err := client.Get(context.Background(), req.NamespacedName, organization); !apierrors.IsNotFound(err) {
+		t.Errorf("OrganizationReconciler.Reconcile() organization still exists, err = %v", err)
+	}
+}
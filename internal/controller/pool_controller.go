@@ -5,15 +5,20 @@ package controller
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"reflect"
+	"slices"
 	"sort"
+	"strings"
 	"time"
 
 	"github.com/cloudbase/garm/client/instances"
 	"github.com/cloudbase/garm/client/pools"
 	"github.com/cloudbase/garm/params"
+	"github.com/prometheus/client_golang/prometheus"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
@@ -35,6 +40,7 @@ import (
 	"github.com/mercedes-benz/garm-operator/pkg/config"
 	"github.com/mercedes-benz/garm-operator/pkg/event"
 	"github.com/mercedes-benz/garm-operator/pkg/finalizers"
+	"github.com/mercedes-benz/garm-operator/pkg/metrics"
 	poolUtil "github.com/mercedes-benz/garm-operator/pkg/pools"
 	runnerUtil "github.com/mercedes-benz/garm-operator/pkg/runners"
 	"github.com/mercedes-benz/garm-operator/pkg/tags"
@@ -45,10 +51,27 @@ type PoolReconciler struct {
 	client.Client
 	Scheme   *runtime.Scheme
 	Recorder record.EventRecorder
+	// Jobs counts the jobs of the runners to recycle them once they exceed the max jobs of their pool
+	Jobs *runnerUtil.JobTracker
 }
 
 const (
 	imageField = "spec.image"
+
+	// poolDrainInterval is the interval in which a draining pool checks if its runners are gone
+	poolDrainInterval = 30 * time.Second
+
+	// poolScaleDownInterval is the interval in which a pool continues scaling down if it deletes its idle runners in steps without cooldown
+	poolScaleDownInterval = 30 * time.Second
+
+	// defaultRemediationRetries is the number of stuck or faulty runners deleted within the retry window if the pool doesn't define it
+	defaultRemediationRetries = 3
+
+	// defaultRemediationWindow is the retry window of the runner remediation if the pool doesn't define one
+	defaultRemediationWindow = 1 * time.Hour
+
+	// defaultRecycleBatchInterval is the minimum time between two batches of recycled runners if the pool doesn't define one
+	defaultRecycleBatchInterval = 5 * time.Minute
 )
 
 //+kubebuilder:rbac:groups=core,resources=events,verbs=create;patch
@@ -82,10 +105,6 @@ func (r *PoolReconciler) Reconcile(ctx context.Context, req ctrl.Request) (res c
 		return ctrl.Result{}, err
 	}
 
-	poolClient := garmClient.NewPoolClient()
-
-	instanceClient := garmClient.NewInstanceClient()
-
 	// Initialize conditions to unknown if not set already
 	pool.InitializeConditions()
 
@@ -100,15 +119,35 @@ func (r *PoolReconciler) Reconcile(ctx context.Context, req ctrl.Request) (res c
 		}
 	}()
 
+	garmServer, err := garmServerClient(ctx, r.Client, pool.Namespace, pool.Spec.GarmServerRef)
+	if err != nil {
+		event.Error(r.Recorder, pool, err.Error())
+		conditions.MarkFalse(pool, conditions.ReadyCondition, conditions.GarmServerRefNotReadyReason, err.Error())
+		return ctrl.Result{}, err
+	}
+
+	if result, unavailable := requeueIfGarmUnavailable(ctx, pool, garmServer); unavailable {
+		return result, nil
+	}
+
+	poolClient := garmClient.NewPoolClient(garmServer)
+
+	instanceClient := garmClient.NewInstanceClient(garmServer)
+
 	// handle deletion
 	if !pool.DeletionTimestamp.IsZero() {
-		return r.reconcileDelete(ctx, poolClient, pool, instanceClient)
+		res, err = r.reconcileDelete(ctx, poolClient, pool, instanceClient)
+		return handleGarmUnavailable(ctx, pool, res, err)
 	}
 
-	return r.reconcileNormal(ctx, poolClient, pool, instanceClient)
+	res, err = r.reconcileNormal(ctx, poolClient, pool, instanceClient)
+	return handleGarmUnavailable(ctx, pool, res, err)
 }
 
 func (r *PoolReconciler) reconcileNormal(ctx context.Context, poolClient garmClient.PoolClient, pool *garmoperatorv1beta1.Pool, instanceClient garmClient.InstanceClient) (ctrl.Result, error) {
+	// expose the selector for the runners of this pool, which is used by the scale subresource
+	pool.Status.Selector = pool.RunnerSelector()
+
 	gitHubScopeRef, err := r.fetchGitHubScopeCRD(ctx, pool)
 	if err != nil {
 		r.errorLog(ctx, pool, err)
@@ -149,6 +188,32 @@ func (r *PoolReconciler) reconcileCreate(ctx context.Context, garmClient garmCli
 	}
 	conditions.MarkTrue(pool, conditions.ImageReference, conditions.FetchingImageRefSuccessReason, "Successfully fetched Image CR Ref")
 
+	// bind an existing garm pool instead of creating a new one
+	if poolID, adopt := annotations.AdoptPool(pool); adopt {
+		garmPool, err := r.adoptPool(ctx, garmClient, pool, poolID, image, gitHubScopeRef)
+		if err != nil {
+			reason := conditions.ReconcileErrorReason
+			if errors.Is(err, poolUtil.ErrDuplicatePool) {
+				reason = conditions.DuplicatePoolReason
+			}
+			conditions.MarkFalse(pool, conditions.ReadyCondition, reason, err.Error())
+			r.errorLog(ctx, pool, fmt.Errorf("failed adopting pool %s: %s", pool.Name, err.Error()))
+			return ctrl.Result{}, err
+		}
+
+		if garmPool != nil {
+			log.Info("adopting existing pool in garm succeeded", "id", garmPool.ID)
+			event.Info(r.Recorder, pool, fmt.Sprintf("adopted existing pool %s in garm", garmPool.ID))
+
+			pool.Status.ID = garmPool.ID
+
+			conditions.MarkTrue(pool, conditions.ReadyCondition, conditions.SuccessfulReconcileReason, "")
+			return ctrl.Result{}, nil
+		}
+
+		log.Info("no existing pool in garm found to adopt")
+	}
+
 	// always create new pool in garm
 	garmPool, err := poolUtil.CreatePool(ctx, garmClient, pool, image, gitHubScopeRef)
 	if err != nil {
@@ -168,6 +233,34 @@ func (r *PoolReconciler) reconcileCreate(ctx context.Context, garmClient garmCli
 	return ctrl.Result{}, nil
 }
 
+// adoptPool returns the existing garm pool with the given ID or, if no ID is given, the one which matches the pool spec.
+// It returns nil if no garm pool matches the pool spec.
+func (r *PoolReconciler) adoptPool(ctx context.Context, garmClient garmClient.PoolClient, pool *garmoperatorv1beta1.Pool, poolID string, image *garmoperatorv1beta1.Image, gitHubScopeRef garmoperatorv1beta1.GitHubScope) (*params.Pool, error) {
+	var garmPool *params.Pool
+	var err error
+	if poolID != "" {
+		garmPool, err = poolUtil.GetGarmPoolByID(ctx, garmClient, poolID, gitHubScopeRef)
+	} else {
+		garmPool, err = poolUtil.GetGarmPoolBySpecs(ctx, garmClient, pool, image, gitHubScopeRef)
+	}
+	if err != nil || garmPool == nil {
+		return nil, err
+	}
+
+	// a garm pool must not be managed by more than one pool CR
+	poolList := &garmoperatorv1beta1.PoolList{}
+	if err := r.List(ctx, poolList); err != nil {
+		return nil, err
+	}
+	for _, p := range poolList.Items {
+		if p.Status.ID == garmPool.ID && poolGarmServerName(&p) == poolGarmServerName(pool) && (p.Namespace != pool.Namespace || p.Name != pool.Name) {
+			return nil, fmt.Errorf("%w: garm pool %s is already bound to Pool %s/%s", poolUtil.ErrDuplicatePool, garmPool.ID, p.Namespace, p.Name)
+		}
+	}
+
+	return garmPool, nil
+}
+
 func (r *PoolReconciler) reconcileUpdate(ctx context.Context, garmClient garmClient.PoolClient, pool *garmoperatorv1beta1.Pool, instanceClient garmClient.InstanceClient) (ctrl.Result, error) {
 	log := log.FromContext(ctx).
 		WithName("reconcileUpdate")
@@ -182,7 +275,7 @@ func (r *PoolReconciler) reconcileUpdate(ctx context.Context, garmClient garmCli
 	}
 	conditions.MarkTrue(pool, conditions.ImageReference, conditions.FetchingImageRefSuccessReason, "Successfully fetched Image CR Ref")
 
-	poolCRdiffersFromGarmPool, idleRunners, err := r.comparePoolSpecs(ctx, pool, image.Spec.Tag, garmClient)
+	drifts, garmRunners, err := r.comparePoolSpecs(ctx, pool, image.Spec.Tag, garmClient)
 	if err != nil {
 		err := fmt.Errorf("error comparing pool specs: %s", err.Error())
 		conditions.MarkFalse(pool, conditions.ReadyCondition, conditions.ReconcileErrorReason, err.Error())
@@ -190,8 +283,20 @@ func (r *PoolReconciler) reconcileUpdate(ctx context.Context, garmClient garmCli
 		return ctrl.Result{}, err
 	}
 
-	if !poolCRdiffersFromGarmPool {
-		log.Info("pool CR differs from pool on garm side. Trigger a garm pool update")
+	// changes of the pool spec are always applied, the drift policy only applies to changes made in garm directly
+	specChanged := pool.Generation != pool.Status.ObservedGeneration
+	driftPolicy := pool.Spec.DriftPolicy
+	if driftPolicy == "" {
+		driftPolicy = garmoperatorv1beta1.DriftPolicyEnforce
+	}
+
+	inSync := len(drifts) == 0
+	if !inSync && !specChanged && driftPolicy != garmoperatorv1beta1.DriftPolicyIgnore {
+		r.reportDrift(ctx, pool, driftPolicy, drifts)
+	}
+
+	if !inSync && (specChanged || driftPolicy == garmoperatorv1beta1.DriftPolicyEnforce) {
+		log.Info("pool CR differs from pool on garm side. Trigger a garm pool update", "fields", poolUtil.DriftedFields(drifts))
 
 		if err = poolUtil.UpdatePool(ctx, garmClient, pool, image); err != nil {
 			log.Error(err, "error updating pool")
@@ -199,55 +304,219 @@ func (r *PoolReconciler) reconcileUpdate(ctx context.Context, garmClient garmCli
 			r.errorLog(ctx, pool, err)
 			return ctrl.Result{}, err
 		}
+		pool.Status.LastDriftedFields = poolUtil.DriftedFields(drifts)
+		inSync = true
 	}
+	pool.Status.ObservedGeneration = pool.Generation
 
-	longRunningIdleRunnersCount := len(runnerUtil.OldIdleRunners(config.Config.Operator.MinIdleRunnersAge, idleRunners))
+	if r.Jobs != nil {
+		r.Jobs.Observe(garmRunners...)
+		r.Jobs.Forget(pool.Status.ID, garmRunners)
+	}
 
-	switch pool.Spec.MinIdleRunners {
-	case 0:
-		// scale to zero
-		// when scale to zero is desired, we immediately scale down to zero by deleting all idle runners
+	// runners which are protected by their RunnerCR are never deleted by the pool
+	protectedRunners, err := r.protectedRunners(ctx, pool)
+	if err != nil {
+		conditions.MarkFalse(pool, conditions.ReadyCondition, conditions.ReconcileErrorReason, err.Error())
+		r.errorLog(ctx, pool, err)
+		return ctrl.Result{}, err
+	}
 
-		log.Info("Scaling pool", "pool", pool.Name)
-		event.Scaling(r.Recorder, pool, fmt.Sprintf("scale idle runners down to %d", pool.Spec.MinIdleRunners))
+	// delete runners which are stuck or faulty, so that garm recreates them
+	remediatedRunners := r.remediateRunners(ctx, instanceClient, pool, runnerUtil.ExcludeRunners(garmRunners, protectedRunners))
 
-		runners := runnerUtil.DeletableRunners(ctx, idleRunners)
-		for _, runner := range runners {
-			if err := instanceClient.DeleteInstance(instances.NewDeleteInstanceParams().WithInstanceName(runner.Name)); err != nil {
-				log.Error(err, "unable to delete runner", "runner", runner.Name)
-			}
-			longRunningIdleRunnersCount--
-		}
+	// we are only interested in IdleRunners
+	idleRunners := runnerUtil.IdleRunners(ctx, garmRunners)
+	reapableIdleRunners := runnerUtil.ExcludeRunners(idleRunners, protectedRunners)
+	runnerCounts := runnerUtil.CountRunners(garmRunners)
+	runnerCounts.Total -= remediatedRunners
+
+	scaleDown := poolScaleDownPolicy(pool)
+	longRunningIdleRunnersCount := len(runnerUtil.OldIdleRunners(scaleDown.minIdleRunnersAge, idleRunners))
+	idleRunnersCount := len(idleRunners)
+	scaleDownReason := ""
+	result := ctrl.Result{}
+
+	var runners []params.Instance
+	scaleDownMsg := ""
+	switch pool.GarmMinIdleRunners() {
+	case 0:
+		// scale to zero
+		// when scale to zero is desired, we scale down to zero by deleting all idle runners
+		runners = runnerUtil.DeletableRunners(ctx, reapableIdleRunners)
+		scaleDownMsg = fmt.Sprintf("scale idle runners down to %d", pool.Spec.MinIdleRunners)
 	default:
 		// If there are more old idle Runners than minIdleRunners are defined in
 		// the spec, we delete old idle runners
 
 		// get all idle runners that are older than minRunnerAge
-		longRunningIdleRunners := runnerUtil.OldIdleRunners(config.Config.Operator.MinIdleRunnersAge, idleRunners)
+		longRunningIdleRunners := runnerUtil.OldIdleRunners(scaleDown.minIdleRunnersAge, idleRunners)
 
-		// calculate how many old runners need to be deleted to match the desired minIdleRunners
-		alignedRunners := runnerUtil.AlignIdleRunners(int(pool.Spec.MinIdleRunners), longRunningIdleRunners)
+		// calculate how many old runners need to be deleted to match the desired minIdleRunners,
+		// protected runners count towards minIdleRunners but are never deleted
+		reapableLongRunningIdleRunners := runnerUtil.ExcludeRunners(longRunningIdleRunners, protectedRunners)
+		protectedCount := len(longRunningIdleRunners) - len(reapableLongRunningIdleRunners)
+		// runners which garm keeps to recycle runners aren't scaled down either
+		alignedRunners := runnerUtil.AlignIdleRunners(max(int(pool.GarmMinIdleRunners())-protectedCount, 0), reapableLongRunningIdleRunners)
 
 		// extract runners which are deletable
-		runners := runnerUtil.DeletableRunners(ctx, alignedRunners)
-		for _, runner := range runners {
-			log.Info("Scaling pool", "pool", pool.Name)
-			event.Scaling(r.Recorder, pool, fmt.Sprintf("scale long running idle runners down to %d", pool.Spec.MinIdleRunners))
+		runners = runnerUtil.DeletableRunners(ctx, alignedRunners)
+		scaleDownMsg = fmt.Sprintf("scale long running idle runners down to %d", pool.Spec.MinIdleRunners)
+	}
 
-			if err := instanceClient.DeleteInstance(instances.NewDeleteInstanceParams().WithInstanceName(runner.Name)); err != nil {
-				log.Error(err, "unable to delete runner", "runner", runner.Name)
-			}
-			longRunningIdleRunnersCount--
+	// wait until the cooldown since the last scale down has passed
+	if cooldown := scaleDown.cooldownLeft(pool); len(runners) > 0 && cooldown > 0 {
+		log.V(1).Info("waiting for scale down cooldown", "runners", len(runners), "cooldown", cooldown)
+		result = ctrl.Result{RequeueAfter: cooldown}
+		runners = nil
+	}
+
+	// delete at most step size runners at once and continue with the rest after the cooldown
+	if scaleDown.stepSize > 0 && len(runners) > scaleDown.stepSize {
+		runners = runners[:scaleDown.stepSize]
+		result = ctrl.Result{RequeueAfter: max(scaleDown.cooldown, poolScaleDownInterval)}
+	}
+
+	for _, runner := range runners {
+		log.Info("Scaling pool", "pool", pool.Name)
+		event.Scaling(r.Recorder, pool, scaleDownMsg)
+
+		if err := instanceClient.DeleteInstance(instances.NewDeleteInstanceParams().WithInstanceName(runner.Name)); err != nil {
+			log.Error(err, "unable to delete runner", "runner", runner.Name)
+			continue
 		}
+		metrics.RunnersReaped.WithLabelValues(pool.Namespace, pool.Name, metrics.ReapReasonScaleDown).Inc()
+		longRunningIdleRunnersCount--
+		idleRunnersCount--
+		runnerCounts.Total--
+		// the cooldown only starts once runners actually got deleted, failed deletions are retried on the next reconcile
+		scaleDownReason = scaleDownMsg
+	}
+
+	// recycle runners which exceed the runner lifecycle of the pool,
+	// but not while the pool is scaling down to not delete more runners at once than intended
+	if (pool.Spec.RunnerLifecycle != nil || pool.Status.RecycleSurge > 0) && len(runners) == 0 && result.IsZero() {
+		var recycled int
+		result, recycled = r.recycleRunners(ctx, garmClient, instanceClient, pool, reapableIdleRunners, runnerCounts)
+		idleRunnersCount -= recycled
+		runnerCounts.Total -= recycled
 	}
 
 	// update pool idle runners count in status
 	if pool.Status.LongRunningIdleRunners != uint(longRunningIdleRunnersCount) {
 		pool.Status.LongRunningIdleRunners = uint(longRunningIdleRunnersCount)
 	}
+	pool.Status.IdleRunners = uint(idleRunnersCount)
+	pool.Status.ActiveRunners = uint(runnerCounts.Active)
+	pool.Status.PendingRunners = uint(runnerCounts.Pending)
+	pool.Status.FailedRunners = uint(runnerCounts.Failed)
+	pool.Status.TotalRunners = uint(runnerCounts.Total)
+	// drifts which aren't enforced are kept in garm, so max runners might differ from the spec
+	if inSync {
+		pool.Status.MaxRunners = pool.Spec.MaxRunners
+	}
+	setPoolRunnersMetric(pool)
+
+	if scaleDownReason != "" {
+		now := metav1.Now()
+		pool.Status.LastScaleDownTime = &now
+		pool.Status.LastScaleDownReason = scaleDownReason
+	}
 
 	conditions.MarkTrue(pool, conditions.ReadyCondition, conditions.SuccessfulReconcileReason, "")
-	return ctrl.Result{}, nil
+	return result, nil
+}
+
+// protectedRunners returns the lowercase names of the runners of the pool whose RunnerCR is annotated with key.DoNotReapAnnotation
+func (r *PoolReconciler) protectedRunners(ctx context.Context, pool *garmoperatorv1beta1.Pool) (map[string]bool, error) {
+	runners := &garmoperatorv1beta1.RunnerList{}
+	if err := r.List(ctx, runners, client.InNamespace(pool.Namespace), client.MatchingLabels{key.PoolLabel: pool.Name}); err != nil {
+		return nil, err
+	}
+
+	protected := map[string]bool{}
+	for _, runner := range runners.Items {
+		if runner.Annotations[key.DoNotReapAnnotation] == "true" {
+			protected[runner.Name] = true
+		}
+	}
+	return protected, nil
+}
+
+// recycleRunners deletes a batch of idle runners which exceed the runner lifecycle of the pool, so that garm replaces them with fresh ones.
+// If the pool has no surplus idle runners, garm first has to create additional idle runners, so recycling never drops below minIdleRunners.
+// It returns when the next batch should be recycled and the number of deleted runners.
+func (r *PoolReconciler) recycleRunners(ctx context.Context, poolClient garmClient.PoolClient, instanceClient garmClient.InstanceClient, pool *garmoperatorv1beta1.Pool, idleRunners []params.Instance, runnerCounts runnerUtil.RunnerCounts) (ctrl.Result, int) {
+	log := log.FromContext(ctx).
+		WithName("recycleRunners")
+
+	lifecycle := pool.Spec.RunnerLifecycle
+	expiredRunners := runnerUtil.ExpiredRunners(lifecycle, runnerUtil.DeletableRunners(ctx, idleRunners), r.Jobs)
+	if len(expiredRunners) == 0 {
+		// all expired runners are recycled, so garm doesn't need to keep the additional idle runners anymore
+		if pool.Status.RecycleSurge > 0 {
+			r.setRecycleSurge(ctx, poolClient, pool, 0)
+		}
+		return ctrl.Result{}, 0
+	}
+
+	batchInterval := defaultRecycleBatchInterval
+	if lifecycle.BatchInterval != nil {
+		batchInterval = lifecycle.BatchInterval.Duration
+	}
+
+	// wait until the batch interval since the last recycled batch has passed
+	if pool.Status.LastRecycleTime != nil {
+		if wait := batchInterval - time.Since(pool.Status.LastRecycleTime.Time); wait > 0 {
+			log.V(1).Info("waiting for next recycle batch", "expiredRunners", len(expiredRunners), "wait", wait)
+			return ctrl.Result{RequeueAfter: wait}, 0
+		}
+	}
+
+	batchSize := max(int(lifecycle.BatchSize), 1)
+	runners := runnerUtil.RecyclableRunners(batchSize, int(pool.Spec.MinIdleRunners), runnerCounts, expiredRunners)
+
+	// let garm create the replacements first, if the expired runners can't be deleted without dropping below minIdleRunners
+	surge := uint(runnerUtil.RecycleSurge(batchSize, int(pool.Spec.MinIdleRunners), int(pool.Spec.MaxRunners)))
+	if len(runners) == 0 && runnerCounts.Pending == 0 && pool.Status.RecycleSurge != surge {
+		log.Info("Surging idle runners to recycle runners", "expiredRunners", len(expiredRunners), "surge", surge)
+		r.setRecycleSurge(ctx, poolClient, pool, surge)
+		return ctrl.Result{RequeueAfter: batchInterval}, 0
+	}
+
+	recycled := 0
+	for _, runner := range runners {
+		log.Info("Recycling runner", "runner", runner.Name)
+		event.Recycling(r.Recorder, pool, fmt.Sprintf("recycle runner %s as it exceeds the runner lifecycle", runner.Name))
+
+		if err := instanceClient.DeleteInstance(instances.NewDeleteInstanceParams().WithInstanceName(runner.Name)); err != nil {
+			log.Error(err, "unable to delete runner", "runner", runner.Name)
+			continue
+		}
+		metrics.RunnersReaped.WithLabelValues(pool.Namespace, pool.Name, metrics.ReapReasonStale).Inc()
+		recycled++
+	}
+
+	if recycled > 0 {
+		now := metav1.Now()
+		pool.Status.LastRecycleTime = &now
+	}
+
+	// there are either more expired runners left or the pool isn't ready for recycling yet
+	return ctrl.Result{RequeueAfter: batchInterval}, recycled
+}
+
+// setRecycleSurge sets the min idle runners of the pool in garm to minIdleRunners plus surge
+func (r *PoolReconciler) setRecycleSurge(ctx context.Context, poolClient garmClient.PoolClient, pool *garmoperatorv1beta1.Pool, surge uint) {
+	log := log.FromContext(ctx).
+		WithName("setRecycleSurge")
+
+	minIdleRunners := pool.Spec.MinIdleRunners + surge
+	if _, err := poolClient.UpdatePool(pools.NewUpdatePoolParams().WithPoolID(pool.Status.ID).WithBody(params.UpdatePoolParams{MinIdleRunners: &minIdleRunners})); err != nil {
+		log.Error(err, "unable to update min idle runners of pool", "minIdleRunners", minIdleRunners)
+		return
+	}
+	pool.Status.RecycleSurge = surge
 }
 
 func (r *PoolReconciler) reconcileDelete(ctx context.Context, garmClient garmClient.PoolClient, pool *garmoperatorv1beta1.Pool, instanceClient garmClient.InstanceClient) (ctrl.Result, error) {
@@ -269,6 +538,8 @@ func (r *PoolReconciler) reconcileDelete(ctx context.Context, garmClient garmCli
 			return ctrl.Result{}, err
 		}
 
+		deletePoolMetrics(pool)
+
 		log.Info("Successfully deleted pool", "pool", pool.Name)
 		return ctrl.Result{}, nil
 	}
@@ -280,6 +551,7 @@ func (r *PoolReconciler) reconcileDelete(ctx context.Context, garmClient garmCli
 		r.errorLog(ctx, pool, err)
 		return ctrl.Result{}, err
 	}
+	pool.Status.RecycleSurge = 0
 
 	if err := poolUtil.UpdatePool(ctx, garmClient, pool, nil); err != nil {
 		conditions.MarkFalse(pool, conditions.ReadyCondition, conditions.ReconcileErrorReason, err.Error())
@@ -293,10 +565,18 @@ func (r *PoolReconciler) reconcileDelete(ctx context.Context, garmClient garmCli
 		return ctrl.Result{RequeueAfter: 1 * time.Minute}, err
 	}
 
-	// get a list of all idle runners to trigger deletion
-	deletableRunners := runnerUtil.DeletableRunners(ctx, runners)
-	if err != nil {
-		return ctrl.Result{RequeueAfter: 1 * time.Minute}, err
+	// runners which are running a job get the chance to finish it until the drain timeout is exceeded
+	activeRunners := runnerUtil.ActiveRunners(runners)
+	drainDeadline := poolDrainDeadline(pool)
+	drainTimeoutExceeded := !time.Now().Before(drainDeadline)
+	forceDelete := drainTimeoutExceeded && pool.Spec.ForceDeleteAfterDrainTimeout
+
+	// get a list of all runners which aren't running a job to trigger deletion
+	deletableRunners := runnerUtil.DeletableRunners(ctx, runnerUtil.InactiveRunners(runners))
+	if forceDelete {
+		// after the drain timeout, runners which are stuck in any state are force deleted as well,
+		// otherwise they would block the deletion of the pool forever
+		deletableRunners = runners
 	}
 
 	// set current idle runners count in status
@@ -307,11 +587,44 @@ func (r *PoolReconciler) reconcileDelete(ctx context.Context, garmClient garmCli
 	event.Scaling(r.Recorder, pool, fmt.Sprintf("scale idle runners down to %d before deleting", pool.Spec.MinIdleRunners))
 
 	for _, runner := range deletableRunners {
-		if err := instanceClient.DeleteInstance(instances.NewDeleteInstanceParams().WithInstanceName(runner.Name)); err != nil {
+		deleteParams := instances.NewDeleteInstanceParams().WithInstanceName(runner.Name)
+		if forceDelete {
+			deleteParams = deleteParams.WithForceRemove(&forceDelete)
+		}
+		if err := instanceClient.DeleteInstance(deleteParams); err != nil {
 			log.Error(err, "unable to delete runner", "runner", runner.Name)
 		}
 	}
 
+	// garm refuses to delete a pool which still has runners, so wait until all of them are gone
+	if len(runners) > 0 {
+		switch {
+		case forceDelete:
+			msg := fmt.Sprintf("drain timeout exceeded, force deleting %d runners", len(deletableRunners))
+			log.Info(msg, "pool", pool.Name)
+			event.Deleting(r.Recorder, pool, msg)
+			conditions.MarkTrue(pool, conditions.Draining, conditions.DrainTimeoutExceededReason, msg)
+		case drainTimeoutExceeded:
+			msg := fmt.Sprintf("drain timeout exceeded, %d runners left, %d of them still running a job", len(runners), len(activeRunners))
+			log.Info(msg, "pool", pool.Name)
+			conditions.MarkTrue(pool, conditions.Draining, conditions.DrainTimeoutExceededReason, msg)
+		default:
+			msg := fmt.Sprintf("%d runners left, %d of them still running a job", len(runners), len(activeRunners))
+			log.Info(msg, "pool", pool.Name, "drainDeadline", drainDeadline)
+			conditions.MarkTrue(pool, conditions.Draining, conditions.DrainingRunnersReason, msg)
+		}
+
+		requeueAfter := poolDrainInterval
+		if !drainTimeoutExceeded {
+			requeueAfter = min(requeueAfter, time.Until(drainDeadline))
+		}
+		return ctrl.Result{RequeueAfter: requeueAfter}, nil
+	}
+
+	if conditions.Get(pool, conditions.Draining) != nil {
+		conditions.MarkFalse(pool, conditions.Draining, conditions.DrainCompletedReason, "all runners are deleted")
+	}
+
 	// delete pool in garm
 	if err = garmClient.DeletePool(pools.NewDeletePoolParams().WithPoolID(pool.Status.ID)); err != nil {
 		conditions.MarkFalse(pool, conditions.ReadyCondition, conditions.DeletionFailedReason, err.Error())
@@ -326,11 +639,161 @@ func (r *PoolReconciler) reconcileDelete(ctx context.Context, garmClient garmCli
 		r.errorLog(ctx, pool, err)
 		return ctrl.Result{}, err
 	}
+	deletePoolMetrics(pool)
 
 	log.Info("Successfully deleted pool", "pool", pool.Name)
 	return ctrl.Result{}, nil
 }
 
+// remediateRunners deletes runners which are stuck while being created or report a provider fault within the retry budget of the pool.
+// It reflects unhealthy runners in the RunnersDegraded condition and returns the number of deleted runners.
+func (r *PoolReconciler) remediateRunners(ctx context.Context, instanceClient garmClient.InstanceClient, pool *garmoperatorv1beta1.Pool, garmRunners []params.Instance) int {
+	log := log.FromContext(ctx).
+		WithName("remediateRunners")
+
+	bootstrapTimeout := runnerUtil.DefaultRunnerBootstrapTimeout
+	if pool.Spec.RunnerBootstrapTimeout > 0 {
+		bootstrapTimeout = time.Duration(pool.Spec.RunnerBootstrapTimeout) * time.Minute
+	}
+
+	faultyRunners := runnerUtil.FaultyRunners(garmRunners)
+	stuckRunners := runnerUtil.StuckRunners(bootstrapTimeout, garmRunners)
+
+	if len(faultyRunners) == 0 && len(stuckRunners) == 0 {
+		if conditions.Get(pool, conditions.RunnersDegraded) != nil {
+			conditions.MarkFalse(pool, conditions.RunnersDegraded, conditions.RunnersHealthyReason, "")
+		}
+		return 0
+	}
+
+	maxRetries, window := uint(defaultRemediationRetries), defaultRemediationWindow
+	if remediation := pool.Spec.RunnerRemediation; remediation != nil {
+		if remediation.MaxRetries != nil {
+			maxRetries = *remediation.MaxRetries
+		}
+		if remediation.RetryWindow != nil {
+			window = remediation.RetryWindow.Duration
+		}
+	}
+
+	// start a new retry window once the current one is over
+	if pool.Status.RemediationWindowStart == nil || time.Since(pool.Status.RemediationWindowStart.Time) > window {
+		now := metav1.Now()
+		pool.Status.RemediationWindowStart = &now
+		pool.Status.RemediatedRunners = 0
+	}
+
+	reason, msg := conditions.StuckRunnersReason, fmt.Sprintf("%d runners are stuck for longer than %s", len(stuckRunners), bootstrapTimeout)
+	if len(faultyRunners) > 0 {
+		reason, msg = conditions.ProviderFaultReason, fmt.Sprintf("%d runners report a provider fault, %d runners are stuck for longer than %s", len(faultyRunners), len(stuckRunners), bootstrapTimeout)
+	}
+
+	remediated := 0
+	forceRemove := true
+	for _, runner := range append(faultyRunners, stuckRunners...) {
+		if pool.Status.RemediatedRunners >= maxRetries {
+			reason = conditions.RemediationBudgetExhaustedReason
+			msg = fmt.Sprintf("%s, retry budget of %d runners per %s is exhausted", msg, maxRetries, window)
+			break
+		}
+
+		eventMsg, reapReason := fmt.Sprintf("deleting runner %s which is stuck in state %s/%s", runner.Name, runner.Status, runner.RunnerStatus), metrics.ReapReasonStale
+		if len(runner.ProviderFault) > 0 {
+			eventMsg, reapReason = fmt.Sprintf("deleting runner %s which reports a provider fault: %s", runner.Name, string(runner.ProviderFault)), metrics.ReapReasonFault
+		}
+		log.Info(eventMsg)
+		event.Remediate(r.Recorder, pool, eventMsg)
+
+		if err := instanceClient.DeleteInstance(instances.NewDeleteInstanceParams().WithInstanceName(runner.Name).WithForceRemove(&forceRemove)); err != nil {
+			log.Error(err, "unable to delete runner", "runner", runner.Name)
+			continue
+		}
+		metrics.RunnersReaped.WithLabelValues(pool.Namespace, pool.Name, reapReason).Inc()
+		pool.Status.RemediatedRunners++
+		remediated++
+	}
+
+	conditions.MarkTrue(pool, conditions.RunnersDegraded, reason, msg)
+	return remediated
+}
+
+// setPoolRunnersMetric exposes the runner counts of the pool status as metric
+func setPoolRunnersMetric(pool *garmoperatorv1beta1.Pool) {
+	// the scope and provider of the pool might have changed, so the series with the previous labels are removed first
+	metrics.PoolRunners.DeletePartialMatch(prometheus.Labels{"namespace": pool.Namespace, "pool": pool.Name})
+	for state, count := range map[string]uint{
+		"idle":    pool.Status.IdleRunners,
+		"active":  pool.Status.ActiveRunners,
+		"pending": pool.Status.PendingRunners,
+		"failed":  pool.Status.FailedRunners,
+	} {
+		metrics.PoolRunners.WithLabelValues(pool.Namespace, pool.Name, pool.Spec.GitHubScopeRef.Kind, pool.Spec.GitHubScopeRef.Name, pool.Spec.ProviderName, state).Set(float64(count))
+	}
+}
+
+// deletePoolMetrics removes all series of the pool, so they don't pile up as pools come and go
+func deletePoolMetrics(pool *garmoperatorv1beta1.Pool) {
+	labels := prometheus.Labels{"namespace": pool.Namespace, "pool": pool.Name}
+	metrics.PoolRunners.DeletePartialMatch(labels)
+	metrics.PoolDrifts.DeletePartialMatch(labels)
+	metrics.RunnersReaped.DeletePartialMatch(labels)
+	metrics.RunnerBootstrapDuration.DeletePartialMatch(labels)
+	metrics.RunnerIdleDuration.DeletePartialMatch(labels)
+}
+
+// scaleDownPolicy is the scale down behaviour of a pool with the operator configuration as fallback
+type scaleDownPolicy struct {
+	minIdleRunnersAge time.Duration
+	stepSize          int
+	cooldown          time.Duration
+}
+
+// poolScaleDownPolicy returns the scale down behaviour of the pool, falling back to the operator configuration for unset fields
+func poolScaleDownPolicy(pool *garmoperatorv1beta1.Pool) scaleDownPolicy {
+	policy := scaleDownPolicy{
+		minIdleRunnersAge: config.Config.Operator.MinIdleRunnersAge,
+		stepSize:          config.Config.Operator.ScaleDownStepSize,
+		cooldown:          config.Config.Operator.ScaleDownCooldown,
+	}
+
+	if pool.Spec.ScaleDown == nil {
+		return policy
+	}
+	if pool.Spec.ScaleDown.MinIdleRunnersAge != nil {
+		policy.minIdleRunnersAge = pool.Spec.ScaleDown.MinIdleRunnersAge.Duration
+	}
+	if pool.Spec.ScaleDown.StepSize > 0 {
+		policy.stepSize = int(pool.Spec.ScaleDown.StepSize)
+	}
+	if pool.Spec.ScaleDown.Cooldown != nil {
+		policy.cooldown = pool.Spec.ScaleDown.Cooldown.Duration
+	}
+	return policy
+}
+
+// cooldownLeft returns the time until the pool may scale down again
+func (p scaleDownPolicy) cooldownLeft(pool *garmoperatorv1beta1.Pool) time.Duration {
+	if p.cooldown <= 0 || pool.Status.LastScaleDownTime == nil {
+		return 0
+	}
+	return p.cooldown - time.Since(pool.Status.LastScaleDownTime.Time)
+}
+
+// poolDrainDeadline returns the point in time until the runners of a deleted pool get to finish their jobs
+func poolDrainDeadline(pool *garmoperatorv1beta1.Pool) time.Time {
+	drainTimeout := config.Config.Operator.PoolDrainTimeout
+	if pool.Spec.DrainTimeout != nil {
+		drainTimeout = pool.Spec.DrainTimeout.Duration
+	}
+
+	drainStart := time.Now()
+	if pool.DeletionTimestamp != nil {
+		drainStart = pool.DeletionTimestamp.Time
+	}
+
+	return drainStart.Add(drainTimeout)
+}
+
 func (r *PoolReconciler) errorLog(ctx context.Context, obj client.Object, err error) {
 	log := log.FromContext(ctx)
 
@@ -338,27 +801,53 @@ func (r *PoolReconciler) errorLog(ctx context.Context, obj client.Object, err er
 	event.Error(r.Recorder, obj, err.Error())
 }
 
-func (r *PoolReconciler) comparePoolSpecs(ctx context.Context, pool *garmoperatorv1beta1.Pool, imageTag string, poolClient garmClient.PoolClient) (bool, []params.Instance, error) {
+// reportDrift emits an event and increments the drift metric for the fields of the garm pool which were changed outside of the pool spec
+func (r *PoolReconciler) reportDrift(ctx context.Context, pool *garmoperatorv1beta1.Pool, policy garmoperatorv1beta1.DriftPolicy, drifts []poolUtil.FieldDrift) {
+	log := log.FromContext(ctx)
+
+	// a drift which is kept in garm is only reported once and not on every reconcile
+	fields := poolUtil.DriftedFields(drifts)
+	if policy == garmoperatorv1beta1.DriftPolicyReportOnly && slices.Equal(fields, pool.Status.LastDriftedFields) {
+		return
+	}
+
+	msgs := make([]string, 0, len(drifts))
+	for _, drift := range drifts {
+		msgs = append(msgs, drift.String())
+		metrics.PoolDrifts.WithLabelValues(pool.Namespace, pool.Name, drift.Field, string(policy)).Inc()
+	}
+
+	msg := fmt.Sprintf("pool %s in garm differs from the pool spec (policy %s): %s", pool.Status.ID, policy, strings.Join(msgs, "; "))
+	log.Info(msg)
+	event.Drift(r.Recorder, pool, msg)
+
+	if policy == garmoperatorv1beta1.DriftPolicyReportOnly {
+		pool.Status.LastDriftedFields = fields
+	}
+}
+
+// comparePoolSpecs returns the fields of the garm pool which differ from the pool spec and the runners of the garm pool
+func (r *PoolReconciler) comparePoolSpecs(ctx context.Context, pool *garmoperatorv1beta1.Pool, imageTag string, poolClient garmClient.PoolClient) ([]poolUtil.FieldDrift, []params.Instance, error) {
 	log := log.FromContext(ctx).
 		WithName("comparePoolSpecs")
 
 	gitHubScopeRef, err := r.fetchGitHubScopeCRD(ctx, pool)
 	if err != nil {
 		log.Error(err, "error fetching GitHubScopeRef")
-		return false, []params.Instance{}, err
+		return nil, nil, err
 	}
 
 	// as there are some "special" tags, which aren't set by the user and aren't part of the pool spec
 	// we need to "discover" them and add them to the pool spec before comparing
 	poolTags, err := tags.CreateComparableRunnerTags(pool.Spec.Tags, pool.Spec.OSArch, pool.Spec.OSType)
 	if err != nil {
-		return false, []params.Instance{}, err
+		return nil, nil, err
 	}
 
 	// get the current pool from garm
 	garmPool, err := poolClient.GetPool(pools.NewGetPoolParams().WithPoolID(pool.Status.ID))
 	if err != nil {
-		return false, []params.Instance{}, err
+		return nil, nil, err
 	}
 
 	// sort tags to ensure that the order is always the same
@@ -374,7 +863,7 @@ func (r *PoolReconciler) comparePoolSpecs(ctx context.Context, pool *garmoperato
 			Prefix: pool.Spec.RunnerPrefix,
 		},
 		MaxRunners:             pool.Spec.MaxRunners,
-		MinIdleRunners:         pool.Spec.MinIdleRunners,
+		MinIdleRunners:         pool.GarmMinIdleRunners(),
 		Image:                  imageTag,
 		Flavor:                 pool.Spec.Flavor,
 		OSType:                 pool.Spec.OSType,
@@ -382,12 +871,16 @@ func (r *PoolReconciler) comparePoolSpecs(ctx context.Context, pool *garmoperato
 		Tags:                   poolTags,
 		Enabled:                pool.Spec.Enabled,
 		RunnerBootstrapTimeout: pool.Spec.RunnerBootstrapTimeout,
-		ExtraSpecs:             json.RawMessage([]byte(pool.Spec.ExtraSpecs)),
 		GitHubRunnerGroup:      pool.Spec.GitHubRunnerGroup,
 		ID:                     pool.Status.ID,
 		ProviderName:           pool.Spec.ProviderName,
 	}
 
+	// garm omits empty extra specs
+	if pool.Spec.ExtraSpecs != "" {
+		tmpGarmPool.ExtraSpecs = json.RawMessage([]byte(pool.Spec.ExtraSpecs))
+	}
+
 	switch gitHubScopeRef.GetKind() {
 	case string(garmoperatorv1beta1.EnterpriseScope):
 		tmpGarmPool.EnterpriseID = gitHubScopeRef.GetID()
@@ -400,13 +893,12 @@ func (r *PoolReconciler) comparePoolSpecs(ctx context.Context, pool *garmoperato
 		tmpGarmPool.RepoName = gitHubScopeRef.GetName()
 	}
 
-	// we are only interested in IdleRunners
-	idleInstances := runnerUtil.IdleRunners(ctx, garmPool.Payload.Instances)
+	runners := garmPool.Payload.Instances
 
 	// empty instances for comparison
 	garmPool.Payload.Instances = nil
 
-	return reflect.DeepEqual(tmpGarmPool, garmPool.Payload), idleInstances, nil
+	return poolUtil.Diff(tmpGarmPool, garmPool.Payload), runners, nil
 }
 
 func (r *PoolReconciler) fetchGitHubScopeCRD(ctx context.Context, pool *garmoperatorv1beta1.Pool) (garmoperatorv1beta1.GitHubScope, error) {
@@ -5,6 +5,7 @@ package controller
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"reflect"
 	"testing"
 	"time"
@@ -14,6 +15,8 @@ import (
 	"github.com/cloudbase/garm/client/instances"
 	"github.com/cloudbase/garm/client/pools"
 	"github.com/cloudbase/garm/params"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -29,6 +32,9 @@ import (
 	"github.com/mercedes-benz/garm-operator/pkg/client/mock"
 	"github.com/mercedes-benz/garm-operator/pkg/conditions"
 	"github.com/mercedes-benz/garm-operator/pkg/config"
+	"github.com/mercedes-benz/garm-operator/pkg/metrics"
+	poolUtil "github.com/mercedes-benz/garm-operator/pkg/pools"
+	"github.com/mercedes-benz/garm-operator/pkg/tags"
 )
 
 const namespaceName = "test-namespace"
@@ -121,7 +127,7 @@ func TestPoolController_ReconcileCreate(t *testing.T) {
 				Status: garmoperatorv1beta1.PoolStatus{
 					ID:                     poolID,
 					LongRunningIdleRunners: 3,
-					Selector:               "",
+					Selector:               "garm-operator.mercedes-benz.com/pool=my-enterprise-pool",
 					Conditions: []metav1.Condition{
 						{
 							Type:               string(conditions.ReadyCondition),
@@ -272,7 +278,7 @@ func TestPoolController_ReconcileCreate(t *testing.T) {
 			},
 		},
 		{
-			name: "pool.Status has matching id in garm database, pool.Specs changed - update pool in garm",
+			name: "pool does not exist in garm - adopt existing pool by spec",
 			object: &garmoperatorv1beta1.Pool{
 				TypeMeta: metav1.TypeMeta{
 					Kind:       "Pool",
@@ -281,6 +287,9 @@ func TestPoolController_ReconcileCreate(t *testing.T) {
 				ObjectMeta: metav1.ObjectMeta{
 					Name:      "my-enterprise-pool",
 					Namespace: namespaceName,
+					Annotations: map[string]string{
+						key.AdoptAnnotation: "true",
+					},
 					Finalizers: []string{
 						key.PoolFinalizerName,
 					},
@@ -292,8 +301,8 @@ func TestPoolController_ReconcileCreate(t *testing.T) {
 						Name:     enterpriseName,
 					},
 					ProviderName:           "kubernetes_external",
-					MaxRunners:             1,
-					MinIdleRunners:         0,
+					MaxRunners:             5,
+					MinIdleRunners:         3,
 					ImageName:              "ubuntu-image",
 					Flavor:                 "medium",
 					OSType:                 "linux",
@@ -304,9 +313,6 @@ func TestPoolController_ReconcileCreate(t *testing.T) {
 					ExtraSpecs:             "",
 					GitHubRunnerGroup:      "",
 				},
-				Status: garmoperatorv1beta1.PoolStatus{
-					ID: poolID,
-				},
 			},
 			expectedObject: &garmoperatorv1beta1.Pool{
 				TypeMeta: metav1.TypeMeta{
@@ -327,8 +333,8 @@ func TestPoolController_ReconcileCreate(t *testing.T) {
 						Name:     enterpriseName,
 					},
 					ProviderName:           "kubernetes_external",
-					MaxRunners:             1,
-					MinIdleRunners:         0,
+					MaxRunners:             5,
+					MinIdleRunners:         3,
 					ImageName:              "ubuntu-image",
 					Flavor:                 "medium",
 					OSType:                 "linux",
@@ -340,7 +346,9 @@ func TestPoolController_ReconcileCreate(t *testing.T) {
 					GitHubRunnerGroup:      "",
 				},
 				Status: garmoperatorv1beta1.PoolStatus{
-					ID: poolID,
+					ID:                     poolID,
+					LongRunningIdleRunners: 0,
+					Selector:               "garm-operator.mercedes-benz.com/pool=my-enterprise-pool",
 					Conditions: []metav1.Condition{
 						{
 							Type:               string(conditions.ReadyCondition),
@@ -427,150 +435,40 @@ func TestPoolController_ReconcileCreate(t *testing.T) {
 				},
 			},
 			expectGarmRequest: func(poolClient *mock.MockPoolClientMockRecorder, _ *mock.MockInstanceClientMockRecorder) {
-				poolClient.GetPool(pools.NewGetPoolParams().WithPoolID(poolID)).Return(&pools.GetPoolOK{Payload: params.Pool{
-					RunnerPrefix: params.RunnerPrefix{
-						Prefix: "",
-					},
-					ID:             poolID,
-					ProviderName:   "kubernetes_external",
-					MaxRunners:     5,
-					MinIdleRunners: 3,
-					Image:          "linux-ubuntu-22.04-arm64",
-					Flavor:         "medium",
-					OSType:         "linux",
-					OSArch:         "arm64",
-					Tags: []params.Tag{
-						{
-							ID:   "b3ea9882-a25c-4eb1-94ba-6c70b9abb6da",
-							Name: "kubernetes",
-						},
-						{
-							ID:   "b3ea9882-a25c-4eb1-94ba-6c70b9abb6db",
-							Name: "linux",
-						},
-						{
-							ID:   "b3ea9882-a25c-4eb1-94ba-6c70b9abb6dc",
-							Name: "arm64",
-						},
-						{
-							ID:   "b3ea9882-a25c-4eb1-94ba-6c70b9abb6dd",
-							Name: "ubuntu",
-						},
-					},
-					Enabled:        true,
-					Instances:      []params.Instance{},
-					RepoID:         "",
-					RepoName:       "",
-					OrgID:          "",
-					OrgName:        "",
-					EnterpriseID:   enterpriseID,
-					EnterpriseName: enterpriseName,
-				}}, nil)
-
-				poolClient.GetPool(pools.NewGetPoolParams().WithPoolID(poolID)).Return(&pools.GetPoolOK{Payload: params.Pool{
-					RunnerPrefix: params.RunnerPrefix{
-						Prefix: "",
-					},
-					ID:             poolID,
-					ProviderName:   "kubernetes_external",
-					MaxRunners:     5,
-					MinIdleRunners: 3,
-					Image:          "linux-ubuntu-22.04-arm64",
-					Flavor:         "medium",
-					OSType:         "linux",
-					OSArch:         "arm64",
-					Tags: []params.Tag{
-						{
-							ID:   "b3ea9882-a25c-4eb1-94ba-6c70b9abb6da",
-							Name: "kubernetes",
-						},
-						{
-							ID:   "b3ea9882-a25c-4eb1-94ba-6c70b9abb6db",
-							Name: "linux",
-						},
-						{
-							ID:   "b3ea9882-a25c-4eb1-94ba-6c70b9abb6dc",
-							Name: "arm64",
-						},
-						{
-							ID:   "b3ea9882-a25c-4eb1-94ba-6c70b9abb6dd",
-							Name: "ubuntu",
-						},
-					},
-					Enabled:        true,
-					Instances:      []params.Instance{},
-					RepoID:         "",
-					RepoName:       "",
-					OrgID:          "",
-					OrgName:        "",
-					EnterpriseID:   enterpriseID,
-					EnterpriseName: enterpriseName,
-				}}, nil)
-
-				maxRunners := uint(1)
-				minIdleRunners := uint(0)
-				enabled := true
-				runnerBootstrapTimeout := uint(20)
-				extraSpecs := json.RawMessage([]byte{})
-				gitHubRunnerGroup := ""
-				poolClient.UpdatePool(pools.NewUpdatePoolParams().WithPoolID(poolID).WithBody(params.UpdatePoolParams{
-					RunnerPrefix: params.RunnerPrefix{
-						Prefix: "",
-					},
-					MaxRunners:             &maxRunners,
-					MinIdleRunners:         &minIdleRunners,
-					Image:                  "linux-ubuntu-22.04-arm64",
-					Flavor:                 "medium",
-					OSType:                 "linux",
-					OSArch:                 "arm64",
-					Tags:                   []string{"kubernetes", "linux", "arm64", "ubuntu"},
-					Enabled:                &enabled,
-					RunnerBootstrapTimeout: &runnerBootstrapTimeout,
-					ExtraSpecs:             extraSpecs,
-					GitHubRunnerGroup:      &gitHubRunnerGroup,
-				})).Return(&pools.UpdatePoolOK{Payload: params.Pool{
-					RunnerPrefix: params.RunnerPrefix{
-						Prefix: "",
-					},
-					ID:             poolID,
-					ProviderName:   "kubernetes_external",
-					MaxRunners:     1,
-					MinIdleRunners: 0,
-					Image:          "linux-ubuntu-22.04-arm64",
-					Flavor:         "medium",
-					OSType:         "linux",
-					OSArch:         "arm64",
-					Tags: []params.Tag{
-						{
-							ID:   "b3ea9882-a25c-4eb1-94ba-6c70b9abb6da",
-							Name: "kubernetes",
-						},
-						{
-							ID:   "b3ea9882-a25c-4eb1-94ba-6c70b9abb6db",
-							Name: "linux",
-						},
-						{
-							ID:   "b3ea9882-a25c-4eb1-94ba-6c70b9abb6dc",
-							Name: "arm64",
+				poolClient.ListAllPools(pools.NewListPoolsParams()).Return(&pools.ListPoolsOK{
+					Payload: params.Pools{
+						params.Pool{
+							ID:             "9b2a1c3e-34b8-4a4e-b9f5-4c1d5fa0e6a1",
+							ProviderName:   "kubernetes_external",
+							MaxRunners:     5,
+							MinIdleRunners: 3,
+							Image:          "linux-ubuntu-22.04-arm64",
+							Flavor:         "large",
+							OSType:         "linux",
+							OSArch:         "arm64",
+							Enabled:        true,
+							EnterpriseID:   enterpriseID,
+							EnterpriseName: enterpriseName,
 						},
-						{
-							ID:   "b3ea9882-a25c-4eb1-94ba-6c70b9abb6dd",
-							Name: "ubuntu",
+						params.Pool{
+							ID:             poolID,
+							ProviderName:   "kubernetes_external",
+							MaxRunners:     5,
+							MinIdleRunners: 3,
+							Image:          "linux-ubuntu-22.04-arm64",
+							Flavor:         "medium",
+							OSType:         "linux",
+							OSArch:         "arm64",
+							Enabled:        true,
+							EnterpriseID:   enterpriseID,
+							EnterpriseName: enterpriseName,
 						},
 					},
-					Enabled:        true,
-					Instances:      []params.Instance{},
-					RepoID:         "",
-					RepoName:       "",
-					OrgID:          "",
-					OrgName:        "",
-					EnterpriseID:   enterpriseID,
-					EnterpriseName: enterpriseName,
-				}}, nil)
+				}, nil)
 			},
 		},
 		{
-			name: "scaling idleRunners down to 2 - expect deletion of two old instances",
+			name: "pool does not exist in garm - adopt existing pool by id",
 			object: &garmoperatorv1beta1.Pool{
 				TypeMeta: metav1.TypeMeta{
 					Kind:       "Pool",
@@ -579,6 +477,9 @@ func TestPoolController_ReconcileCreate(t *testing.T) {
 				ObjectMeta: metav1.ObjectMeta{
 					Name:      "my-enterprise-pool",
 					Namespace: namespaceName,
+					Annotations: map[string]string{
+						key.AdoptAnnotation: poolID,
+					},
 					Finalizers: []string{
 						key.PoolFinalizerName,
 					},
@@ -590,8 +491,8 @@ func TestPoolController_ReconcileCreate(t *testing.T) {
 						Name:     enterpriseName,
 					},
 					ProviderName:           "kubernetes_external",
-					MaxRunners:             10,
-					MinIdleRunners:         2,
+					MaxRunners:             5,
+					MinIdleRunners:         3,
 					ImageName:              "ubuntu-image",
 					Flavor:                 "medium",
 					OSType:                 "linux",
@@ -602,10 +503,6 @@ func TestPoolController_ReconcileCreate(t *testing.T) {
 					ExtraSpecs:             "",
 					GitHubRunnerGroup:      "",
 				},
-				Status: garmoperatorv1beta1.PoolStatus{
-					ID:                     poolID,
-					LongRunningIdleRunners: 3,
-				},
 			},
 			expectedObject: &garmoperatorv1beta1.Pool{
 				TypeMeta: metav1.TypeMeta{
@@ -626,8 +523,8 @@ func TestPoolController_ReconcileCreate(t *testing.T) {
 						Name:     enterpriseName,
 					},
 					ProviderName:           "kubernetes_external",
-					MaxRunners:             10,
-					MinIdleRunners:         2,
+					MaxRunners:             5,
+					MinIdleRunners:         3,
 					ImageName:              "ubuntu-image",
 					Flavor:                 "medium",
 					OSType:                 "linux",
@@ -640,7 +537,8 @@ func TestPoolController_ReconcileCreate(t *testing.T) {
 				},
 				Status: garmoperatorv1beta1.PoolStatus{
 					ID:                     poolID,
-					LongRunningIdleRunners: 2,
+					LongRunningIdleRunners: 0,
+					Selector:               "garm-operator.mercedes-benz.com/pool=my-enterprise-pool",
 					Conditions: []metav1.Condition{
 						{
 							Type:               string(conditions.ReadyCondition),
@@ -726,269 +624,38 @@ func TestPoolController_ReconcileCreate(t *testing.T) {
 					},
 				},
 			},
-			expectGarmRequest: func(poolClient *mock.MockPoolClientMockRecorder, instanceClient *mock.MockInstanceClientMockRecorder) {
-				poolClient.GetPool(pools.NewGetPoolParams().WithPoolID(poolID)).Return(&pools.GetPoolOK{Payload: params.Pool{
-					RunnerPrefix: params.RunnerPrefix{
-						Prefix: "",
+			expectGarmRequest: func(poolClient *mock.MockPoolClientMockRecorder, _ *mock.MockInstanceClientMockRecorder) {
+				poolClient.GetPool(pools.NewGetPoolParams().WithPoolID(poolID)).Return(&pools.GetPoolOK{
+					Payload: params.Pool{
+						ID:             poolID,
+						ProviderName:   "kubernetes_external",
+						MaxRunners:     5,
+						MinIdleRunners: 3,
+						Image:          "linux-ubuntu-22.04-arm64",
+						Flavor:         "medium",
+						OSType:         "linux",
+						OSArch:         "arm64",
+						Enabled:        true,
+						EnterpriseID:   enterpriseID,
+						EnterpriseName: enterpriseName,
 					},
-					ID:             poolID,
-					ProviderName:   "kubernetes_external",
-					MaxRunners:     10,
-					MinIdleRunners: 5,
-					Image:          "linux-ubuntu-22.04-arm64",
-					Flavor:         "medium",
-					OSType:         "linux",
-					OSArch:         "arm64",
-					Tags: []params.Tag{
-						{
-							ID:   "b3ea9882-a25c-4eb1-94ba-6c70b9abb6da",
-							Name: "kubernetes",
-						},
-						{
-							ID:   "b3ea9882-a25c-4eb1-94ba-6c70b9abb6db",
-							Name: "linux",
-						},
-						{
-							ID:   "b3ea9882-a25c-4eb1-94ba-6c70b9abb6dc",
-							Name: "arm64",
-						},
-						{
-							ID:   "b3ea9882-a25c-4eb1-94ba-6c70b9abb6dd",
-							Name: "ubuntu",
-						},
+				}, nil)
+			},
+		},
+		{
+			name:    "pool does not exist in garm - error multiple pools to adopt found",
+			wantErr: true,
+			object: &garmoperatorv1beta1.Pool{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       "Pool",
+					APIVersion: garmoperatorv1beta1.GroupVersion.Group + "/" + garmoperatorv1beta1.GroupVersion.Version,
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "my-enterprise-pool",
+					Namespace: namespaceName,
+					Annotations: map[string]string{
+						key.AdoptAnnotation: "true",
 					},
-					Enabled: true,
-					Instances: []params.Instance{
-						{
-							ID:           "b3ea9882-a25c-4eb1-94ba-6c70b9abb6dd",
-							Name:         "kube-runner-5",
-							Status:       garmProviderParams.InstanceRunning,
-							RunnerStatus: params.RunnerIdle,
-							UpdatedAt:    time.Now(),
-						},
-						{
-							ID:           "b3ea9882-a25c-4eb1-94ba-6c70b9abb6de",
-							Name:         "kube-runner-4",
-							Status:       garmProviderParams.InstanceRunning,
-							RunnerStatus: params.RunnerIdle,
-							UpdatedAt:    time.Now().Add(-1 * time.Hour),
-						},
-						{
-							ID:           "b3ea9882-a25c-4eb1-94ba-6c70b9abb6df",
-							Name:         "kube-runner-3",
-							Status:       garmProviderParams.InstanceRunning,
-							RunnerStatus: params.RunnerIdle,
-							UpdatedAt:    time.Now().Add(-1 * time.Hour),
-						},
-						{
-							ID:           "b3ea9882-a25c-4eb1-94ba-6c70b9abb6dg",
-							Name:         "kube-runner-2",
-							Status:       garmProviderParams.InstanceRunning,
-							RunnerStatus: params.RunnerIdle,
-							UpdatedAt:    time.Now().Add(-1 * time.Hour),
-						},
-						{
-							ID:           "b3ea9882-a25c-4eb1-94ba-6c70b9abb6dh",
-							Name:         "kube-runner-1",
-							Status:       garmProviderParams.InstancePendingDelete,
-							RunnerStatus: params.RunnerTerminated,
-							UpdatedAt:    time.Now(),
-						},
-					},
-					RepoID:         "",
-					RepoName:       "",
-					OrgID:          "",
-					OrgName:        "",
-					EnterpriseID:   enterpriseID,
-					EnterpriseName: enterpriseName,
-				}}, nil)
-
-				poolClient.GetPool(pools.NewGetPoolParams().WithPoolID(poolID)).Return(&pools.GetPoolOK{Payload: params.Pool{
-					RunnerPrefix: params.RunnerPrefix{
-						Prefix: "",
-					},
-					ID:             poolID,
-					ProviderName:   "kubernetes_external",
-					MaxRunners:     10,
-					MinIdleRunners: 5,
-					Image:          "linux-ubuntu-22.04-arm64",
-					Flavor:         "medium",
-					OSType:         "linux",
-					OSArch:         "arm64",
-					Tags: []params.Tag{
-						{
-							ID:   "b3ea9882-a25c-4eb1-94ba-6c70b9abb6da",
-							Name: "kubernetes",
-						},
-						{
-							ID:   "b3ea9882-a25c-4eb1-94ba-6c70b9abb6db",
-							Name: "linux",
-						},
-						{
-							ID:   "b3ea9882-a25c-4eb1-94ba-6c70b9abb6dc",
-							Name: "arm64",
-						},
-						{
-							ID:   "b3ea9882-a25c-4eb1-94ba-6c70b9abb6dd",
-							Name: "ubuntu",
-						},
-					},
-					Enabled: true,
-					Instances: []params.Instance{
-						{
-							ID:           "b3ea9882-a25c-4eb1-94ba-6c70b9abb6dd",
-							Name:         "kube-runner-5",
-							Status:       garmProviderParams.InstanceRunning,
-							RunnerStatus: params.RunnerIdle,
-							UpdatedAt:    time.Now(),
-						},
-						{
-							ID:           "b3ea9882-a25c-4eb1-94ba-6c70b9abb6de",
-							Name:         "kube-runner-4",
-							Status:       garmProviderParams.InstanceRunning,
-							RunnerStatus: params.RunnerIdle,
-							UpdatedAt:    time.Now().Add(-1 * time.Hour),
-						},
-						{
-							ID:           "b3ea9882-a25c-4eb1-94ba-6c70b9abb6df",
-							Name:         "kube-runner-3",
-							Status:       garmProviderParams.InstanceRunning,
-							RunnerStatus: params.RunnerIdle,
-							UpdatedAt:    time.Now().Add(-1 * time.Hour),
-						},
-						{
-							ID:           "b3ea9882-a25c-4eb1-94ba-6c70b9abb6dg",
-							Name:         "kube-runner-2",
-							Status:       garmProviderParams.InstanceRunning,
-							RunnerStatus: params.RunnerIdle,
-							UpdatedAt:    time.Now().Add(-1 * time.Hour),
-						},
-						{
-							ID:           "b3ea9882-a25c-4eb1-94ba-6c70b9abb6dh",
-							Name:         "kube-runner-1",
-							Status:       garmProviderParams.InstancePendingDelete,
-							RunnerStatus: params.RunnerTerminated,
-							UpdatedAt:    time.Now(),
-						},
-					},
-					RepoID:         "",
-					RepoName:       "",
-					OrgID:          "",
-					OrgName:        "",
-					EnterpriseID:   enterpriseID,
-					EnterpriseName: enterpriseName,
-				}}, nil)
-
-				maxRunners := uint(10)
-				minIdleRunners := uint(2)
-				enabled := true
-				runnerBootstrapTimeout := uint(20)
-				extraSpecs := json.RawMessage([]byte{})
-				gitHubRunnerGroup := ""
-				poolClient.UpdatePool(pools.NewUpdatePoolParams().WithPoolID(poolID).WithBody(params.UpdatePoolParams{
-					RunnerPrefix: params.RunnerPrefix{
-						Prefix: "",
-					},
-					MaxRunners:             &maxRunners,
-					MinIdleRunners:         &minIdleRunners,
-					Image:                  "linux-ubuntu-22.04-arm64",
-					Flavor:                 "medium",
-					OSType:                 "linux",
-					OSArch:                 "arm64",
-					Tags:                   []string{"kubernetes", "linux", "arm64", "ubuntu"},
-					Enabled:                &enabled,
-					RunnerBootstrapTimeout: &runnerBootstrapTimeout,
-					ExtraSpecs:             extraSpecs,
-					GitHubRunnerGroup:      &gitHubRunnerGroup,
-				})).Return(&pools.UpdatePoolOK{Payload: params.Pool{
-					RunnerPrefix: params.RunnerPrefix{
-						Prefix: "",
-					},
-					ID:             poolID,
-					ProviderName:   "kubernetes_external",
-					MaxRunners:     10,
-					MinIdleRunners: 2,
-					Image:          "linux-ubuntu-22.04-arm64",
-					Flavor:         "medium",
-					OSType:         "linux",
-					OSArch:         "arm64",
-					Tags: []params.Tag{
-						{
-							ID:   "b3ea9882-a25c-4eb1-94ba-6c70b9abb6da",
-							Name: "kubernetes",
-						},
-						{
-							ID:   "b3ea9882-a25c-4eb1-94ba-6c70b9abb6db",
-							Name: "linux",
-						},
-						{
-							ID:   "b3ea9882-a25c-4eb1-94ba-6c70b9abb6dc",
-							Name: "arm64",
-						},
-						{
-							ID:   "b3ea9882-a25c-4eb1-94ba-6c70b9abb6dd",
-							Name: "ubuntu",
-						},
-					},
-					Enabled: true,
-					Instances: []params.Instance{
-						{
-							ID:           "b3ea9882-a25c-4eb1-94ba-6c70b9abb6dd",
-							Name:         "kube-runner-5",
-							Status:       garmProviderParams.InstanceRunning,
-							RunnerStatus: params.RunnerIdle,
-							UpdatedAt:    time.Now(),
-						},
-						{
-							ID:           "b3ea9882-a25c-4eb1-94ba-6c70b9abb6de",
-							Name:         "kube-runner-4",
-							Status:       garmProviderParams.InstanceRunning,
-							RunnerStatus: params.RunnerIdle,
-							UpdatedAt:    time.Now().Add(-1 * time.Hour),
-						},
-						{
-							ID:           "b3ea9882-a25c-4eb1-94ba-6c70b9abb6df",
-							Name:         "kube-runner-3",
-							Status:       garmProviderParams.InstanceRunning,
-							RunnerStatus: params.RunnerIdle,
-							UpdatedAt:    time.Now().Add(-1 * time.Hour),
-						},
-						{
-							ID:           "b3ea9882-a25c-4eb1-94ba-6c70b9abb6dg",
-							Name:         "kube-runner-2",
-							Status:       garmProviderParams.InstanceRunning,
-							RunnerStatus: params.RunnerIdle,
-							UpdatedAt:    time.Now().Add(-1 * time.Hour),
-						},
-						{
-							ID:           "b3ea9882-a25c-4eb1-94ba-6c70b9abb6dh",
-							Name:         "kube-runner-1",
-							Status:       garmProviderParams.InstancePendingDelete,
-							RunnerStatus: params.RunnerTerminated,
-							UpdatedAt:    time.Now(),
-						},
-					},
-					RepoID:         "",
-					RepoName:       "",
-					OrgID:          "",
-					OrgName:        "",
-					EnterpriseID:   enterpriseID,
-					EnterpriseName: enterpriseName,
-				}}, nil)
-
-				instanceClient.DeleteInstance(instances.NewDeleteInstanceParams().WithInstanceName("kube-runner-4")).Return(nil)
-			},
-		},
-		{
-			name: "pool does not exist in garm - error no image cr found",
-			object: &garmoperatorv1beta1.Pool{
-				TypeMeta: metav1.TypeMeta{
-					Kind:       "Pool",
-					APIVersion: garmoperatorv1beta1.GroupVersion.Group + "/" + garmoperatorv1beta1.GroupVersion.Version,
-				},
-				ObjectMeta: metav1.ObjectMeta{
-					Name:      "my-enterprise-pool",
-					Namespace: namespaceName,
 					Finalizers: []string{
 						key.PoolFinalizerName,
 					},
@@ -1047,20 +714,20 @@ func TestPoolController_ReconcileCreate(t *testing.T) {
 				Status: garmoperatorv1beta1.PoolStatus{
 					ID:                     "",
 					LongRunningIdleRunners: 0,
-					Selector:               "",
+					Selector:               "garm-operator.mercedes-benz.com/pool=my-enterprise-pool",
 					Conditions: []metav1.Condition{
 						{
 							Type:               string(conditions.ReadyCondition),
-							Reason:             string(conditions.FetchingImageRefFailedReason),
 							Status:             metav1.ConditionFalse,
-							Message:            "images.garm-operator.mercedes-benz.com \"ubuntu-image\" not found",
 							LastTransitionTime: metav1.NewTime(time.Now()),
+							Reason:             string(conditions.DuplicatePoolReason),
+							Message:            "duplicate garm pool: 2 garm pools matching scope, image, flavor and provider found",
 						},
 						{
 							Type:               string(conditions.ImageReference),
-							Reason:             string(conditions.FetchingImageRefFailedReason),
-							Status:             metav1.ConditionFalse,
-							Message:            "images.garm-operator.mercedes-benz.com \"ubuntu-image\" not found",
+							Status:             metav1.ConditionTrue,
+							Message:            "Successfully fetched Image CR Ref",
+							Reason:             string(conditions.FetchingImageRefSuccessReason),
 							LastTransitionTime: metav1.NewTime(time.Now()),
 						},
 						{
@@ -1083,6 +750,15 @@ func TestPoolController_ReconcileCreate(t *testing.T) {
 						"webhookSecret": []byte("supersecretvalue"),
 					},
 				},
+				&garmoperatorv1beta1.Image{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "ubuntu-image",
+						Namespace: namespaceName,
+					},
+					Spec: garmoperatorv1beta1.ImageSpec{
+						Tag: "linux-ubuntu-22.04-arm64",
+					},
+				},
 				&garmoperatorv1beta1.Enterprise{
 					TypeMeta: metav1.TypeMeta{
 						Kind:       "Enterprise",
@@ -1124,12 +800,41 @@ func TestPoolController_ReconcileCreate(t *testing.T) {
 					},
 				},
 			},
-			wantErr: true,
-			expectGarmRequest: func(_ *mock.MockPoolClientMockRecorder, _ *mock.MockInstanceClientMockRecorder) {
+			expectGarmRequest: func(poolClient *mock.MockPoolClientMockRecorder, _ *mock.MockInstanceClientMockRecorder) {
+				poolClient.ListAllPools(pools.NewListPoolsParams()).Return(&pools.ListPoolsOK{
+					Payload: params.Pools{
+						params.Pool{
+							ID:             "9b2a1c3e-34b8-4a4e-b9f5-4c1d5fa0e6a1",
+							ProviderName:   "kubernetes_external",
+							MaxRunners:     5,
+							MinIdleRunners: 3,
+							Image:          "linux-ubuntu-22.04-arm64",
+							Flavor:         "medium",
+							OSType:         "linux",
+							OSArch:         "arm64",
+							Enabled:        true,
+							EnterpriseID:   enterpriseID,
+							EnterpriseName: enterpriseName,
+						},
+						params.Pool{
+							ID:             poolID,
+							ProviderName:   "kubernetes_external",
+							MaxRunners:     5,
+							MinIdleRunners: 3,
+							Image:          "linux-ubuntu-22.04-arm64",
+							Flavor:         "medium",
+							OSType:         "linux",
+							OSArch:         "arm64",
+							Enabled:        true,
+							EnterpriseID:   enterpriseID,
+							EnterpriseName: enterpriseName,
+						},
+					},
+				}, nil)
 			},
 		},
 		{
-			name: "pool.Status has matching id in garm database, pool.Specs changed to not existent image cr ref - error no image cr found",
+			name: "pool.Status has matching id in garm database, pool.Specs changed - update pool in garm",
 			object: &garmoperatorv1beta1.Pool{
 				TypeMeta: metav1.TypeMeta{
 					Kind:       "Pool",
@@ -1151,7 +856,7 @@ func TestPoolController_ReconcileCreate(t *testing.T) {
 					ProviderName:           "kubernetes_external",
 					MaxRunners:             1,
 					MinIdleRunners:         0,
-					ImageName:              "ubuntu-image-not-existent",
+					ImageName:              "ubuntu-image",
 					Flavor:                 "medium",
 					OSType:                 "linux",
 					OSArch:                 "arm64",
@@ -1186,7 +891,7 @@ func TestPoolController_ReconcileCreate(t *testing.T) {
 					ProviderName:           "kubernetes_external",
 					MaxRunners:             1,
 					MinIdleRunners:         0,
-					ImageName:              "ubuntu-image-not-existent",
+					ImageName:              "ubuntu-image",
 					Flavor:                 "medium",
 					OSType:                 "linux",
 					OSArch:                 "arm64",
@@ -1197,20 +902,23 @@ func TestPoolController_ReconcileCreate(t *testing.T) {
 					GitHubRunnerGroup:      "",
 				},
 				Status: garmoperatorv1beta1.PoolStatus{
-					ID: poolID,
+					ID:                poolID,
+					MaxRunners:        1,
+					LastDriftedFields: []string{"max_runners", "min_idle_runners", "tags", "runner_bootstrap_timeout"},
+					Selector:          "garm-operator.mercedes-benz.com/pool=my-enterprise-pool",
 					Conditions: []metav1.Condition{
 						{
 							Type:               string(conditions.ReadyCondition),
-							Reason:             string(conditions.FetchingImageRefFailedReason),
-							Status:             metav1.ConditionFalse,
-							Message:            "images.garm-operator.mercedes-benz.com \"ubuntu-image-not-existent\" not found",
+							Status:             metav1.ConditionTrue,
 							LastTransitionTime: metav1.NewTime(time.Now()),
+							Reason:             string(conditions.SuccessfulReconcileReason),
+							Message:            "",
 						},
 						{
 							Type:               string(conditions.ImageReference),
-							Reason:             string(conditions.FetchingImageRefFailedReason),
-							Status:             metav1.ConditionFalse,
-							Message:            "images.garm-operator.mercedes-benz.com \"ubuntu-image-not-existent\" not found",
+							Status:             metav1.ConditionTrue,
+							Message:            "Successfully fetched Image CR Ref",
+							Reason:             string(conditions.FetchingImageRefSuccessReason),
 							LastTransitionTime: metav1.NewTime(time.Now()),
 						},
 						{
@@ -1283,7 +991,6 @@ func TestPoolController_ReconcileCreate(t *testing.T) {
 					},
 				},
 			},
-			wantErr: true,
 			expectGarmRequest: func(poolClient *mock.MockPoolClientMockRecorder, _ *mock.MockInstanceClientMockRecorder) {
 				poolClient.GetPool(pools.NewGetPoolParams().WithPoolID(poolID)).Return(&pools.GetPoolOK{Payload: params.Pool{
 					RunnerPrefix: params.RunnerPrefix{
@@ -1324,95 +1031,120 @@ func TestPoolController_ReconcileCreate(t *testing.T) {
 					EnterpriseID:   enterpriseID,
 					EnterpriseName: enterpriseName,
 				}}, nil)
-			},
-		},
-	}
 
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			schemeBuilder := runtime.SchemeBuilder{
-				garmoperatorv1beta1.AddToScheme,
-			}
-
-			err := schemeBuilder.AddToScheme(scheme.Scheme)
-			if err != nil {
-				t.Fatal(err)
-			}
-			runtimeObjects := []runtime.Object{tt.object}
-			runtimeObjects = append(runtimeObjects, tt.runtimeObjects...)
-			client := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithRuntimeObjects(runtimeObjects...).WithStatusSubresource(&garmoperatorv1beta1.Pool{}).Build()
-
-			// create a fake reconciler
-			reconciler := &PoolReconciler{
-				Client:   client,
-				Recorder: record.NewFakeRecorder(3),
-			}
-
-			pool := tt.object.DeepCopyObject().(*garmoperatorv1beta1.Pool)
-
-			mockPoolClient := mock.NewMockPoolClient(mockCtrl)
-			mockInstanceClient := mock.NewMockInstanceClient(mockCtrl)
-
-			// as the global configuration got initialized in the main function
-			// and the defaulting is done in there as well
-			// we have to explicitly set the values in here
-			config.Config.Operator.MinIdleRunnersAge = time.Duration(30) * time.Minute
-
-			tt.expectGarmRequest(mockPoolClient.EXPECT(), mockInstanceClient.EXPECT())
-
-			_, err = reconciler.reconcileNormal(context.Background(), mockPoolClient, pool, mockInstanceClient)
-			if (err != nil) != tt.wantErr {
-				t.Errorf("PoolReconciler.reconcileNormal() error = %v, wantErr %v", err, tt.wantErr)
-				return
-			}
-
-			// clear out annotations to avoid comparison errors
-			pool.Annotations = nil
-
-			// empty resource version to avoid comparison errors
-			pool.ResourceVersion = ""
-
-			// clear conditions lastTransitionTime to avoid comparison errors
-			conditions.NilLastTransitionTime(tt.expectedObject)
-			conditions.NilLastTransitionTime(pool)
-
-			if !reflect.DeepEqual(pool, tt.expectedObject) {
-				t.Errorf("PoolReconciler.reconcileNormal() \n got =  %#v \n want = %#v", pool, tt.expectedObject)
-			}
-		})
-	}
-}
-
-func TestPoolController_ReconcileDelete(t *testing.T) {
-	mockCtrl := gomock.NewController(t)
-	defer mockCtrl.Finish()
-
-	poolID := "fb2bceeb-f74d-435d-9648-626c75cb23ce"
-	enterpriseID := "93068607-2d0d-4b76-a950-0e40d31955b8"
-	enterpriseName := "test-enterprise"
+				poolClient.GetPool(pools.NewGetPoolParams().WithPoolID(poolID)).Return(&pools.GetPoolOK{Payload: params.Pool{
+					RunnerPrefix: params.RunnerPrefix{
+						Prefix: "",
+					},
+					ID:             poolID,
+					ProviderName:   "kubernetes_external",
+					MaxRunners:     5,
+					MinIdleRunners: 3,
+					Image:          "linux-ubuntu-22.04-arm64",
+					Flavor:         "medium",
+					OSType:         "linux",
+					OSArch:         "arm64",
+					Tags: []params.Tag{
+						{
+							ID:   "b3ea9882-a25c-4eb1-94ba-6c70b9abb6da",
+							Name: "kubernetes",
+						},
+						{
+							ID:   "b3ea9882-a25c-4eb1-94ba-6c70b9abb6db",
+							Name: "linux",
+						},
+						{
+							ID:   "b3ea9882-a25c-4eb1-94ba-6c70b9abb6dc",
+							Name: "arm64",
+						},
+						{
+							ID:   "b3ea9882-a25c-4eb1-94ba-6c70b9abb6dd",
+							Name: "ubuntu",
+						},
+					},
+					Enabled:        true,
+					Instances:      []params.Instance{},
+					RepoID:         "",
+					RepoName:       "",
+					OrgID:          "",
+					OrgName:        "",
+					EnterpriseID:   enterpriseID,
+					EnterpriseName: enterpriseName,
+				}}, nil)
 
-	tests := []struct {
-		name string
-		// the object to reconcile
-		object client.Object
-		// a list of objects to initialize the fake client with
-		// this can be used to define other existing objects that are referenced by the object to reconcile
-		// e.g. images or other pools ..
-		runtimeObjects    []runtime.Object
-		expectGarmRequest func(_ *mock.MockPoolClientMockRecorder, _ *mock.MockInstanceClientMockRecorder)
-		wantErr           bool
-		expectedObject    *garmoperatorv1beta1.Pool
-	}{
+				maxRunners := uint(1)
+				minIdleRunners := uint(0)
+				enabled := true
+				runnerBootstrapTimeout := uint(20)
+				extraSpecs := json.RawMessage([]byte{})
+				gitHubRunnerGroup := ""
+				poolClient.UpdatePool(pools.NewUpdatePoolParams().WithPoolID(poolID).WithBody(params.UpdatePoolParams{
+					RunnerPrefix: params.RunnerPrefix{
+						Prefix: "",
+					},
+					MaxRunners:             &maxRunners,
+					MinIdleRunners:         &minIdleRunners,
+					Image:                  "linux-ubuntu-22.04-arm64",
+					Flavor:                 "medium",
+					OSType:                 "linux",
+					OSArch:                 "arm64",
+					Tags:                   []string{"kubernetes", "linux", "arm64", "ubuntu"},
+					Enabled:                &enabled,
+					RunnerBootstrapTimeout: &runnerBootstrapTimeout,
+					ExtraSpecs:             extraSpecs,
+					GitHubRunnerGroup:      &gitHubRunnerGroup,
+				})).Return(&pools.UpdatePoolOK{Payload: params.Pool{
+					RunnerPrefix: params.RunnerPrefix{
+						Prefix: "",
+					},
+					ID:             poolID,
+					ProviderName:   "kubernetes_external",
+					MaxRunners:     1,
+					MinIdleRunners: 0,
+					Image:          "linux-ubuntu-22.04-arm64",
+					Flavor:         "medium",
+					OSType:         "linux",
+					OSArch:         "arm64",
+					Tags: []params.Tag{
+						{
+							ID:   "b3ea9882-a25c-4eb1-94ba-6c70b9abb6da",
+							Name: "kubernetes",
+						},
+						{
+							ID:   "b3ea9882-a25c-4eb1-94ba-6c70b9abb6db",
+							Name: "linux",
+						},
+						{
+							ID:   "b3ea9882-a25c-4eb1-94ba-6c70b9abb6dc",
+							Name: "arm64",
+						},
+						{
+							ID:   "b3ea9882-a25c-4eb1-94ba-6c70b9abb6dd",
+							Name: "ubuntu",
+						},
+					},
+					Enabled:        true,
+					Instances:      []params.Instance{},
+					RepoID:         "",
+					RepoName:       "",
+					OrgID:          "",
+					OrgName:        "",
+					EnterpriseID:   enterpriseID,
+					EnterpriseName: enterpriseName,
+				}}, nil)
+			},
+		},
 		{
-			name: "delete pool - scaling down runners",
+			name: "pool.Status has matching id in garm database, pool changed in garm, drift policy ReportOnly - keep pool in garm",
 			object: &garmoperatorv1beta1.Pool{
 				TypeMeta: metav1.TypeMeta{
 					Kind:       "Pool",
 					APIVersion: garmoperatorv1beta1.GroupVersion.Group + "/" + garmoperatorv1beta1.GroupVersion.Version,
 				},
 				ObjectMeta: metav1.ObjectMeta{
-					Name:      "my-enterprise-pool",
-					Namespace: namespaceName,
+					Name:       "my-enterprise-pool",
+					Namespace:  namespaceName,
+					Generation: 1,
 					Finalizers: []string{
 						key.PoolFinalizerName,
 					},
@@ -1424,8 +1156,8 @@ func TestPoolController_ReconcileDelete(t *testing.T) {
 						Name:     enterpriseName,
 					},
 					ProviderName:           "kubernetes_external",
-					MaxRunners:             5,
-					MinIdleRunners:         3,
+					MaxRunners:             1,
+					MinIdleRunners:         0,
 					ImageName:              "ubuntu-image",
 					Flavor:                 "medium",
 					OSType:                 "linux",
@@ -1435,9 +1167,11 @@ func TestPoolController_ReconcileDelete(t *testing.T) {
 					RunnerBootstrapTimeout: 20,
 					ExtraSpecs:             "",
 					GitHubRunnerGroup:      "",
+					DriftPolicy:            garmoperatorv1beta1.DriftPolicyReportOnly,
 				},
 				Status: garmoperatorv1beta1.PoolStatus{
-					ID: poolID,
+					ID:                 poolID,
+					ObservedGeneration: 1,
 				},
 			},
 			expectedObject: &garmoperatorv1beta1.Pool{
@@ -1446,38 +1180,59 @@ func TestPoolController_ReconcileDelete(t *testing.T) {
 					APIVersion: garmoperatorv1beta1.GroupVersion.Group + "/" + garmoperatorv1beta1.GroupVersion.Version,
 				},
 				ObjectMeta: metav1.ObjectMeta{
-					Name:      "my-enterprise-pool",
-					Namespace: namespaceName,
+					Name:       "my-enterprise-pool",
+					Namespace:  namespaceName,
+					Generation: 1,
+					Finalizers: []string{
+						key.PoolFinalizerName,
+					},
 				},
 				Spec: garmoperatorv1beta1.PoolSpec{
 					GitHubScopeRef: corev1.TypedLocalObjectReference{
-						// APIGroup: &garmoperatorv1beta1.GroupVersion.Group,
-						Kind: string(garmoperatorv1beta1.EnterpriseScope),
-						Name: enterpriseName,
+						APIGroup: &garmoperatorv1beta1.GroupVersion.Group,
+						Kind:     string(garmoperatorv1beta1.EnterpriseScope),
+						Name:     enterpriseName,
 					},
 					ProviderName:           "kubernetes_external",
-					MaxRunners:             5,
+					MaxRunners:             1,
 					MinIdleRunners:         0,
 					ImageName:              "ubuntu-image",
 					Flavor:                 "medium",
 					OSType:                 "linux",
 					OSArch:                 "arm64",
 					Tags:                   []string{"kubernetes", "linux", "arm64", "ubuntu"},
-					Enabled:                false,
+					Enabled:                true,
 					RunnerBootstrapTimeout: 20,
 					ExtraSpecs:             "",
 					GitHubRunnerGroup:      "",
+					DriftPolicy:            garmoperatorv1beta1.DriftPolicyReportOnly,
 				},
 				Status: garmoperatorv1beta1.PoolStatus{
-					ID:                     poolID,
-					LongRunningIdleRunners: 0,
+					ID:                 poolID,
+					ObservedGeneration: 1,
+					LastDriftedFields:  []string{"max_runners", "min_idle_runners", "tags", "runner_bootstrap_timeout"},
+					Selector:           "garm-operator.mercedes-benz.com/pool=my-enterprise-pool",
 					Conditions: []metav1.Condition{
 						{
 							Type:               string(conditions.ReadyCondition),
-							Status:             metav1.ConditionFalse,
+							Status:             metav1.ConditionTrue,
+							LastTransitionTime: metav1.NewTime(time.Now()),
+							Reason:             string(conditions.SuccessfulReconcileReason),
+							Message:            "",
+						},
+						{
+							Type:               string(conditions.ImageReference),
+							Status:             metav1.ConditionTrue,
+							Message:            "Successfully fetched Image CR Ref",
+							Reason:             string(conditions.FetchingImageRefSuccessReason),
+							LastTransitionTime: metav1.NewTime(time.Now()),
+						},
+						{
+							Type:               string(conditions.ScopeReference),
+							Status:             metav1.ConditionTrue,
+							Message:            "Successfully fetched Enterprise CR Ref",
+							Reason:             string(conditions.FetchingScopeRefSuccessReason),
 							LastTransitionTime: metav1.NewTime(time.Now()),
-							Reason:             string(conditions.DeletingReason),
-							Message:            conditions.DeletingPoolMsg,
 						},
 					},
 				},
@@ -1542,85 +1297,90 @@ func TestPoolController_ReconcileDelete(t *testing.T) {
 					},
 				},
 			},
-			expectGarmRequest: func(m *mock.MockPoolClientMockRecorder, instanceClient *mock.MockInstanceClientMockRecorder) {
-				maxRunners := uint(5)
-				minIdleRunners := uint(0)
-				enabled := false
-				runnerBootstrapTimeout := uint(20)
-				extraSpecs := json.RawMessage([]byte{})
-				gitHubRunnerGroup := ""
-
-				instanceClient.ListPoolInstances(
-					instances.NewListPoolInstancesParams().
-						WithPoolID(poolID)).
-					Return(&instances.ListPoolInstancesOK{
-						Payload: params.Instances{},
+			expectGarmRequest: func(poolClient *mock.MockPoolClientMockRecorder, _ *mock.MockInstanceClientMockRecorder) {
+				poolClient.GetPool(pools.NewGetPoolParams().WithPoolID(poolID)).Return(&pools.GetPoolOK{Payload: params.Pool{
+					RunnerPrefix: params.RunnerPrefix{
+						Prefix: "",
 					},
-						nil)
-
-				m.UpdatePool(pools.NewUpdatePoolParams().
-					WithPoolID(poolID).
-					WithBody(params.UpdatePoolParams{
-						RunnerPrefix: params.RunnerPrefix{
-							Prefix: "",
+					ID:             poolID,
+					ProviderName:   "kubernetes_external",
+					MaxRunners:     5,
+					MinIdleRunners: 3,
+					Image:          "linux-ubuntu-22.04-arm64",
+					Flavor:         "medium",
+					OSType:         "linux",
+					OSArch:         "arm64",
+					Tags: []params.Tag{
+						{
+							ID:   "b3ea9882-a25c-4eb1-94ba-6c70b9abb6da",
+							Name: "kubernetes",
 						},
-						MaxRunners:             &maxRunners,
-						MinIdleRunners:         &minIdleRunners,
-						Flavor:                 "medium",
-						OSType:                 "linux",
-						OSArch:                 "arm64",
-						Tags:                   []string{"kubernetes", "linux", "arm64", "ubuntu"},
-						Enabled:                &enabled,
-						RunnerBootstrapTimeout: &runnerBootstrapTimeout,
-						ExtraSpecs:             extraSpecs,
-						GitHubRunnerGroup:      &gitHubRunnerGroup,
-					})).Return(&pools.UpdatePoolOK{
-					Payload: params.Pool{
-						RunnerPrefix: params.RunnerPrefix{
-							Prefix: "",
+						{
+							ID:   "b3ea9882-a25c-4eb1-94ba-6c70b9abb6db",
+							Name: "linux",
 						},
-						ID:             poolID,
-						ProviderName:   "kubernetes_external",
-						MaxRunners:     5,
-						MinIdleRunners: 0,
-						Image:          "linux-ubuntu-22.04-arm64",
-						Flavor:         "medium",
-						OSType:         "linux",
-						OSArch:         "arm64",
-						Tags: []params.Tag{
-							{
-								ID:   "b3ea9882-a25c-4eb1-94ba-6c70b9abb6da",
-								Name: "kubernetes",
-							},
-							{
-								ID:   "b3ea9882-a25c-4eb1-94ba-6c70b9abb6db",
-								Name: "linux",
-							},
-							{
-								ID:   "b3ea9882-a25c-4eb1-94ba-6c70b9abb6dc",
-								Name: "arm64",
-							},
-							{
-								ID:   "b3ea9882-a25c-4eb1-94ba-6c70b9abb6dd",
-								Name: "ubuntu",
-							},
+						{
+							ID:   "b3ea9882-a25c-4eb1-94ba-6c70b9abb6dc",
+							Name: "arm64",
+						},
+						{
+							ID:   "b3ea9882-a25c-4eb1-94ba-6c70b9abb6dd",
+							Name: "ubuntu",
 						},
-						Enabled:        false,
-						Instances:      []params.Instance{},
-						RepoID:         "",
-						RepoName:       "",
-						OrgID:          "",
-						OrgName:        "",
-						EnterpriseID:   enterpriseID,
-						EnterpriseName: enterpriseName,
 					},
-				}, nil)
+					Enabled:        true,
+					Instances:      []params.Instance{},
+					RepoID:         "",
+					RepoName:       "",
+					OrgID:          "",
+					OrgName:        "",
+					EnterpriseID:   enterpriseID,
+					EnterpriseName: enterpriseName,
+				}}, nil)
 
-				m.DeletePool(pools.NewDeletePoolParams().WithPoolID(poolID)).Return(nil)
+				poolClient.GetPool(pools.NewGetPoolParams().WithPoolID(poolID)).Return(&pools.GetPoolOK{Payload: params.Pool{
+					RunnerPrefix: params.RunnerPrefix{
+						Prefix: "",
+					},
+					ID:             poolID,
+					ProviderName:   "kubernetes_external",
+					MaxRunners:     5,
+					MinIdleRunners: 3,
+					Image:          "linux-ubuntu-22.04-arm64",
+					Flavor:         "medium",
+					OSType:         "linux",
+					OSArch:         "arm64",
+					Tags: []params.Tag{
+						{
+							ID:   "b3ea9882-a25c-4eb1-94ba-6c70b9abb6da",
+							Name: "kubernetes",
+						},
+						{
+							ID:   "b3ea9882-a25c-4eb1-94ba-6c70b9abb6db",
+							Name: "linux",
+						},
+						{
+							ID:   "b3ea9882-a25c-4eb1-94ba-6c70b9abb6dc",
+							Name: "arm64",
+						},
+						{
+							ID:   "b3ea9882-a25c-4eb1-94ba-6c70b9abb6dd",
+							Name: "ubuntu",
+						},
+					},
+					Enabled:        true,
+					Instances:      []params.Instance{},
+					RepoID:         "",
+					RepoName:       "",
+					OrgID:          "",
+					OrgName:        "",
+					EnterpriseID:   enterpriseID,
+					EnterpriseName: enterpriseName,
+				}}, nil)
 			},
 		},
 		{
-			name: "delete pool - deleting garm resource",
+			name: "scaling idleRunners down to 2 - expect deletion of two old instances",
 			object: &garmoperatorv1beta1.Pool{
 				TypeMeta: metav1.TypeMeta{
 					Kind:       "Pool",
@@ -1640,8 +1400,8 @@ func TestPoolController_ReconcileDelete(t *testing.T) {
 						Name:     enterpriseName,
 					},
 					ProviderName:           "kubernetes_external",
-					MaxRunners:             5,
-					MinIdleRunners:         0,
+					MaxRunners:             10,
+					MinIdleRunners:         2,
 					ImageName:              "ubuntu-image",
 					Flavor:                 "medium",
 					OSType:                 "linux",
@@ -1654,7 +1414,7 @@ func TestPoolController_ReconcileDelete(t *testing.T) {
 				},
 				Status: garmoperatorv1beta1.PoolStatus{
 					ID:                     poolID,
-					LongRunningIdleRunners: 0,
+					LongRunningIdleRunners: 3,
 				},
 			},
 			expectedObject: &garmoperatorv1beta1.Pool{
@@ -1665,35 +1425,60 @@ func TestPoolController_ReconcileDelete(t *testing.T) {
 				ObjectMeta: metav1.ObjectMeta{
 					Name:      "my-enterprise-pool",
 					Namespace: namespaceName,
+					Finalizers: []string{
+						key.PoolFinalizerName,
+					},
 				},
 				Spec: garmoperatorv1beta1.PoolSpec{
 					GitHubScopeRef: corev1.TypedLocalObjectReference{
-						// APIGroup: &garmoperatorv1beta1.GroupVersion.Group,
-						Kind: string(garmoperatorv1beta1.EnterpriseScope),
-						Name: enterpriseName,
+						APIGroup: &garmoperatorv1beta1.GroupVersion.Group,
+						Kind:     string(garmoperatorv1beta1.EnterpriseScope),
+						Name:     enterpriseName,
 					},
 					ProviderName:           "kubernetes_external",
-					MaxRunners:             5,
-					MinIdleRunners:         0,
+					MaxRunners:             10,
+					MinIdleRunners:         2,
 					ImageName:              "ubuntu-image",
 					Flavor:                 "medium",
 					OSType:                 "linux",
 					OSArch:                 "arm64",
 					Tags:                   []string{"kubernetes", "linux", "arm64", "ubuntu"},
-					Enabled:                false,
+					Enabled:                true,
 					RunnerBootstrapTimeout: 20,
 					ExtraSpecs:             "",
 					GitHubRunnerGroup:      "",
 				},
 				Status: garmoperatorv1beta1.PoolStatus{
-					ID: poolID,
+					ID:                     poolID,
+					LongRunningIdleRunners: 2,
+					IdleRunners:            3,
+					TotalRunners:           4,
+					MaxRunners:             10,
+					LastScaleDownTime:      &metav1.Time{},
+					LastScaleDownReason:    "scale long running idle runners down to 2",
+					LastDriftedFields:      []string{"min_idle_runners", "tags", "runner_bootstrap_timeout"},
+					Selector:               "garm-operator.mercedes-benz.com/pool=my-enterprise-pool",
 					Conditions: []metav1.Condition{
 						{
 							Type:               string(conditions.ReadyCondition),
-							Status:             metav1.ConditionFalse,
+							Status:             metav1.ConditionTrue,
+							LastTransitionTime: metav1.NewTime(time.Now()),
+							Reason:             string(conditions.SuccessfulReconcileReason),
+							Message:            "",
+						},
+						{
+							Type:               string(conditions.ImageReference),
+							Status:             metav1.ConditionTrue,
+							Message:            "Successfully fetched Image CR Ref",
+							Reason:             string(conditions.FetchingImageRefSuccessReason),
+							LastTransitionTime: metav1.NewTime(time.Now()),
+						},
+						{
+							Type:               string(conditions.ScopeReference),
+							Status:             metav1.ConditionTrue,
+							Message:            "Successfully fetched Enterprise CR Ref",
+							Reason:             string(conditions.FetchingScopeRefSuccessReason),
 							LastTransitionTime: metav1.NewTime(time.Now()),
-							Reason:             string(conditions.DeletingReason),
-							Message:            conditions.DeletingPoolMsg,
 						},
 					},
 				},
@@ -1759,31 +1544,14 @@ func TestPoolController_ReconcileDelete(t *testing.T) {
 				},
 			},
 			expectGarmRequest: func(poolClient *mock.MockPoolClientMockRecorder, instanceClient *mock.MockInstanceClientMockRecorder) {
-				instanceClient.ListPoolInstances(instances.NewListPoolInstancesParams().WithPoolID(poolID)).Return(&instances.ListPoolInstancesOK{Payload: params.Instances{}}, nil)
-				poolClient.DeletePool(pools.NewDeletePoolParams().WithPoolID(poolID)).Return(nil)
-
-				poolClient.UpdatePool(pools.NewUpdatePoolParams().WithPoolID(poolID).WithBody(params.UpdatePoolParams{
-					RunnerPrefix: params.RunnerPrefix{
-						Prefix: "",
-					},
-					MaxRunners:             ptr.To(uint(5)),
-					MinIdleRunners:         ptr.To(uint(0)),
-					Flavor:                 "medium",
-					OSType:                 "linux",
-					OSArch:                 "arm64",
-					Tags:                   []string{"kubernetes", "linux", "arm64", "ubuntu"},
-					Enabled:                ptr.To(false),
-					RunnerBootstrapTimeout: ptr.To(uint(20)),
-					ExtraSpecs:             json.RawMessage([]byte{}),
-					GitHubRunnerGroup:      ptr.To(""),
-				})).Return(&pools.UpdatePoolOK{Payload: params.Pool{
+				poolClient.GetPool(pools.NewGetPoolParams().WithPoolID(poolID)).Return(&pools.GetPoolOK{Payload: params.Pool{
 					RunnerPrefix: params.RunnerPrefix{
 						Prefix: "",
 					},
 					ID:             poolID,
 					ProviderName:   "kubernetes_external",
-					MaxRunners:     1,
-					MinIdleRunners: 0,
+					MaxRunners:     10,
+					MinIdleRunners: 5,
 					Image:          "linux-ubuntu-22.04-arm64",
 					Flavor:         "medium",
 					OSType:         "linux",
@@ -1806,8 +1574,44 @@ func TestPoolController_ReconcileDelete(t *testing.T) {
 							Name: "ubuntu",
 						},
 					},
-					Enabled:        false,
-					Instances:      []params.Instance{},
+					Enabled: true,
+					Instances: []params.Instance{
+						{
+							ID:           "b3ea9882-a25c-4eb1-94ba-6c70b9abb6dd",
+							Name:         "kube-runner-5",
+							Status:       garmProviderParams.InstanceRunning,
+							RunnerStatus: params.RunnerIdle,
+							UpdatedAt:    time.Now(),
+						},
+						{
+							ID:           "b3ea9882-a25c-4eb1-94ba-6c70b9abb6de",
+							Name:         "kube-runner-4",
+							Status:       garmProviderParams.InstanceRunning,
+							RunnerStatus: params.RunnerIdle,
+							UpdatedAt:    time.Now().Add(-1 * time.Hour),
+						},
+						{
+							ID:           "b3ea9882-a25c-4eb1-94ba-6c70b9abb6df",
+							Name:         "kube-runner-3",
+							Status:       garmProviderParams.InstanceRunning,
+							RunnerStatus: params.RunnerIdle,
+							UpdatedAt:    time.Now().Add(-1 * time.Hour),
+						},
+						{
+							ID:           "b3ea9882-a25c-4eb1-94ba-6c70b9abb6dg",
+							Name:         "kube-runner-2",
+							Status:       garmProviderParams.InstanceRunning,
+							RunnerStatus: params.RunnerIdle,
+							UpdatedAt:    time.Now().Add(-1 * time.Hour),
+						},
+						{
+							ID:           "b3ea9882-a25c-4eb1-94ba-6c70b9abb6dh",
+							Name:         "kube-runner-1",
+							Status:       garmProviderParams.InstancePendingDelete,
+							RunnerStatus: params.RunnerTerminated,
+							UpdatedAt:    time.Now(),
+						},
+					},
 					RepoID:         "",
 					RepoName:       "",
 					OrgID:          "",
@@ -1815,51 +1619,3399 @@ func TestPoolController_ReconcileDelete(t *testing.T) {
 					EnterpriseID:   enterpriseID,
 					EnterpriseName: enterpriseName,
 				}}, nil)
-			},
-		},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			schemeBuilder := runtime.SchemeBuilder{
-				garmoperatorv1beta1.AddToScheme,
-			}
-
-			err := schemeBuilder.AddToScheme(scheme.Scheme)
-			if err != nil {
-				t.Fatal(err)
-			}
-			runtimeObjects := []runtime.Object{tt.object}
-			runtimeObjects = append(runtimeObjects, tt.runtimeObjects...)
-			client := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithRuntimeObjects(runtimeObjects...).WithStatusSubresource(&garmoperatorv1beta1.Pool{}).Build()
-
-			// create a fake reconciler
-			reconciler := &PoolReconciler{
-				Client:   client,
-				Recorder: record.NewFakeRecorder(3),
-			}
-
-			pool := tt.object.DeepCopyObject().(*garmoperatorv1beta1.Pool)
-
-			mockPoolClient := mock.NewMockPoolClient(mockCtrl)
-			mockInstanceClient := mock.NewMockInstanceClient(mockCtrl)
-
-			tt.expectGarmRequest(mockPoolClient.EXPECT(), mockInstanceClient.EXPECT())
-
-			_, err = reconciler.reconcileDelete(context.Background(), mockPoolClient, pool, mockInstanceClient)
-			if (err != nil) != tt.wantErr {
-				t.Errorf("PoolReconciler.reconcileDelete() error = %v, wantErr %v", err, tt.wantErr)
-				return
-			}
-
-			// empty resource version to avoid comparison errors
-			pool.ResourceVersion = ""
-			pool.Spec.GitHubScopeRef.APIGroup = nil
-			conditions.NilLastTransitionTime(pool)
-			conditions.NilLastTransitionTime(tt.expectedObject)
 
-			if !reflect.DeepEqual(pool, tt.expectedObject) {
-				t.Errorf("PoolReconciler.reconcileNormal() \n got =  %#v \n want = %#v", pool, tt.expectedObject)
+				poolClient.GetPool(pools.NewGetPoolParams().WithPoolID(poolID)).Return(&pools.GetPoolOK{Payload: params.Pool{
+					RunnerPrefix: params.RunnerPrefix{
+						Prefix: "",
+					},
+					ID:             poolID,
+					ProviderName:   "kubernetes_external",
+					MaxRunners:     10,
+					MinIdleRunners: 5,
+					Image:          "linux-ubuntu-22.04-arm64",
+					Flavor:         "medium",
+					OSType:         "linux",
+					OSArch:         "arm64",
+					Tags: []params.Tag{
+						{
+							ID:   "b3ea9882-a25c-4eb1-94ba-6c70b9abb6da",
+							Name: "kubernetes",
+						},
+						{
+							ID:   "b3ea9882-a25c-4eb1-94ba-6c70b9abb6db",
+							Name: "linux",
+						},
+						{
+							ID:   "b3ea9882-a25c-4eb1-94ba-6c70b9abb6dc",
+							Name: "arm64",
+						},
+						{
+							ID:   "b3ea9882-a25c-4eb1-94ba-6c70b9abb6dd",
+							Name: "ubuntu",
+						},
+					},
+					Enabled: true,
+					Instances: []params.Instance{
+						{
+							ID:           "b3ea9882-a25c-4eb1-94ba-6c70b9abb6dd",
+							Name:         "kube-runner-5",
+							Status:       garmProviderParams.InstanceRunning,
+							RunnerStatus: params.RunnerIdle,
+							UpdatedAt:    time.Now(),
+						},
+						{
+							ID:           "b3ea9882-a25c-4eb1-94ba-6c70b9abb6de",
+							Name:         "kube-runner-4",
+							Status:       garmProviderParams.InstanceRunning,
+							RunnerStatus: params.RunnerIdle,
+							UpdatedAt:    time.Now().Add(-1 * time.Hour),
+						},
+						{
+							ID:           "b3ea9882-a25c-4eb1-94ba-6c70b9abb6df",
+							Name:         "kube-runner-3",
+							Status:       garmProviderParams.InstanceRunning,
+							RunnerStatus: params.RunnerIdle,
+							UpdatedAt:    time.Now().Add(-1 * time.Hour),
+						},
+						{
+							ID:           "b3ea9882-a25c-4eb1-94ba-6c70b9abb6dg",
+							Name:         "kube-runner-2",
+							Status:       garmProviderParams.InstanceRunning,
+							RunnerStatus: params.RunnerIdle,
+							UpdatedAt:    time.Now().Add(-1 * time.Hour),
+						},
+						{
+							ID:           "b3ea9882-a25c-4eb1-94ba-6c70b9abb6dh",
+							Name:         "kube-runner-1",
+							Status:       garmProviderParams.InstancePendingDelete,
+							RunnerStatus: params.RunnerTerminated,
+							UpdatedAt:    time.Now(),
+						},
+					},
+					RepoID:         "",
+					RepoName:       "",
+					OrgID:          "",
+					OrgName:        "",
+					EnterpriseID:   enterpriseID,
+					EnterpriseName: enterpriseName,
+				}}, nil)
+
+				maxRunners := uint(10)
+				minIdleRunners := uint(2)
+				enabled := true
+				runnerBootstrapTimeout := uint(20)
+				extraSpecs := json.RawMessage([]byte{})
+				gitHubRunnerGroup := ""
+				poolClient.UpdatePool(pools.NewUpdatePoolParams().WithPoolID(poolID).WithBody(params.UpdatePoolParams{
+					RunnerPrefix: params.RunnerPrefix{
+						Prefix: "",
+					},
+					MaxRunners:             &maxRunners,
+					MinIdleRunners:         &minIdleRunners,
+					Image:                  "linux-ubuntu-22.04-arm64",
+					Flavor:                 "medium",
+					OSType:                 "linux",
+					OSArch:                 "arm64",
+					Tags:                   []string{"kubernetes", "linux", "arm64", "ubuntu"},
+					Enabled:                &enabled,
+					RunnerBootstrapTimeout: &runnerBootstrapTimeout,
+					ExtraSpecs:             extraSpecs,
+					GitHubRunnerGroup:      &gitHubRunnerGroup,
+				})).Return(&pools.UpdatePoolOK{Payload: params.Pool{
+					RunnerPrefix: params.RunnerPrefix{
+						Prefix: "",
+					},
+					ID:             poolID,
+					ProviderName:   "kubernetes_external",
+					MaxRunners:     10,
+					MinIdleRunners: 2,
+					Image:          "linux-ubuntu-22.04-arm64",
+					Flavor:         "medium",
+					OSType:         "linux",
+					OSArch:         "arm64",
+					Tags: []params.Tag{
+						{
+							ID:   "b3ea9882-a25c-4eb1-94ba-6c70b9abb6da",
+							Name: "kubernetes",
+						},
+						{
+							ID:   "b3ea9882-a25c-4eb1-94ba-6c70b9abb6db",
+							Name: "linux",
+						},
+						{
+							ID:   "b3ea9882-a25c-4eb1-94ba-6c70b9abb6dc",
+							Name: "arm64",
+						},
+						{
+							ID:   "b3ea9882-a25c-4eb1-94ba-6c70b9abb6dd",
+							Name: "ubuntu",
+						},
+					},
+					Enabled: true,
+					Instances: []params.Instance{
+						{
+							ID:           "b3ea9882-a25c-4eb1-94ba-6c70b9abb6dd",
+							Name:         "kube-runner-5",
+							Status:       garmProviderParams.InstanceRunning,
+							RunnerStatus: params.RunnerIdle,
+							UpdatedAt:    time.Now(),
+						},
+						{
+							ID:           "b3ea9882-a25c-4eb1-94ba-6c70b9abb6de",
+							Name:         "kube-runner-4",
+							Status:       garmProviderParams.InstanceRunning,
+							RunnerStatus: params.RunnerIdle,
+							UpdatedAt:    time.Now().Add(-1 * time.Hour),
+						},
+						{
+							ID:           "b3ea9882-a25c-4eb1-94ba-6c70b9abb6df",
+							Name:         "kube-runner-3",
+							Status:       garmProviderParams.InstanceRunning,
+							RunnerStatus: params.RunnerIdle,
+							UpdatedAt:    time.Now().Add(-1 * time.Hour),
+						},
+						{
+							ID:           "b3ea9882-a25c-4eb1-94ba-6c70b9abb6dg",
+							Name:         "kube-runner-2",
+							Status:       garmProviderParams.InstanceRunning,
+							RunnerStatus: params.RunnerIdle,
+							UpdatedAt:    time.Now().Add(-1 * time.Hour),
+						},
+						{
+							ID:           "b3ea9882-a25c-4eb1-94ba-6c70b9abb6dh",
+							Name:         "kube-runner-1",
+							Status:       garmProviderParams.InstancePendingDelete,
+							RunnerStatus: params.RunnerTerminated,
+							UpdatedAt:    time.Now(),
+						},
+					},
+					RepoID:         "",
+					RepoName:       "",
+					OrgID:          "",
+					OrgName:        "",
+					EnterpriseID:   enterpriseID,
+					EnterpriseName: enterpriseName,
+				}}, nil)
+
+				instanceClient.DeleteInstance(instances.NewDeleteInstanceParams().WithInstanceName("kube-runner-4")).Return(nil)
+			},
+		},
+		{
+			name: "scaling idleRunners down to 2 with protected runner - expect deletion of unprotected old instance",
+			object: &garmoperatorv1beta1.Pool{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       "Pool",
+					APIVersion: garmoperatorv1beta1.GroupVersion.Group + "/" + garmoperatorv1beta1.GroupVersion.Version,
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "my-enterprise-pool",
+					Namespace: namespaceName,
+					Finalizers: []string{
+						key.PoolFinalizerName,
+					},
+				},
+				Spec: garmoperatorv1beta1.PoolSpec{
+					GitHubScopeRef: corev1.TypedLocalObjectReference{
+						APIGroup: &garmoperatorv1beta1.GroupVersion.Group,
+						Kind:     string(garmoperatorv1beta1.EnterpriseScope),
+						Name:     enterpriseName,
+					},
+					ProviderName:           "kubernetes_external",
+					MaxRunners:             10,
+					MinIdleRunners:         2,
+					ImageName:              "ubuntu-image",
+					Flavor:                 "medium",
+					OSType:                 "linux",
+					OSArch:                 "arm64",
+					Tags:                   []string{"kubernetes", "linux", "arm64", "ubuntu"},
+					Enabled:                true,
+					RunnerBootstrapTimeout: 20,
+					ExtraSpecs:             "",
+					GitHubRunnerGroup:      "",
+				},
+				Status: garmoperatorv1beta1.PoolStatus{
+					ID:                     poolID,
+					LongRunningIdleRunners: 3,
+				},
+			},
+			expectedObject: &garmoperatorv1beta1.Pool{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       "Pool",
+					APIVersion: garmoperatorv1beta1.GroupVersion.Group + "/" + garmoperatorv1beta1.GroupVersion.Version,
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "my-enterprise-pool",
+					Namespace: namespaceName,
+					Finalizers: []string{
+						key.PoolFinalizerName,
+					},
+				},
+				Spec: garmoperatorv1beta1.PoolSpec{
+					GitHubScopeRef: corev1.TypedLocalObjectReference{
+						APIGroup: &garmoperatorv1beta1.GroupVersion.Group,
+						Kind:     string(garmoperatorv1beta1.EnterpriseScope),
+						Name:     enterpriseName,
+					},
+					ProviderName:           "kubernetes_external",
+					MaxRunners:             10,
+					MinIdleRunners:         2,
+					ImageName:              "ubuntu-image",
+					Flavor:                 "medium",
+					OSType:                 "linux",
+					OSArch:                 "arm64",
+					Tags:                   []string{"kubernetes", "linux", "arm64", "ubuntu"},
+					Enabled:                true,
+					RunnerBootstrapTimeout: 20,
+					ExtraSpecs:             "",
+					GitHubRunnerGroup:      "",
+				},
+				Status: garmoperatorv1beta1.PoolStatus{
+					ID:                     poolID,
+					LongRunningIdleRunners: 2,
+					IdleRunners:            3,
+					TotalRunners:           4,
+					MaxRunners:             10,
+					LastScaleDownTime:      &metav1.Time{},
+					LastScaleDownReason:    "scale long running idle runners down to 2",
+					LastDriftedFields:      []string{"min_idle_runners", "tags", "runner_bootstrap_timeout"},
+					Selector:               "garm-operator.mercedes-benz.com/pool=my-enterprise-pool",
+					Conditions: []metav1.Condition{
+						{
+							Type:               string(conditions.ReadyCondition),
+							Status:             metav1.ConditionTrue,
+							LastTransitionTime: metav1.NewTime(time.Now()),
+							Reason:             string(conditions.SuccessfulReconcileReason),
+							Message:            "",
+						},
+						{
+							Type:               string(conditions.ImageReference),
+							Status:             metav1.ConditionTrue,
+							Message:            "Successfully fetched Image CR Ref",
+							Reason:             string(conditions.FetchingImageRefSuccessReason),
+							LastTransitionTime: metav1.NewTime(time.Now()),
+						},
+						{
+							Type:               string(conditions.ScopeReference),
+							Status:             metav1.ConditionTrue,
+							Message:            "Successfully fetched Enterprise CR Ref",
+							Reason:             string(conditions.FetchingScopeRefSuccessReason),
+							LastTransitionTime: metav1.NewTime(time.Now()),
+						},
+					},
+				},
+			},
+			runtimeObjects: []runtime.Object{
+				&garmoperatorv1beta1.Runner{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "kube-runner-4",
+						Namespace: namespaceName,
+						Labels: map[string]string{
+							key.PoolLabel: "my-enterprise-pool",
+						},
+						Annotations: map[string]string{
+							key.DoNotReapAnnotation: "true",
+						},
+					},
+				},
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: namespaceName,
+						Name:      "my-webhook-secret",
+					},
+					Data: map[string][]byte{
+						"webhookSecret": []byte("supersecretvalue"),
+					},
+				},
+				&garmoperatorv1beta1.Image{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "ubuntu-image",
+						Namespace: namespaceName,
+					},
+					Spec: garmoperatorv1beta1.ImageSpec{
+						Tag: "linux-ubuntu-22.04-arm64",
+					},
+				},
+				&garmoperatorv1beta1.Enterprise{
+					TypeMeta: metav1.TypeMeta{
+						Kind:       "Enterprise",
+						APIVersion: garmoperatorv1beta1.GroupVersion.Group + "/" + garmoperatorv1beta1.GroupVersion.Version,
+					},
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      enterpriseName,
+						Namespace: namespaceName,
+					},
+					Spec: garmoperatorv1beta1.EnterpriseSpec{
+						CredentialsRef: corev1.TypedLocalObjectReference{
+							APIGroup: &garmoperatorv1beta1.GroupVersion.Group,
+							Kind:     "GitHubCredential",
+							Name:     "github-creds",
+						},
+						WebhookSecretRef: garmoperatorv1beta1.SecretRef{
+							Name: "my-webhook-secret",
+							Key:  "webhookSecret",
+						},
+					},
+					Status: garmoperatorv1beta1.EnterpriseStatus{
+						ID: enterpriseID,
+						Conditions: []metav1.Condition{
+							{
+								Type:               string(conditions.ReadyCondition),
+								Reason:             string(conditions.SuccessfulReconcileReason),
+								Status:             metav1.ConditionTrue,
+								Message:            "",
+								LastTransitionTime: metav1.NewTime(time.Now()),
+							},
+							{
+								Type:               string(conditions.PoolManager),
+								Reason:             string(conditions.PoolManagerFailureReason),
+								Status:             metav1.ConditionFalse,
+								Message:            "no resources available",
+								LastTransitionTime: metav1.NewTime(time.Now()),
+							},
+						},
+					},
+				},
+			},
+			expectGarmRequest: func(poolClient *mock.MockPoolClientMockRecorder, instanceClient *mock.MockInstanceClientMockRecorder) {
+				poolClient.GetPool(pools.NewGetPoolParams().WithPoolID(poolID)).Return(&pools.GetPoolOK{Payload: params.Pool{
+					RunnerPrefix: params.RunnerPrefix{
+						Prefix: "",
+					},
+					ID:             poolID,
+					ProviderName:   "kubernetes_external",
+					MaxRunners:     10,
+					MinIdleRunners: 5,
+					Image:          "linux-ubuntu-22.04-arm64",
+					Flavor:         "medium",
+					OSType:         "linux",
+					OSArch:         "arm64",
+					Tags: []params.Tag{
+						{
+							ID:   "b3ea9882-a25c-4eb1-94ba-6c70b9abb6da",
+							Name: "kubernetes",
+						},
+						{
+							ID:   "b3ea9882-a25c-4eb1-94ba-6c70b9abb6db",
+							Name: "linux",
+						},
+						{
+							ID:   "b3ea9882-a25c-4eb1-94ba-6c70b9abb6dc",
+							Name: "arm64",
+						},
+						{
+							ID:   "b3ea9882-a25c-4eb1-94ba-6c70b9abb6dd",
+							Name: "ubuntu",
+						},
+					},
+					Enabled: true,
+					Instances: []params.Instance{
+						{
+							ID:           "b3ea9882-a25c-4eb1-94ba-6c70b9abb6dd",
+							Name:         "kube-runner-5",
+							Status:       garmProviderParams.InstanceRunning,
+							RunnerStatus: params.RunnerIdle,
+							UpdatedAt:    time.Now(),
+						},
+						{
+							ID:           "b3ea9882-a25c-4eb1-94ba-6c70b9abb6de",
+							Name:         "kube-runner-4",
+							Status:       garmProviderParams.InstanceRunning,
+							RunnerStatus: params.RunnerIdle,
+							UpdatedAt:    time.Now().Add(-1 * time.Hour),
+						},
+						{
+							ID:           "b3ea9882-a25c-4eb1-94ba-6c70b9abb6df",
+							Name:         "kube-runner-3",
+							Status:       garmProviderParams.InstanceRunning,
+							RunnerStatus: params.RunnerIdle,
+							UpdatedAt:    time.Now().Add(-1 * time.Hour),
+						},
+						{
+							ID:           "b3ea9882-a25c-4eb1-94ba-6c70b9abb6dg",
+							Name:         "kube-runner-2",
+							Status:       garmProviderParams.InstanceRunning,
+							RunnerStatus: params.RunnerIdle,
+							UpdatedAt:    time.Now().Add(-1 * time.Hour),
+						},
+						{
+							ID:           "b3ea9882-a25c-4eb1-94ba-6c70b9abb6dh",
+							Name:         "kube-runner-1",
+							Status:       garmProviderParams.InstancePendingDelete,
+							RunnerStatus: params.RunnerTerminated,
+							UpdatedAt:    time.Now(),
+						},
+					},
+					RepoID:         "",
+					RepoName:       "",
+					OrgID:          "",
+					OrgName:        "",
+					EnterpriseID:   enterpriseID,
+					EnterpriseName: enterpriseName,
+				}}, nil)
+
+				poolClient.GetPool(pools.NewGetPoolParams().WithPoolID(poolID)).Return(&pools.GetPoolOK{Payload: params.Pool{
+					RunnerPrefix: params.RunnerPrefix{
+						Prefix: "",
+					},
+					ID:             poolID,
+					ProviderName:   "kubernetes_external",
+					MaxRunners:     10,
+					MinIdleRunners: 5,
+					Image:          "linux-ubuntu-22.04-arm64",
+					Flavor:         "medium",
+					OSType:         "linux",
+					OSArch:         "arm64",
+					Tags: []params.Tag{
+						{
+							ID:   "b3ea9882-a25c-4eb1-94ba-6c70b9abb6da",
+							Name: "kubernetes",
+						},
+						{
+							ID:   "b3ea9882-a25c-4eb1-94ba-6c70b9abb6db",
+							Name: "linux",
+						},
+						{
+							ID:   "b3ea9882-a25c-4eb1-94ba-6c70b9abb6dc",
+							Name: "arm64",
+						},
+						{
+							ID:   "b3ea9882-a25c-4eb1-94ba-6c70b9abb6dd",
+							Name: "ubuntu",
+						},
+					},
+					Enabled: true,
+					Instances: []params.Instance{
+						{
+							ID:           "b3ea9882-a25c-4eb1-94ba-6c70b9abb6dd",
+							Name:         "kube-runner-5",
+							Status:       garmProviderParams.InstanceRunning,
+							RunnerStatus: params.RunnerIdle,
+							UpdatedAt:    time.Now(),
+						},
+						{
+							ID:           "b3ea9882-a25c-4eb1-94ba-6c70b9abb6de",
+							Name:         "kube-runner-4",
+							Status:       garmProviderParams.InstanceRunning,
+							RunnerStatus: params.RunnerIdle,
+							UpdatedAt:    time.Now().Add(-1 * time.Hour),
+						},
+						{
+							ID:           "b3ea9882-a25c-4eb1-94ba-6c70b9abb6df",
+							Name:         "kube-runner-3",
+							Status:       garmProviderParams.InstanceRunning,
+							RunnerStatus: params.RunnerIdle,
+							UpdatedAt:    time.Now().Add(-1 * time.Hour),
+						},
+						{
+							ID:           "b3ea9882-a25c-4eb1-94ba-6c70b9abb6dg",
+							Name:         "kube-runner-2",
+							Status:       garmProviderParams.InstanceRunning,
+							RunnerStatus: params.RunnerIdle,
+							UpdatedAt:    time.Now().Add(-1 * time.Hour),
+						},
+						{
+							ID:           "b3ea9882-a25c-4eb1-94ba-6c70b9abb6dh",
+							Name:         "kube-runner-1",
+							Status:       garmProviderParams.InstancePendingDelete,
+							RunnerStatus: params.RunnerTerminated,
+							UpdatedAt:    time.Now(),
+						},
+					},
+					RepoID:         "",
+					RepoName:       "",
+					OrgID:          "",
+					OrgName:        "",
+					EnterpriseID:   enterpriseID,
+					EnterpriseName: enterpriseName,
+				}}, nil)
+
+				maxRunners := uint(10)
+				minIdleRunners := uint(2)
+				enabled := true
+				runnerBootstrapTimeout := uint(20)
+				extraSpecs := json.RawMessage([]byte{})
+				gitHubRunnerGroup := ""
+				poolClient.UpdatePool(pools.NewUpdatePoolParams().WithPoolID(poolID).WithBody(params.UpdatePoolParams{
+					RunnerPrefix: params.RunnerPrefix{
+						Prefix: "",
+					},
+					MaxRunners:             &maxRunners,
+					MinIdleRunners:         &minIdleRunners,
+					Image:                  "linux-ubuntu-22.04-arm64",
+					Flavor:                 "medium",
+					OSType:                 "linux",
+					OSArch:                 "arm64",
+					Tags:                   []string{"kubernetes", "linux", "arm64", "ubuntu"},
+					Enabled:                &enabled,
+					RunnerBootstrapTimeout: &runnerBootstrapTimeout,
+					ExtraSpecs:             extraSpecs,
+					GitHubRunnerGroup:      &gitHubRunnerGroup,
+				})).Return(&pools.UpdatePoolOK{Payload: params.Pool{
+					RunnerPrefix: params.RunnerPrefix{
+						Prefix: "",
+					},
+					ID:             poolID,
+					ProviderName:   "kubernetes_external",
+					MaxRunners:     10,
+					MinIdleRunners: 2,
+					Image:          "linux-ubuntu-22.04-arm64",
+					Flavor:         "medium",
+					OSType:         "linux",
+					OSArch:         "arm64",
+					Tags: []params.Tag{
+						{
+							ID:   "b3ea9882-a25c-4eb1-94ba-6c70b9abb6da",
+							Name: "kubernetes",
+						},
+						{
+							ID:   "b3ea9882-a25c-4eb1-94ba-6c70b9abb6db",
+							Name: "linux",
+						},
+						{
+							ID:   "b3ea9882-a25c-4eb1-94ba-6c70b9abb6dc",
+							Name: "arm64",
+						},
+						{
+							ID:   "b3ea9882-a25c-4eb1-94ba-6c70b9abb6dd",
+							Name: "ubuntu",
+						},
+					},
+					Enabled: true,
+					Instances: []params.Instance{
+						{
+							ID:           "b3ea9882-a25c-4eb1-94ba-6c70b9abb6dd",
+							Name:         "kube-runner-5",
+							Status:       garmProviderParams.InstanceRunning,
+							RunnerStatus: params.RunnerIdle,
+							UpdatedAt:    time.Now(),
+						},
+						{
+							ID:           "b3ea9882-a25c-4eb1-94ba-6c70b9abb6de",
+							Name:         "kube-runner-4",
+							Status:       garmProviderParams.InstanceRunning,
+							RunnerStatus: params.RunnerIdle,
+							UpdatedAt:    time.Now().Add(-1 * time.Hour),
+						},
+						{
+							ID:           "b3ea9882-a25c-4eb1-94ba-6c70b9abb6df",
+							Name:         "kube-runner-3",
+							Status:       garmProviderParams.InstanceRunning,
+							RunnerStatus: params.RunnerIdle,
+							UpdatedAt:    time.Now().Add(-1 * time.Hour),
+						},
+						{
+							ID:           "b3ea9882-a25c-4eb1-94ba-6c70b9abb6dg",
+							Name:         "kube-runner-2",
+							Status:       garmProviderParams.InstanceRunning,
+							RunnerStatus: params.RunnerIdle,
+							UpdatedAt:    time.Now().Add(-1 * time.Hour),
+						},
+						{
+							ID:           "b3ea9882-a25c-4eb1-94ba-6c70b9abb6dh",
+							Name:         "kube-runner-1",
+							Status:       garmProviderParams.InstancePendingDelete,
+							RunnerStatus: params.RunnerTerminated,
+							UpdatedAt:    time.Now(),
+						},
+					},
+					RepoID:         "",
+					RepoName:       "",
+					OrgID:          "",
+					OrgName:        "",
+					EnterpriseID:   enterpriseID,
+					EnterpriseName: enterpriseName,
+				}}, nil)
+
+				instanceClient.DeleteInstance(instances.NewDeleteInstanceParams().WithInstanceName("kube-runner-3")).Return(nil)
+			},
+		},
+		{
+			name: "scaling idleRunners down to 2 within scale down cooldown - expect no deletion",
+			object: &garmoperatorv1beta1.Pool{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       "Pool",
+					APIVersion: garmoperatorv1beta1.GroupVersion.Group + "/" + garmoperatorv1beta1.GroupVersion.Version,
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "my-enterprise-pool",
+					Namespace: namespaceName,
+					Finalizers: []string{
+						key.PoolFinalizerName,
+					},
+				},
+				Spec: garmoperatorv1beta1.PoolSpec{
+					GitHubScopeRef: corev1.TypedLocalObjectReference{
+						APIGroup: &garmoperatorv1beta1.GroupVersion.Group,
+						Kind:     string(garmoperatorv1beta1.EnterpriseScope),
+						Name:     enterpriseName,
+					},
+					ProviderName:           "kubernetes_external",
+					MaxRunners:             10,
+					MinIdleRunners:         2,
+					ImageName:              "ubuntu-image",
+					Flavor:                 "medium",
+					OSType:                 "linux",
+					OSArch:                 "arm64",
+					Tags:                   []string{"kubernetes", "linux", "arm64", "ubuntu"},
+					Enabled:                true,
+					RunnerBootstrapTimeout: 20,
+					ExtraSpecs:             "",
+					GitHubRunnerGroup:      "",
+					ScaleDown: &garmoperatorv1beta1.ScaleDownPolicy{
+						Cooldown: &metav1.Duration{Duration: 10 * time.Minute},
+					},
+				},
+				Status: garmoperatorv1beta1.PoolStatus{
+					ID:                     poolID,
+					LongRunningIdleRunners: 3,
+					LastScaleDownTime:      &metav1.Time{Time: time.Now().Add(-1 * time.Minute)},
+				},
+			},
+			expectedObject: &garmoperatorv1beta1.Pool{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       "Pool",
+					APIVersion: garmoperatorv1beta1.GroupVersion.Group + "/" + garmoperatorv1beta1.GroupVersion.Version,
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "my-enterprise-pool",
+					Namespace: namespaceName,
+					Finalizers: []string{
+						key.PoolFinalizerName,
+					},
+				},
+				Spec: garmoperatorv1beta1.PoolSpec{
+					GitHubScopeRef: corev1.TypedLocalObjectReference{
+						APIGroup: &garmoperatorv1beta1.GroupVersion.Group,
+						Kind:     string(garmoperatorv1beta1.EnterpriseScope),
+						Name:     enterpriseName,
+					},
+					ProviderName:           "kubernetes_external",
+					MaxRunners:             10,
+					MinIdleRunners:         2,
+					ImageName:              "ubuntu-image",
+					Flavor:                 "medium",
+					OSType:                 "linux",
+					OSArch:                 "arm64",
+					Tags:                   []string{"kubernetes", "linux", "arm64", "ubuntu"},
+					Enabled:                true,
+					RunnerBootstrapTimeout: 20,
+					ExtraSpecs:             "",
+					GitHubRunnerGroup:      "",
+					ScaleDown: &garmoperatorv1beta1.ScaleDownPolicy{
+						Cooldown: &metav1.Duration{Duration: 10 * time.Minute},
+					},
+				},
+				Status: garmoperatorv1beta1.PoolStatus{
+					ID:                     poolID,
+					LongRunningIdleRunners: 3,
+					IdleRunners:            4,
+					TotalRunners:           5,
+					MaxRunners:             10,
+					LastScaleDownTime:      &metav1.Time{},
+					LastDriftedFields:      []string{"min_idle_runners", "tags", "runner_bootstrap_timeout"},
+					Selector:               "garm-operator.mercedes-benz.com/pool=my-enterprise-pool",
+					Conditions: []metav1.Condition{
+						{
+							Type:               string(conditions.ReadyCondition),
+							Status:             metav1.ConditionTrue,
+							LastTransitionTime: metav1.NewTime(time.Now()),
+							Reason:             string(conditions.SuccessfulReconcileReason),
+							Message:            "",
+						},
+						{
+							Type:               string(conditions.ImageReference),
+							Status:             metav1.ConditionTrue,
+							Message:            "Successfully fetched Image CR Ref",
+							Reason:             string(conditions.FetchingImageRefSuccessReason),
+							LastTransitionTime: metav1.NewTime(time.Now()),
+						},
+						{
+							Type:               string(conditions.ScopeReference),
+							Status:             metav1.ConditionTrue,
+							Message:            "Successfully fetched Enterprise CR Ref",
+							Reason:             string(conditions.FetchingScopeRefSuccessReason),
+							LastTransitionTime: metav1.NewTime(time.Now()),
+						},
+					},
+				},
+			},
+			runtimeObjects: []runtime.Object{
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: namespaceName,
+						Name:      "my-webhook-secret",
+					},
+					Data: map[string][]byte{
+						"webhookSecret": []byte("supersecretvalue"),
+					},
+				},
+				&garmoperatorv1beta1.Image{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "ubuntu-image",
+						Namespace: namespaceName,
+					},
+					Spec: garmoperatorv1beta1.ImageSpec{
+						Tag: "linux-ubuntu-22.04-arm64",
+					},
+				},
+				&garmoperatorv1beta1.Enterprise{
+					TypeMeta: metav1.TypeMeta{
+						Kind:       "Enterprise",
+						APIVersion: garmoperatorv1beta1.GroupVersion.Group + "/" + garmoperatorv1beta1.GroupVersion.Version,
+					},
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      enterpriseName,
+						Namespace: namespaceName,
+					},
+					Spec: garmoperatorv1beta1.EnterpriseSpec{
+						CredentialsRef: corev1.TypedLocalObjectReference{
+							APIGroup: &garmoperatorv1beta1.GroupVersion.Group,
+							Kind:     "GitHubCredential",
+							Name:     "github-creds",
+						},
+						WebhookSecretRef: garmoperatorv1beta1.SecretRef{
+							Name: "my-webhook-secret",
+							Key:  "webhookSecret",
+						},
+					},
+					Status: garmoperatorv1beta1.EnterpriseStatus{
+						ID: enterpriseID,
+						Conditions: []metav1.Condition{
+							{
+								Type:               string(conditions.ReadyCondition),
+								Reason:             string(conditions.SuccessfulReconcileReason),
+								Status:             metav1.ConditionTrue,
+								Message:            "",
+								LastTransitionTime: metav1.NewTime(time.Now()),
+							},
+							{
+								Type:               string(conditions.PoolManager),
+								Reason:             string(conditions.PoolManagerFailureReason),
+								Status:             metav1.ConditionFalse,
+								Message:            "no resources available",
+								LastTransitionTime: metav1.NewTime(time.Now()),
+							},
+						},
+					},
+				},
+			},
+			expectGarmRequest: func(poolClient *mock.MockPoolClientMockRecorder, instanceClient *mock.MockInstanceClientMockRecorder) {
+				poolClient.GetPool(pools.NewGetPoolParams().WithPoolID(poolID)).Return(&pools.GetPoolOK{Payload: params.Pool{
+					RunnerPrefix: params.RunnerPrefix{
+						Prefix: "",
+					},
+					ID:             poolID,
+					ProviderName:   "kubernetes_external",
+					MaxRunners:     10,
+					MinIdleRunners: 5,
+					Image:          "linux-ubuntu-22.04-arm64",
+					Flavor:         "medium",
+					OSType:         "linux",
+					OSArch:         "arm64",
+					Tags: []params.Tag{
+						{
+							ID:   "b3ea9882-a25c-4eb1-94ba-6c70b9abb6da",
+							Name: "kubernetes",
+						},
+						{
+							ID:   "b3ea9882-a25c-4eb1-94ba-6c70b9abb6db",
+							Name: "linux",
+						},
+						{
+							ID:   "b3ea9882-a25c-4eb1-94ba-6c70b9abb6dc",
+							Name: "arm64",
+						},
+						{
+							ID:   "b3ea9882-a25c-4eb1-94ba-6c70b9abb6dd",
+							Name: "ubuntu",
+						},
+					},
+					Enabled: true,
+					Instances: []params.Instance{
+						{
+							ID:           "b3ea9882-a25c-4eb1-94ba-6c70b9abb6dd",
+							Name:         "kube-runner-5",
+							Status:       garmProviderParams.InstanceRunning,
+							RunnerStatus: params.RunnerIdle,
+							UpdatedAt:    time.Now(),
+						},
+						{
+							ID:           "b3ea9882-a25c-4eb1-94ba-6c70b9abb6de",
+							Name:         "kube-runner-4",
+							Status:       garmProviderParams.InstanceRunning,
+							RunnerStatus: params.RunnerIdle,
+							UpdatedAt:    time.Now().Add(-1 * time.Hour),
+						},
+						{
+							ID:           "b3ea9882-a25c-4eb1-94ba-6c70b9abb6df",
+							Name:         "kube-runner-3",
+							Status:       garmProviderParams.InstanceRunning,
+							RunnerStatus: params.RunnerIdle,
+							UpdatedAt:    time.Now().Add(-1 * time.Hour),
+						},
+						{
+							ID:           "b3ea9882-a25c-4eb1-94ba-6c70b9abb6dg",
+							Name:         "kube-runner-2",
+							Status:       garmProviderParams.InstanceRunning,
+							RunnerStatus: params.RunnerIdle,
+							UpdatedAt:    time.Now().Add(-1 * time.Hour),
+						},
+						{
+							ID:           "b3ea9882-a25c-4eb1-94ba-6c70b9abb6dh",
+							Name:         "kube-runner-1",
+							Status:       garmProviderParams.InstancePendingDelete,
+							RunnerStatus: params.RunnerTerminated,
+							UpdatedAt:    time.Now(),
+						},
+					},
+					RepoID:         "",
+					RepoName:       "",
+					OrgID:          "",
+					OrgName:        "",
+					EnterpriseID:   enterpriseID,
+					EnterpriseName: enterpriseName,
+				}}, nil)
+
+				poolClient.GetPool(pools.NewGetPoolParams().WithPoolID(poolID)).Return(&pools.GetPoolOK{Payload: params.Pool{
+					RunnerPrefix: params.RunnerPrefix{
+						Prefix: "",
+					},
+					ID:             poolID,
+					ProviderName:   "kubernetes_external",
+					MaxRunners:     10,
+					MinIdleRunners: 5,
+					Image:          "linux-ubuntu-22.04-arm64",
+					Flavor:         "medium",
+					OSType:         "linux",
+					OSArch:         "arm64",
+					Tags: []params.Tag{
+						{
+							ID:   "b3ea9882-a25c-4eb1-94ba-6c70b9abb6da",
+							Name: "kubernetes",
+						},
+						{
+							ID:   "b3ea9882-a25c-4eb1-94ba-6c70b9abb6db",
+							Name: "linux",
+						},
+						{
+							ID:   "b3ea9882-a25c-4eb1-94ba-6c70b9abb6dc",
+							Name: "arm64",
+						},
+						{
+							ID:   "b3ea9882-a25c-4eb1-94ba-6c70b9abb6dd",
+							Name: "ubuntu",
+						},
+					},
+					Enabled: true,
+					Instances: []params.Instance{
+						{
+							ID:           "b3ea9882-a25c-4eb1-94ba-6c70b9abb6dd",
+							Name:         "kube-runner-5",
+							Status:       garmProviderParams.InstanceRunning,
+							RunnerStatus: params.RunnerIdle,
+							UpdatedAt:    time.Now(),
+						},
+						{
+							ID:           "b3ea9882-a25c-4eb1-94ba-6c70b9abb6de",
+							Name:         "kube-runner-4",
+							Status:       garmProviderParams.InstanceRunning,
+							RunnerStatus: params.RunnerIdle,
+							UpdatedAt:    time.Now().Add(-1 * time.Hour),
+						},
+						{
+							ID:           "b3ea9882-a25c-4eb1-94ba-6c70b9abb6df",
+							Name:         "kube-runner-3",
+							Status:       garmProviderParams.InstanceRunning,
+							RunnerStatus: params.RunnerIdle,
+							UpdatedAt:    time.Now().Add(-1 * time.Hour),
+						},
+						{
+							ID:           "b3ea9882-a25c-4eb1-94ba-6c70b9abb6dg",
+							Name:         "kube-runner-2",
+							Status:       garmProviderParams.InstanceRunning,
+							RunnerStatus: params.RunnerIdle,
+							UpdatedAt:    time.Now().Add(-1 * time.Hour),
+						},
+						{
+							ID:           "b3ea9882-a25c-4eb1-94ba-6c70b9abb6dh",
+							Name:         "kube-runner-1",
+							Status:       garmProviderParams.InstancePendingDelete,
+							RunnerStatus: params.RunnerTerminated,
+							UpdatedAt:    time.Now(),
+						},
+					},
+					RepoID:         "",
+					RepoName:       "",
+					OrgID:          "",
+					OrgName:        "",
+					EnterpriseID:   enterpriseID,
+					EnterpriseName: enterpriseName,
+				}}, nil)
+
+				maxRunners := uint(10)
+				minIdleRunners := uint(2)
+				enabled := true
+				runnerBootstrapTimeout := uint(20)
+				extraSpecs := json.RawMessage([]byte{})
+				gitHubRunnerGroup := ""
+				poolClient.UpdatePool(pools.NewUpdatePoolParams().WithPoolID(poolID).WithBody(params.UpdatePoolParams{
+					RunnerPrefix: params.RunnerPrefix{
+						Prefix: "",
+					},
+					MaxRunners:             &maxRunners,
+					MinIdleRunners:         &minIdleRunners,
+					Image:                  "linux-ubuntu-22.04-arm64",
+					Flavor:                 "medium",
+					OSType:                 "linux",
+					OSArch:                 "arm64",
+					Tags:                   []string{"kubernetes", "linux", "arm64", "ubuntu"},
+					Enabled:                &enabled,
+					RunnerBootstrapTimeout: &runnerBootstrapTimeout,
+					ExtraSpecs:             extraSpecs,
+					GitHubRunnerGroup:      &gitHubRunnerGroup,
+				})).Return(&pools.UpdatePoolOK{Payload: params.Pool{
+					RunnerPrefix: params.RunnerPrefix{
+						Prefix: "",
+					},
+					ID:             poolID,
+					ProviderName:   "kubernetes_external",
+					MaxRunners:     10,
+					MinIdleRunners: 2,
+					Image:          "linux-ubuntu-22.04-arm64",
+					Flavor:         "medium",
+					OSType:         "linux",
+					OSArch:         "arm64",
+					Tags: []params.Tag{
+						{
+							ID:   "b3ea9882-a25c-4eb1-94ba-6c70b9abb6da",
+							Name: "kubernetes",
+						},
+						{
+							ID:   "b3ea9882-a25c-4eb1-94ba-6c70b9abb6db",
+							Name: "linux",
+						},
+						{
+							ID:   "b3ea9882-a25c-4eb1-94ba-6c70b9abb6dc",
+							Name: "arm64",
+						},
+						{
+							ID:   "b3ea9882-a25c-4eb1-94ba-6c70b9abb6dd",
+							Name: "ubuntu",
+						},
+					},
+					Enabled: true,
+					Instances: []params.Instance{
+						{
+							ID:           "b3ea9882-a25c-4eb1-94ba-6c70b9abb6dd",
+							Name:         "kube-runner-5",
+							Status:       garmProviderParams.InstanceRunning,
+							RunnerStatus: params.RunnerIdle,
+							UpdatedAt:    time.Now(),
+						},
+						{
+							ID:           "b3ea9882-a25c-4eb1-94ba-6c70b9abb6de",
+							Name:         "kube-runner-4",
+							Status:       garmProviderParams.InstanceRunning,
+							RunnerStatus: params.RunnerIdle,
+							UpdatedAt:    time.Now().Add(-1 * time.Hour),
+						},
+						{
+							ID:           "b3ea9882-a25c-4eb1-94ba-6c70b9abb6df",
+							Name:         "kube-runner-3",
+							Status:       garmProviderParams.InstanceRunning,
+							RunnerStatus: params.RunnerIdle,
+							UpdatedAt:    time.Now().Add(-1 * time.Hour),
+						},
+						{
+							ID:           "b3ea9882-a25c-4eb1-94ba-6c70b9abb6dg",
+							Name:         "kube-runner-2",
+							Status:       garmProviderParams.InstanceRunning,
+							RunnerStatus: params.RunnerIdle,
+							UpdatedAt:    time.Now().Add(-1 * time.Hour),
+						},
+						{
+							ID:           "b3ea9882-a25c-4eb1-94ba-6c70b9abb6dh",
+							Name:         "kube-runner-1",
+							Status:       garmProviderParams.InstancePendingDelete,
+							RunnerStatus: params.RunnerTerminated,
+							UpdatedAt:    time.Now(),
+						},
+					},
+					RepoID:         "",
+					RepoName:       "",
+					OrgID:          "",
+					OrgName:        "",
+					EnterpriseID:   enterpriseID,
+					EnterpriseName: enterpriseName,
+				}}, nil)
+
+			},
+		},
+		{
+			name: "scaling idleRunners down to 0 with scale down step size - expect deletion of one instance",
+			object: &garmoperatorv1beta1.Pool{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       "Pool",
+					APIVersion: garmoperatorv1beta1.GroupVersion.Group + "/" + garmoperatorv1beta1.GroupVersion.Version,
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "my-enterprise-pool",
+					Namespace: namespaceName,
+					Finalizers: []string{
+						key.PoolFinalizerName,
+					},
+				},
+				Spec: garmoperatorv1beta1.PoolSpec{
+					GitHubScopeRef: corev1.TypedLocalObjectReference{
+						APIGroup: &garmoperatorv1beta1.GroupVersion.Group,
+						Kind:     string(garmoperatorv1beta1.EnterpriseScope),
+						Name:     enterpriseName,
+					},
+					ProviderName:           "kubernetes_external",
+					MaxRunners:             10,
+					MinIdleRunners:         0,
+					ImageName:              "ubuntu-image",
+					Flavor:                 "medium",
+					OSType:                 "linux",
+					OSArch:                 "arm64",
+					Tags:                   []string{"kubernetes", "linux", "arm64", "ubuntu"},
+					Enabled:                true,
+					RunnerBootstrapTimeout: 20,
+					ExtraSpecs:             "",
+					GitHubRunnerGroup:      "",
+					ScaleDown: &garmoperatorv1beta1.ScaleDownPolicy{
+						StepSize: 1,
+					},
+				},
+				Status: garmoperatorv1beta1.PoolStatus{
+					ID:                     poolID,
+					LongRunningIdleRunners: 3,
+				},
+			},
+			expectedObject: &garmoperatorv1beta1.Pool{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       "Pool",
+					APIVersion: garmoperatorv1beta1.GroupVersion.Group + "/" + garmoperatorv1beta1.GroupVersion.Version,
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "my-enterprise-pool",
+					Namespace: namespaceName,
+					Finalizers: []string{
+						key.PoolFinalizerName,
+					},
+				},
+				Spec: garmoperatorv1beta1.PoolSpec{
+					GitHubScopeRef: corev1.TypedLocalObjectReference{
+						APIGroup: &garmoperatorv1beta1.GroupVersion.Group,
+						Kind:     string(garmoperatorv1beta1.EnterpriseScope),
+						Name:     enterpriseName,
+					},
+					ProviderName:           "kubernetes_external",
+					MaxRunners:             10,
+					MinIdleRunners:         0,
+					ImageName:              "ubuntu-image",
+					Flavor:                 "medium",
+					OSType:                 "linux",
+					OSArch:                 "arm64",
+					Tags:                   []string{"kubernetes", "linux", "arm64", "ubuntu"},
+					Enabled:                true,
+					RunnerBootstrapTimeout: 20,
+					ExtraSpecs:             "",
+					GitHubRunnerGroup:      "",
+					ScaleDown: &garmoperatorv1beta1.ScaleDownPolicy{
+						StepSize: 1,
+					},
+				},
+				Status: garmoperatorv1beta1.PoolStatus{
+					ID:                     poolID,
+					LongRunningIdleRunners: 2,
+					IdleRunners:            3,
+					TotalRunners:           4,
+					MaxRunners:             10,
+					LastScaleDownTime:      &metav1.Time{},
+					LastScaleDownReason:    "scale idle runners down to 0",
+					LastDriftedFields:      []string{"min_idle_runners", "tags", "runner_bootstrap_timeout"},
+					Selector:               "garm-operator.mercedes-benz.com/pool=my-enterprise-pool",
+					Conditions: []metav1.Condition{
+						{
+							Type:               string(conditions.ReadyCondition),
+							Status:             metav1.ConditionTrue,
+							LastTransitionTime: metav1.NewTime(time.Now()),
+							Reason:             string(conditions.SuccessfulReconcileReason),
+							Message:            "",
+						},
+						{
+							Type:               string(conditions.ImageReference),
+							Status:             metav1.ConditionTrue,
+							Message:            "Successfully fetched Image CR Ref",
+							Reason:             string(conditions.FetchingImageRefSuccessReason),
+							LastTransitionTime: metav1.NewTime(time.Now()),
+						},
+						{
+							Type:               string(conditions.ScopeReference),
+							Status:             metav1.ConditionTrue,
+							Message:            "Successfully fetched Enterprise CR Ref",
+							Reason:             string(conditions.FetchingScopeRefSuccessReason),
+							LastTransitionTime: metav1.NewTime(time.Now()),
+						},
+					},
+				},
+			},
+			runtimeObjects: []runtime.Object{
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: namespaceName,
+						Name:      "my-webhook-secret",
+					},
+					Data: map[string][]byte{
+						"webhookSecret": []byte("supersecretvalue"),
+					},
+				},
+				&garmoperatorv1beta1.Image{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "ubuntu-image",
+						Namespace: namespaceName,
+					},
+					Spec: garmoperatorv1beta1.ImageSpec{
+						Tag: "linux-ubuntu-22.04-arm64",
+					},
+				},
+				&garmoperatorv1beta1.Enterprise{
+					TypeMeta: metav1.TypeMeta{
+						Kind:       "Enterprise",
+						APIVersion: garmoperatorv1beta1.GroupVersion.Group + "/" + garmoperatorv1beta1.GroupVersion.Version,
+					},
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      enterpriseName,
+						Namespace: namespaceName,
+					},
+					Spec: garmoperatorv1beta1.EnterpriseSpec{
+						CredentialsRef: corev1.TypedLocalObjectReference{
+							APIGroup: &garmoperatorv1beta1.GroupVersion.Group,
+							Kind:     "GitHubCredential",
+							Name:     "github-creds",
+						},
+						WebhookSecretRef: garmoperatorv1beta1.SecretRef{
+							Name: "my-webhook-secret",
+							Key:  "webhookSecret",
+						},
+					},
+					Status: garmoperatorv1beta1.EnterpriseStatus{
+						ID: enterpriseID,
+						Conditions: []metav1.Condition{
+							{
+								Type:               string(conditions.ReadyCondition),
+								Reason:             string(conditions.SuccessfulReconcileReason),
+								Status:             metav1.ConditionTrue,
+								Message:            "",
+								LastTransitionTime: metav1.NewTime(time.Now()),
+							},
+							{
+								Type:               string(conditions.PoolManager),
+								Reason:             string(conditions.PoolManagerFailureReason),
+								Status:             metav1.ConditionFalse,
+								Message:            "no resources available",
+								LastTransitionTime: metav1.NewTime(time.Now()),
+							},
+						},
+					},
+				},
+			},
+			expectGarmRequest: func(poolClient *mock.MockPoolClientMockRecorder, instanceClient *mock.MockInstanceClientMockRecorder) {
+				poolClient.GetPool(pools.NewGetPoolParams().WithPoolID(poolID)).Return(&pools.GetPoolOK{Payload: params.Pool{
+					RunnerPrefix: params.RunnerPrefix{
+						Prefix: "",
+					},
+					ID:             poolID,
+					ProviderName:   "kubernetes_external",
+					MaxRunners:     10,
+					MinIdleRunners: 5,
+					Image:          "linux-ubuntu-22.04-arm64",
+					Flavor:         "medium",
+					OSType:         "linux",
+					OSArch:         "arm64",
+					Tags: []params.Tag{
+						{
+							ID:   "b3ea9882-a25c-4eb1-94ba-6c70b9abb6da",
+							Name: "kubernetes",
+						},
+						{
+							ID:   "b3ea9882-a25c-4eb1-94ba-6c70b9abb6db",
+							Name: "linux",
+						},
+						{
+							ID:   "b3ea9882-a25c-4eb1-94ba-6c70b9abb6dc",
+							Name: "arm64",
+						},
+						{
+							ID:   "b3ea9882-a25c-4eb1-94ba-6c70b9abb6dd",
+							Name: "ubuntu",
+						},
+					},
+					Enabled: true,
+					Instances: []params.Instance{
+						{
+							ID:           "b3ea9882-a25c-4eb1-94ba-6c70b9abb6dd",
+							Name:         "kube-runner-5",
+							Status:       garmProviderParams.InstanceRunning,
+							RunnerStatus: params.RunnerIdle,
+							UpdatedAt:    time.Now(),
+						},
+						{
+							ID:           "b3ea9882-a25c-4eb1-94ba-6c70b9abb6de",
+							Name:         "kube-runner-4",
+							Status:       garmProviderParams.InstanceRunning,
+							RunnerStatus: params.RunnerIdle,
+							UpdatedAt:    time.Now().Add(-1 * time.Hour),
+						},
+						{
+							ID:           "b3ea9882-a25c-4eb1-94ba-6c70b9abb6df",
+							Name:         "kube-runner-3",
+							Status:       garmProviderParams.InstanceRunning,
+							RunnerStatus: params.RunnerIdle,
+							UpdatedAt:    time.Now().Add(-1 * time.Hour),
+						},
+						{
+							ID:           "b3ea9882-a25c-4eb1-94ba-6c70b9abb6dg",
+							Name:         "kube-runner-2",
+							Status:       garmProviderParams.InstanceRunning,
+							RunnerStatus: params.RunnerIdle,
+							UpdatedAt:    time.Now().Add(-1 * time.Hour),
+						},
+						{
+							ID:           "b3ea9882-a25c-4eb1-94ba-6c70b9abb6dh",
+							Name:         "kube-runner-1",
+							Status:       garmProviderParams.InstancePendingDelete,
+							RunnerStatus: params.RunnerTerminated,
+							UpdatedAt:    time.Now(),
+						},
+					},
+					RepoID:         "",
+					RepoName:       "",
+					OrgID:          "",
+					OrgName:        "",
+					EnterpriseID:   enterpriseID,
+					EnterpriseName: enterpriseName,
+				}}, nil)
+
+				poolClient.GetPool(pools.NewGetPoolParams().WithPoolID(poolID)).Return(&pools.GetPoolOK{Payload: params.Pool{
+					RunnerPrefix: params.RunnerPrefix{
+						Prefix: "",
+					},
+					ID:             poolID,
+					ProviderName:   "kubernetes_external",
+					MaxRunners:     10,
+					MinIdleRunners: 5,
+					Image:          "linux-ubuntu-22.04-arm64",
+					Flavor:         "medium",
+					OSType:         "linux",
+					OSArch:         "arm64",
+					Tags: []params.Tag{
+						{
+							ID:   "b3ea9882-a25c-4eb1-94ba-6c70b9abb6da",
+							Name: "kubernetes",
+						},
+						{
+							ID:   "b3ea9882-a25c-4eb1-94ba-6c70b9abb6db",
+							Name: "linux",
+						},
+						{
+							ID:   "b3ea9882-a25c-4eb1-94ba-6c70b9abb6dc",
+							Name: "arm64",
+						},
+						{
+							ID:   "b3ea9882-a25c-4eb1-94ba-6c70b9abb6dd",
+							Name: "ubuntu",
+						},
+					},
+					Enabled: true,
+					Instances: []params.Instance{
+						{
+							ID:           "b3ea9882-a25c-4eb1-94ba-6c70b9abb6dd",
+							Name:         "kube-runner-5",
+							Status:       garmProviderParams.InstanceRunning,
+							RunnerStatus: params.RunnerIdle,
+							UpdatedAt:    time.Now(),
+						},
+						{
+							ID:           "b3ea9882-a25c-4eb1-94ba-6c70b9abb6de",
+							Name:         "kube-runner-4",
+							Status:       garmProviderParams.InstanceRunning,
+							RunnerStatus: params.RunnerIdle,
+							UpdatedAt:    time.Now().Add(-1 * time.Hour),
+						},
+						{
+							ID:           "b3ea9882-a25c-4eb1-94ba-6c70b9abb6df",
+							Name:         "kube-runner-3",
+							Status:       garmProviderParams.InstanceRunning,
+							RunnerStatus: params.RunnerIdle,
+							UpdatedAt:    time.Now().Add(-1 * time.Hour),
+						},
+						{
+							ID:           "b3ea9882-a25c-4eb1-94ba-6c70b9abb6dg",
+							Name:         "kube-runner-2",
+							Status:       garmProviderParams.InstanceRunning,
+							RunnerStatus: params.RunnerIdle,
+							UpdatedAt:    time.Now().Add(-1 * time.Hour),
+						},
+						{
+							ID:           "b3ea9882-a25c-4eb1-94ba-6c70b9abb6dh",
+							Name:         "kube-runner-1",
+							Status:       garmProviderParams.InstancePendingDelete,
+							RunnerStatus: params.RunnerTerminated,
+							UpdatedAt:    time.Now(),
+						},
+					},
+					RepoID:         "",
+					RepoName:       "",
+					OrgID:          "",
+					OrgName:        "",
+					EnterpriseID:   enterpriseID,
+					EnterpriseName: enterpriseName,
+				}}, nil)
+
+				maxRunners := uint(10)
+				minIdleRunners := uint(0)
+				enabled := true
+				runnerBootstrapTimeout := uint(20)
+				extraSpecs := json.RawMessage([]byte{})
+				gitHubRunnerGroup := ""
+				poolClient.UpdatePool(pools.NewUpdatePoolParams().WithPoolID(poolID).WithBody(params.UpdatePoolParams{
+					RunnerPrefix: params.RunnerPrefix{
+						Prefix: "",
+					},
+					MaxRunners:             &maxRunners,
+					MinIdleRunners:         &minIdleRunners,
+					Image:                  "linux-ubuntu-22.04-arm64",
+					Flavor:                 "medium",
+					OSType:                 "linux",
+					OSArch:                 "arm64",
+					Tags:                   []string{"kubernetes", "linux", "arm64", "ubuntu"},
+					Enabled:                &enabled,
+					RunnerBootstrapTimeout: &runnerBootstrapTimeout,
+					ExtraSpecs:             extraSpecs,
+					GitHubRunnerGroup:      &gitHubRunnerGroup,
+				})).Return(&pools.UpdatePoolOK{Payload: params.Pool{
+					RunnerPrefix: params.RunnerPrefix{
+						Prefix: "",
+					},
+					ID:             poolID,
+					ProviderName:   "kubernetes_external",
+					MaxRunners:     10,
+					MinIdleRunners: 0,
+					Image:          "linux-ubuntu-22.04-arm64",
+					Flavor:         "medium",
+					OSType:         "linux",
+					OSArch:         "arm64",
+					Tags: []params.Tag{
+						{
+							ID:   "b3ea9882-a25c-4eb1-94ba-6c70b9abb6da",
+							Name: "kubernetes",
+						},
+						{
+							ID:   "b3ea9882-a25c-4eb1-94ba-6c70b9abb6db",
+							Name: "linux",
+						},
+						{
+							ID:   "b3ea9882-a25c-4eb1-94ba-6c70b9abb6dc",
+							Name: "arm64",
+						},
+						{
+							ID:   "b3ea9882-a25c-4eb1-94ba-6c70b9abb6dd",
+							Name: "ubuntu",
+						},
+					},
+					Enabled: true,
+					Instances: []params.Instance{
+						{
+							ID:           "b3ea9882-a25c-4eb1-94ba-6c70b9abb6dd",
+							Name:         "kube-runner-5",
+							Status:       garmProviderParams.InstanceRunning,
+							RunnerStatus: params.RunnerIdle,
+							UpdatedAt:    time.Now(),
+						},
+						{
+							ID:           "b3ea9882-a25c-4eb1-94ba-6c70b9abb6de",
+							Name:         "kube-runner-4",
+							Status:       garmProviderParams.InstanceRunning,
+							RunnerStatus: params.RunnerIdle,
+							UpdatedAt:    time.Now().Add(-1 * time.Hour),
+						},
+						{
+							ID:           "b3ea9882-a25c-4eb1-94ba-6c70b9abb6df",
+							Name:         "kube-runner-3",
+							Status:       garmProviderParams.InstanceRunning,
+							RunnerStatus: params.RunnerIdle,
+							UpdatedAt:    time.Now().Add(-1 * time.Hour),
+						},
+						{
+							ID:           "b3ea9882-a25c-4eb1-94ba-6c70b9abb6dg",
+							Name:         "kube-runner-2",
+							Status:       garmProviderParams.InstanceRunning,
+							RunnerStatus: params.RunnerIdle,
+							UpdatedAt:    time.Now().Add(-1 * time.Hour),
+						},
+						{
+							ID:           "b3ea9882-a25c-4eb1-94ba-6c70b9abb6dh",
+							Name:         "kube-runner-1",
+							Status:       garmProviderParams.InstancePendingDelete,
+							RunnerStatus: params.RunnerTerminated,
+							UpdatedAt:    time.Now(),
+						},
+					},
+					RepoID:         "",
+					RepoName:       "",
+					OrgID:          "",
+					OrgName:        "",
+					EnterpriseID:   enterpriseID,
+					EnterpriseName: enterpriseName,
+				}}, nil)
+
+				instanceClient.DeleteInstance(instances.NewDeleteInstanceParams().WithInstanceName("kube-runner-5")).Return(nil)
+			},
+		},
+		{
+			name: "pool does not exist in garm - error no image cr found",
+			object: &garmoperatorv1beta1.Pool{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       "Pool",
+					APIVersion: garmoperatorv1beta1.GroupVersion.Group + "/" + garmoperatorv1beta1.GroupVersion.Version,
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "my-enterprise-pool",
+					Namespace: namespaceName,
+					Finalizers: []string{
+						key.PoolFinalizerName,
+					},
+				},
+				Spec: garmoperatorv1beta1.PoolSpec{
+					GitHubScopeRef: corev1.TypedLocalObjectReference{
+						APIGroup: &garmoperatorv1beta1.GroupVersion.Group,
+						Kind:     string(garmoperatorv1beta1.EnterpriseScope),
+						Name:     enterpriseName,
+					},
+					ProviderName:           "kubernetes_external",
+					MaxRunners:             5,
+					MinIdleRunners:         3,
+					ImageName:              "ubuntu-image",
+					Flavor:                 "medium",
+					OSType:                 "linux",
+					OSArch:                 "arm64",
+					Tags:                   []string{"kubernetes", "linux", "arm64", "ubuntu"},
+					Enabled:                true,
+					RunnerBootstrapTimeout: 20,
+					ExtraSpecs:             "",
+					GitHubRunnerGroup:      "",
+				},
+			},
+			expectedObject: &garmoperatorv1beta1.Pool{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       "Pool",
+					APIVersion: garmoperatorv1beta1.GroupVersion.Group + "/" + garmoperatorv1beta1.GroupVersion.Version,
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "my-enterprise-pool",
+					Namespace: namespaceName,
+					Finalizers: []string{
+						key.PoolFinalizerName,
+					},
+				},
+				Spec: garmoperatorv1beta1.PoolSpec{
+					GitHubScopeRef: corev1.TypedLocalObjectReference{
+						APIGroup: &garmoperatorv1beta1.GroupVersion.Group,
+						Kind:     string(garmoperatorv1beta1.EnterpriseScope),
+						Name:     enterpriseName,
+					},
+					ProviderName:           "kubernetes_external",
+					MaxRunners:             5,
+					MinIdleRunners:         3,
+					ImageName:              "ubuntu-image",
+					Flavor:                 "medium",
+					OSType:                 "linux",
+					OSArch:                 "arm64",
+					Tags:                   []string{"kubernetes", "linux", "arm64", "ubuntu"},
+					Enabled:                true,
+					RunnerBootstrapTimeout: 20,
+					ExtraSpecs:             "",
+					GitHubRunnerGroup:      "",
+				},
+				Status: garmoperatorv1beta1.PoolStatus{
+					ID:                     "",
+					LongRunningIdleRunners: 0,
+					Selector:               "garm-operator.mercedes-benz.com/pool=my-enterprise-pool",
+					Conditions: []metav1.Condition{
+						{
+							Type:               string(conditions.ReadyCondition),
+							Reason:             string(conditions.FetchingImageRefFailedReason),
+							Status:             metav1.ConditionFalse,
+							Message:            "images.garm-operator.mercedes-benz.com \"ubuntu-image\" not found",
+							LastTransitionTime: metav1.NewTime(time.Now()),
+						},
+						{
+							Type:               string(conditions.ImageReference),
+							Reason:             string(conditions.FetchingImageRefFailedReason),
+							Status:             metav1.ConditionFalse,
+							Message:            "images.garm-operator.mercedes-benz.com \"ubuntu-image\" not found",
+							LastTransitionTime: metav1.NewTime(time.Now()),
+						},
+						{
+							Type:               string(conditions.ScopeReference),
+							Status:             metav1.ConditionTrue,
+							Message:            "Successfully fetched Enterprise CR Ref",
+							Reason:             string(conditions.FetchingScopeRefSuccessReason),
+							LastTransitionTime: metav1.NewTime(time.Now()),
+						},
+					},
+				},
+			},
+			runtimeObjects: []runtime.Object{
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: namespaceName,
+						Name:      "my-webhook-secret",
+					},
+					Data: map[string][]byte{
+						"webhookSecret": []byte("supersecretvalue"),
+					},
+				},
+				&garmoperatorv1beta1.Enterprise{
+					TypeMeta: metav1.TypeMeta{
+						Kind:       "Enterprise",
+						APIVersion: garmoperatorv1beta1.GroupVersion.Group + "/" + garmoperatorv1beta1.GroupVersion.Version,
+					},
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      enterpriseName,
+						Namespace: namespaceName,
+					},
+					Spec: garmoperatorv1beta1.EnterpriseSpec{
+						CredentialsRef: corev1.TypedLocalObjectReference{
+							APIGroup: &garmoperatorv1beta1.GroupVersion.Group,
+							Kind:     "GitHubCredential",
+							Name:     "github-creds",
+						},
+						WebhookSecretRef: garmoperatorv1beta1.SecretRef{
+							Name: "my-webhook-secret",
+							Key:  "webhookSecret",
+						},
+					},
+					Status: garmoperatorv1beta1.EnterpriseStatus{
+						ID: enterpriseID,
+						Conditions: []metav1.Condition{
+							{
+								Type:               string(conditions.ReadyCondition),
+								Reason:             string(conditions.SuccessfulReconcileReason),
+								Status:             metav1.ConditionTrue,
+								Message:            "",
+								LastTransitionTime: metav1.NewTime(time.Now()),
+							},
+							{
+								Type:               string(conditions.PoolManager),
+								Reason:             string(conditions.PoolManagerFailureReason),
+								Status:             metav1.ConditionFalse,
+								Message:            "no resources available",
+								LastTransitionTime: metav1.NewTime(time.Now()),
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+			expectGarmRequest: func(_ *mock.MockPoolClientMockRecorder, _ *mock.MockInstanceClientMockRecorder) {
+			},
+		},
+		{
+			name: "pool.Status has matching id in garm database, pool.Specs changed to not existent image cr ref - error no image cr found",
+			object: &garmoperatorv1beta1.Pool{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       "Pool",
+					APIVersion: garmoperatorv1beta1.GroupVersion.Group + "/" + garmoperatorv1beta1.GroupVersion.Version,
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "my-enterprise-pool",
+					Namespace: namespaceName,
+					Finalizers: []string{
+						key.PoolFinalizerName,
+					},
+				},
+				Spec: garmoperatorv1beta1.PoolSpec{
+					GitHubScopeRef: corev1.TypedLocalObjectReference{
+						APIGroup: &garmoperatorv1beta1.GroupVersion.Group,
+						Kind:     string(garmoperatorv1beta1.EnterpriseScope),
+						Name:     enterpriseName,
+					},
+					ProviderName:           "kubernetes_external",
+					MaxRunners:             1,
+					MinIdleRunners:         0,
+					ImageName:              "ubuntu-image-not-existent",
+					Flavor:                 "medium",
+					OSType:                 "linux",
+					OSArch:                 "arm64",
+					Tags:                   []string{"kubernetes", "linux", "arm64", "ubuntu"},
+					Enabled:                true,
+					RunnerBootstrapTimeout: 20,
+					ExtraSpecs:             "",
+					GitHubRunnerGroup:      "",
+				},
+				Status: garmoperatorv1beta1.PoolStatus{
+					ID: poolID,
+				},
+			},
+			expectedObject: &garmoperatorv1beta1.Pool{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       "Pool",
+					APIVersion: garmoperatorv1beta1.GroupVersion.Group + "/" + garmoperatorv1beta1.GroupVersion.Version,
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "my-enterprise-pool",
+					Namespace: namespaceName,
+					Finalizers: []string{
+						key.PoolFinalizerName,
+					},
+				},
+				Spec: garmoperatorv1beta1.PoolSpec{
+					GitHubScopeRef: corev1.TypedLocalObjectReference{
+						APIGroup: &garmoperatorv1beta1.GroupVersion.Group,
+						Kind:     string(garmoperatorv1beta1.EnterpriseScope),
+						Name:     enterpriseName,
+					},
+					ProviderName:           "kubernetes_external",
+					MaxRunners:             1,
+					MinIdleRunners:         0,
+					ImageName:              "ubuntu-image-not-existent",
+					Flavor:                 "medium",
+					OSType:                 "linux",
+					OSArch:                 "arm64",
+					Tags:                   []string{"kubernetes", "linux", "arm64", "ubuntu"},
+					Enabled:                true,
+					RunnerBootstrapTimeout: 20,
+					ExtraSpecs:             "",
+					GitHubRunnerGroup:      "",
+				},
+				Status: garmoperatorv1beta1.PoolStatus{
+					ID:       poolID,
+					Selector: "garm-operator.mercedes-benz.com/pool=my-enterprise-pool",
+					Conditions: []metav1.Condition{
+						{
+							Type:               string(conditions.ReadyCondition),
+							Reason:             string(conditions.FetchingImageRefFailedReason),
+							Status:             metav1.ConditionFalse,
+							Message:            "images.garm-operator.mercedes-benz.com \"ubuntu-image-not-existent\" not found",
+							LastTransitionTime: metav1.NewTime(time.Now()),
+						},
+						{
+							Type:               string(conditions.ImageReference),
+							Reason:             string(conditions.FetchingImageRefFailedReason),
+							Status:             metav1.ConditionFalse,
+							Message:            "images.garm-operator.mercedes-benz.com \"ubuntu-image-not-existent\" not found",
+							LastTransitionTime: metav1.NewTime(time.Now()),
+						},
+						{
+							Type:               string(conditions.ScopeReference),
+							Status:             metav1.ConditionTrue,
+							Message:            "Successfully fetched Enterprise CR Ref",
+							Reason:             string(conditions.FetchingScopeRefSuccessReason),
+							LastTransitionTime: metav1.NewTime(time.Now()),
+						},
+					},
+				},
+			},
+			runtimeObjects: []runtime.Object{
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: namespaceName,
+						Name:      "my-webhook-secret",
+					},
+					Data: map[string][]byte{
+						"webhookSecret": []byte("supersecretvalue"),
+					},
+				},
+				&garmoperatorv1beta1.Image{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "ubuntu-image",
+						Namespace: namespaceName,
+					},
+					Spec: garmoperatorv1beta1.ImageSpec{
+						Tag: "linux-ubuntu-22.04-arm64",
+					},
+				},
+				&garmoperatorv1beta1.Enterprise{
+					TypeMeta: metav1.TypeMeta{
+						Kind:       "Enterprise",
+						APIVersion: garmoperatorv1beta1.GroupVersion.Group + "/" + garmoperatorv1beta1.GroupVersion.Version,
+					},
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      enterpriseName,
+						Namespace: namespaceName,
+					},
+					Spec: garmoperatorv1beta1.EnterpriseSpec{
+						CredentialsRef: corev1.TypedLocalObjectReference{
+							APIGroup: &garmoperatorv1beta1.GroupVersion.Group,
+							Kind:     "GitHubCredential",
+							Name:     "github-creds",
+						},
+						WebhookSecretRef: garmoperatorv1beta1.SecretRef{
+							Name: "my-webhook-secret",
+							Key:  "webhookSecret",
+						},
+					},
+					Status: garmoperatorv1beta1.EnterpriseStatus{
+						ID: enterpriseID,
+						Conditions: []metav1.Condition{
+							{
+								Type:               string(conditions.ReadyCondition),
+								Reason:             string(conditions.SuccessfulReconcileReason),
+								Status:             metav1.ConditionTrue,
+								Message:            "",
+								LastTransitionTime: metav1.NewTime(time.Now()),
+							},
+							{
+								Type:               string(conditions.PoolManager),
+								Reason:             string(conditions.PoolManagerFailureReason),
+								Status:             metav1.ConditionFalse,
+								Message:            "no resources available",
+								LastTransitionTime: metav1.NewTime(time.Now()),
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+			expectGarmRequest: func(poolClient *mock.MockPoolClientMockRecorder, _ *mock.MockInstanceClientMockRecorder) {
+				poolClient.GetPool(pools.NewGetPoolParams().WithPoolID(poolID)).Return(&pools.GetPoolOK{Payload: params.Pool{
+					RunnerPrefix: params.RunnerPrefix{
+						Prefix: "",
+					},
+					ID:             poolID,
+					ProviderName:   "kubernetes_external",
+					MaxRunners:     5,
+					MinIdleRunners: 3,
+					Image:          "linux-ubuntu-22.04-arm64",
+					Flavor:         "medium",
+					OSType:         "linux",
+					OSArch:         "arm64",
+					Tags: []params.Tag{
+						{
+							ID:   "b3ea9882-a25c-4eb1-94ba-6c70b9abb6da",
+							Name: "kubernetes",
+						},
+						{
+							ID:   "b3ea9882-a25c-4eb1-94ba-6c70b9abb6db",
+							Name: "linux",
+						},
+						{
+							ID:   "b3ea9882-a25c-4eb1-94ba-6c70b9abb6dc",
+							Name: "arm64",
+						},
+						{
+							ID:   "b3ea9882-a25c-4eb1-94ba-6c70b9abb6dd",
+							Name: "ubuntu",
+						},
+					},
+					Enabled:        true,
+					Instances:      []params.Instance{},
+					RepoID:         "",
+					RepoName:       "",
+					OrgID:          "",
+					OrgName:        "",
+					EnterpriseID:   enterpriseID,
+					EnterpriseName: enterpriseName,
+				}}, nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schemeBuilder := runtime.SchemeBuilder{
+				garmoperatorv1beta1.AddToScheme,
+			}
+
+			err := schemeBuilder.AddToScheme(scheme.Scheme)
+			if err != nil {
+				t.Fatal(err)
+			}
+			runtimeObjects := []runtime.Object{tt.object}
+			runtimeObjects = append(runtimeObjects, tt.runtimeObjects...)
+			client := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithRuntimeObjects(runtimeObjects...).WithStatusSubresource(&garmoperatorv1beta1.Pool{}).Build()
+
+			// create a fake reconciler
+			reconciler := &PoolReconciler{
+				Client:   client,
+				Recorder: record.NewFakeRecorder(3),
+			}
+
+			pool := tt.object.DeepCopyObject().(*garmoperatorv1beta1.Pool)
+
+			mockPoolClient := mock.NewMockPoolClient(mockCtrl)
+			mockInstanceClient := mock.NewMockInstanceClient(mockCtrl)
+
+			// as the global configuration got initialized in the main function
+			// and the defaulting is done in there as well
+			// we have to explicitly set the values in here
+			config.Config.Operator.MinIdleRunnersAge = time.Duration(30) * time.Minute
+
+			tt.expectGarmRequest(mockPoolClient.EXPECT(), mockInstanceClient.EXPECT())
+
+			_, err = reconciler.reconcileNormal(context.Background(), mockPoolClient, pool, mockInstanceClient)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("PoolReconciler.reconcileNormal() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			// clear out annotations to avoid comparison errors
+			pool.Annotations = nil
+
+			// empty resource version to avoid comparison errors
+			pool.ResourceVersion = ""
+
+			// clear conditions lastTransitionTime to avoid comparison errors
+			conditions.NilLastTransitionTime(tt.expectedObject)
+			conditions.NilLastTransitionTime(pool)
+
+			// clear lastScaleDownTime to avoid comparison errors
+			if pool.Status.LastScaleDownTime != nil {
+				pool.Status.LastScaleDownTime = &metav1.Time{}
+			}
+
+			if !reflect.DeepEqual(pool, tt.expectedObject) {
+				t.Errorf("PoolReconciler.reconcileNormal() \n got =  %#v \n want = %#v", pool, tt.expectedObject)
+			}
+		})
+	}
+}
+
+func TestPoolController_ReconcileDelete(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	poolID := "fb2bceeb-f74d-435d-9648-626c75cb23ce"
+	enterpriseID := "93068607-2d0d-4b76-a950-0e40d31955b8"
+	enterpriseName := "test-enterprise"
+
+	tests := []struct {
+		name string
+		// the object to reconcile
+		object client.Object
+		// a list of objects to initialize the fake client with
+		// this can be used to define other existing objects that are referenced by the object to reconcile
+		// e.g. images or other pools ..
+		runtimeObjects    []runtime.Object
+		expectGarmRequest func(_ *mock.MockPoolClientMockRecorder, _ *mock.MockInstanceClientMockRecorder)
+		wantErr           bool
+		expectedObject    *garmoperatorv1beta1.Pool
+	}{
+		{
+			name: "delete pool - scaling down runners",
+			object: &garmoperatorv1beta1.Pool{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       "Pool",
+					APIVersion: garmoperatorv1beta1.GroupVersion.Group + "/" + garmoperatorv1beta1.GroupVersion.Version,
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "my-enterprise-pool",
+					Namespace: namespaceName,
+					Finalizers: []string{
+						key.PoolFinalizerName,
+					},
+				},
+				Spec: garmoperatorv1beta1.PoolSpec{
+					GitHubScopeRef: corev1.TypedLocalObjectReference{
+						APIGroup: &garmoperatorv1beta1.GroupVersion.Group,
+						Kind:     string(garmoperatorv1beta1.EnterpriseScope),
+						Name:     enterpriseName,
+					},
+					ProviderName:           "kubernetes_external",
+					MaxRunners:             5,
+					MinIdleRunners:         3,
+					ImageName:              "ubuntu-image",
+					Flavor:                 "medium",
+					OSType:                 "linux",
+					OSArch:                 "arm64",
+					Tags:                   []string{"kubernetes", "linux", "arm64", "ubuntu"},
+					Enabled:                true,
+					RunnerBootstrapTimeout: 20,
+					ExtraSpecs:             "",
+					GitHubRunnerGroup:      "",
+				},
+				Status: garmoperatorv1beta1.PoolStatus{
+					ID: poolID,
+				},
+			},
+			expectedObject: &garmoperatorv1beta1.Pool{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       "Pool",
+					APIVersion: garmoperatorv1beta1.GroupVersion.Group + "/" + garmoperatorv1beta1.GroupVersion.Version,
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "my-enterprise-pool",
+					Namespace: namespaceName,
+				},
+				Spec: garmoperatorv1beta1.PoolSpec{
+					GitHubScopeRef: corev1.TypedLocalObjectReference{
+						// APIGroup: &garmoperatorv1beta1.GroupVersion.Group,
+						Kind: string(garmoperatorv1beta1.EnterpriseScope),
+						Name: enterpriseName,
+					},
+					ProviderName:           "kubernetes_external",
+					MaxRunners:             5,
+					MinIdleRunners:         0,
+					ImageName:              "ubuntu-image",
+					Flavor:                 "medium",
+					OSType:                 "linux",
+					OSArch:                 "arm64",
+					Tags:                   []string{"kubernetes", "linux", "arm64", "ubuntu"},
+					Enabled:                false,
+					RunnerBootstrapTimeout: 20,
+					ExtraSpecs:             "",
+					GitHubRunnerGroup:      "",
+				},
+				Status: garmoperatorv1beta1.PoolStatus{
+					ID:                     poolID,
+					LongRunningIdleRunners: 0,
+					Conditions: []metav1.Condition{
+						{
+							Type:               string(conditions.ReadyCondition),
+							Status:             metav1.ConditionFalse,
+							LastTransitionTime: metav1.NewTime(time.Now()),
+							Reason:             string(conditions.DeletingReason),
+							Message:            conditions.DeletingPoolMsg,
+						},
+					},
+				},
+			},
+			runtimeObjects: []runtime.Object{
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: namespaceName,
+						Name:      "my-webhook-secret",
+					},
+					Data: map[string][]byte{
+						"webhookSecret": []byte("supersecretvalue"),
+					},
+				},
+				&garmoperatorv1beta1.Image{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "ubuntu-image",
+						Namespace: namespaceName,
+					},
+					Spec: garmoperatorv1beta1.ImageSpec{
+						Tag: "linux-ubuntu-22.04-arm64",
+					},
+				},
+				&garmoperatorv1beta1.Enterprise{
+					TypeMeta: metav1.TypeMeta{
+						Kind:       "Enterprise",
+						APIVersion: garmoperatorv1beta1.GroupVersion.Group + "/" + garmoperatorv1beta1.GroupVersion.Version,
+					},
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      enterpriseName,
+						Namespace: namespaceName,
+					},
+					Spec: garmoperatorv1beta1.EnterpriseSpec{
+						CredentialsRef: corev1.TypedLocalObjectReference{
+							APIGroup: &garmoperatorv1beta1.GroupVersion.Group,
+							Kind:     "GitHubCredential",
+							Name:     "github-creds",
+						},
+						WebhookSecretRef: garmoperatorv1beta1.SecretRef{
+							Name: "my-webhook-secret",
+							Key:  "webhookSecret",
+						},
+					},
+					Status: garmoperatorv1beta1.EnterpriseStatus{
+						ID: enterpriseID,
+						Conditions: []metav1.Condition{
+							{
+								Type:               string(conditions.ReadyCondition),
+								Reason:             string(conditions.SuccessfulReconcileReason),
+								Status:             metav1.ConditionTrue,
+								Message:            "",
+								LastTransitionTime: metav1.NewTime(time.Now()),
+							},
+							{
+								Type:               string(conditions.PoolManager),
+								Reason:             string(conditions.PoolManagerFailureReason),
+								Status:             metav1.ConditionFalse,
+								Message:            "no resources available",
+								LastTransitionTime: metav1.NewTime(time.Now()),
+							},
+						},
+					},
+				},
+			},
+			expectGarmRequest: func(m *mock.MockPoolClientMockRecorder, instanceClient *mock.MockInstanceClientMockRecorder) {
+				maxRunners := uint(5)
+				minIdleRunners := uint(0)
+				enabled := false
+				runnerBootstrapTimeout := uint(20)
+				extraSpecs := json.RawMessage([]byte{})
+				gitHubRunnerGroup := ""
+
+				instanceClient.ListPoolInstances(
+					instances.NewListPoolInstancesParams().
+						WithPoolID(poolID)).
+					Return(&instances.ListPoolInstancesOK{
+						Payload: params.Instances{},
+					},
+						nil)
+
+				m.UpdatePool(pools.NewUpdatePoolParams().
+					WithPoolID(poolID).
+					WithBody(params.UpdatePoolParams{
+						RunnerPrefix: params.RunnerPrefix{
+							Prefix: "",
+						},
+						MaxRunners:             &maxRunners,
+						MinIdleRunners:         &minIdleRunners,
+						Flavor:                 "medium",
+						OSType:                 "linux",
+						OSArch:                 "arm64",
+						Tags:                   []string{"kubernetes", "linux", "arm64", "ubuntu"},
+						Enabled:                &enabled,
+						RunnerBootstrapTimeout: &runnerBootstrapTimeout,
+						ExtraSpecs:             extraSpecs,
+						GitHubRunnerGroup:      &gitHubRunnerGroup,
+					})).Return(&pools.UpdatePoolOK{
+					Payload: params.Pool{
+						RunnerPrefix: params.RunnerPrefix{
+							Prefix: "",
+						},
+						ID:             poolID,
+						ProviderName:   "kubernetes_external",
+						MaxRunners:     5,
+						MinIdleRunners: 0,
+						Image:          "linux-ubuntu-22.04-arm64",
+						Flavor:         "medium",
+						OSType:         "linux",
+						OSArch:         "arm64",
+						Tags: []params.Tag{
+							{
+								ID:   "b3ea9882-a25c-4eb1-94ba-6c70b9abb6da",
+								Name: "kubernetes",
+							},
+							{
+								ID:   "b3ea9882-a25c-4eb1-94ba-6c70b9abb6db",
+								Name: "linux",
+							},
+							{
+								ID:   "b3ea9882-a25c-4eb1-94ba-6c70b9abb6dc",
+								Name: "arm64",
+							},
+							{
+								ID:   "b3ea9882-a25c-4eb1-94ba-6c70b9abb6dd",
+								Name: "ubuntu",
+							},
+						},
+						Enabled:        false,
+						Instances:      []params.Instance{},
+						RepoID:         "",
+						RepoName:       "",
+						OrgID:          "",
+						OrgName:        "",
+						EnterpriseID:   enterpriseID,
+						EnterpriseName: enterpriseName,
+					},
+				}, nil)
+
+				m.DeletePool(pools.NewDeletePoolParams().WithPoolID(poolID)).Return(nil)
+			},
+		},
+		{
+			name: "delete pool - deleting garm resource",
+			object: &garmoperatorv1beta1.Pool{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       "Pool",
+					APIVersion: garmoperatorv1beta1.GroupVersion.Group + "/" + garmoperatorv1beta1.GroupVersion.Version,
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "my-enterprise-pool",
+					Namespace: namespaceName,
+					Finalizers: []string{
+						key.PoolFinalizerName,
+					},
+				},
+				Spec: garmoperatorv1beta1.PoolSpec{
+					GitHubScopeRef: corev1.TypedLocalObjectReference{
+						APIGroup: &garmoperatorv1beta1.GroupVersion.Group,
+						Kind:     string(garmoperatorv1beta1.EnterpriseScope),
+						Name:     enterpriseName,
+					},
+					ProviderName:           "kubernetes_external",
+					MaxRunners:             5,
+					MinIdleRunners:         0,
+					ImageName:              "ubuntu-image",
+					Flavor:                 "medium",
+					OSType:                 "linux",
+					OSArch:                 "arm64",
+					Tags:                   []string{"kubernetes", "linux", "arm64", "ubuntu"},
+					Enabled:                true,
+					RunnerBootstrapTimeout: 20,
+					ExtraSpecs:             "",
+					GitHubRunnerGroup:      "",
+				},
+				Status: garmoperatorv1beta1.PoolStatus{
+					ID:                     poolID,
+					LongRunningIdleRunners: 0,
+				},
+			},
+			expectedObject: &garmoperatorv1beta1.Pool{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       "Pool",
+					APIVersion: garmoperatorv1beta1.GroupVersion.Group + "/" + garmoperatorv1beta1.GroupVersion.Version,
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "my-enterprise-pool",
+					Namespace: namespaceName,
+				},
+				Spec: garmoperatorv1beta1.PoolSpec{
+					GitHubScopeRef: corev1.TypedLocalObjectReference{
+						// APIGroup: &garmoperatorv1beta1.GroupVersion.Group,
+						Kind: string(garmoperatorv1beta1.EnterpriseScope),
+						Name: enterpriseName,
+					},
+					ProviderName:           "kubernetes_external",
+					MaxRunners:             5,
+					MinIdleRunners:         0,
+					ImageName:              "ubuntu-image",
+					Flavor:                 "medium",
+					OSType:                 "linux",
+					OSArch:                 "arm64",
+					Tags:                   []string{"kubernetes", "linux", "arm64", "ubuntu"},
+					Enabled:                false,
+					RunnerBootstrapTimeout: 20,
+					ExtraSpecs:             "",
+					GitHubRunnerGroup:      "",
+				},
+				Status: garmoperatorv1beta1.PoolStatus{
+					ID: poolID,
+					Conditions: []metav1.Condition{
+						{
+							Type:               string(conditions.ReadyCondition),
+							Status:             metav1.ConditionFalse,
+							LastTransitionTime: metav1.NewTime(time.Now()),
+							Reason:             string(conditions.DeletingReason),
+							Message:            conditions.DeletingPoolMsg,
+						},
+					},
+				},
+			},
+			runtimeObjects: []runtime.Object{
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: namespaceName,
+						Name:      "my-webhook-secret",
+					},
+					Data: map[string][]byte{
+						"webhookSecret": []byte("supersecretvalue"),
+					},
+				},
+				&garmoperatorv1beta1.Image{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "ubuntu-image",
+						Namespace: namespaceName,
+					},
+					Spec: garmoperatorv1beta1.ImageSpec{
+						Tag: "linux-ubuntu-22.04-arm64",
+					},
+				},
+				&garmoperatorv1beta1.Enterprise{
+					TypeMeta: metav1.TypeMeta{
+						Kind:       "Enterprise",
+						APIVersion: garmoperatorv1beta1.GroupVersion.Group + "/" + garmoperatorv1beta1.GroupVersion.Version,
+					},
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      enterpriseName,
+						Namespace: namespaceName,
+					},
+					Spec: garmoperatorv1beta1.EnterpriseSpec{
+						CredentialsRef: corev1.TypedLocalObjectReference{
+							APIGroup: &garmoperatorv1beta1.GroupVersion.Group,
+							Kind:     "GitHubCredential",
+							Name:     "github-creds",
+						},
+						WebhookSecretRef: garmoperatorv1beta1.SecretRef{
+							Name: "my-webhook-secret",
+							Key:  "webhookSecret",
+						},
+					},
+					Status: garmoperatorv1beta1.EnterpriseStatus{
+						ID: enterpriseID,
+						Conditions: []metav1.Condition{
+							{
+								Type:               string(conditions.ReadyCondition),
+								Reason:             string(conditions.SuccessfulReconcileReason),
+								Status:             metav1.ConditionTrue,
+								Message:            "",
+								LastTransitionTime: metav1.NewTime(time.Now()),
+							},
+							{
+								Type:               string(conditions.PoolManager),
+								Reason:             string(conditions.PoolManagerFailureReason),
+								Status:             metav1.ConditionFalse,
+								Message:            "no resources available",
+								LastTransitionTime: metav1.NewTime(time.Now()),
+							},
+						},
+					},
+				},
+			},
+			expectGarmRequest: func(poolClient *mock.MockPoolClientMockRecorder, instanceClient *mock.MockInstanceClientMockRecorder) {
+				instanceClient.ListPoolInstances(instances.NewListPoolInstancesParams().WithPoolID(poolID)).Return(&instances.ListPoolInstancesOK{Payload: params.Instances{}}, nil)
+				poolClient.DeletePool(pools.NewDeletePoolParams().WithPoolID(poolID)).Return(nil)
+
+				poolClient.UpdatePool(pools.NewUpdatePoolParams().WithPoolID(poolID).WithBody(params.UpdatePoolParams{
+					RunnerPrefix: params.RunnerPrefix{
+						Prefix: "",
+					},
+					MaxRunners:             ptr.To(uint(5)),
+					MinIdleRunners:         ptr.To(uint(0)),
+					Flavor:                 "medium",
+					OSType:                 "linux",
+					OSArch:                 "arm64",
+					Tags:                   []string{"kubernetes", "linux", "arm64", "ubuntu"},
+					Enabled:                ptr.To(false),
+					RunnerBootstrapTimeout: ptr.To(uint(20)),
+					ExtraSpecs:             json.RawMessage([]byte{}),
+					GitHubRunnerGroup:      ptr.To(""),
+				})).Return(&pools.UpdatePoolOK{Payload: params.Pool{
+					RunnerPrefix: params.RunnerPrefix{
+						Prefix: "",
+					},
+					ID:             poolID,
+					ProviderName:   "kubernetes_external",
+					MaxRunners:     1,
+					MinIdleRunners: 0,
+					Image:          "linux-ubuntu-22.04-arm64",
+					Flavor:         "medium",
+					OSType:         "linux",
+					OSArch:         "arm64",
+					Tags: []params.Tag{
+						{
+							ID:   "b3ea9882-a25c-4eb1-94ba-6c70b9abb6da",
+							Name: "kubernetes",
+						},
+						{
+							ID:   "b3ea9882-a25c-4eb1-94ba-6c70b9abb6db",
+							Name: "linux",
+						},
+						{
+							ID:   "b3ea9882-a25c-4eb1-94ba-6c70b9abb6dc",
+							Name: "arm64",
+						},
+						{
+							ID:   "b3ea9882-a25c-4eb1-94ba-6c70b9abb6dd",
+							Name: "ubuntu",
+						},
+					},
+					Enabled:        false,
+					Instances:      []params.Instance{},
+					RepoID:         "",
+					RepoName:       "",
+					OrgID:          "",
+					OrgName:        "",
+					EnterpriseID:   enterpriseID,
+					EnterpriseName: enterpriseName,
+				}}, nil)
+			},
+		},
+		{
+			name: "delete pool - draining active runners",
+			object: &garmoperatorv1beta1.Pool{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       "Pool",
+					APIVersion: garmoperatorv1beta1.GroupVersion.Group + "/" + garmoperatorv1beta1.GroupVersion.Version,
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "my-enterprise-pool",
+					Namespace: namespaceName,
+					Finalizers: []string{
+						key.PoolFinalizerName,
+					},
+				},
+				Spec: garmoperatorv1beta1.PoolSpec{
+					GitHubScopeRef: corev1.TypedLocalObjectReference{
+						APIGroup: &garmoperatorv1beta1.GroupVersion.Group,
+						Kind:     string(garmoperatorv1beta1.EnterpriseScope),
+						Name:     enterpriseName,
+					},
+					ProviderName:           "kubernetes_external",
+					MaxRunners:             5,
+					MinIdleRunners:         3,
+					ImageName:              "ubuntu-image",
+					Flavor:                 "medium",
+					OSType:                 "linux",
+					OSArch:                 "arm64",
+					Tags:                   []string{"kubernetes", "linux", "arm64", "ubuntu"},
+					Enabled:                true,
+					RunnerBootstrapTimeout: 20,
+					DrainTimeout:           &metav1.Duration{Duration: time.Hour},
+				},
+				Status: garmoperatorv1beta1.PoolStatus{
+					ID: poolID,
+				},
+			},
+			expectedObject: &garmoperatorv1beta1.Pool{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       "Pool",
+					APIVersion: garmoperatorv1beta1.GroupVersion.Group + "/" + garmoperatorv1beta1.GroupVersion.Version,
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "my-enterprise-pool",
+					Namespace: namespaceName,
+					Finalizers: []string{
+						key.PoolFinalizerName,
+					},
+				},
+				Spec: garmoperatorv1beta1.PoolSpec{
+					GitHubScopeRef: corev1.TypedLocalObjectReference{
+						Kind: string(garmoperatorv1beta1.EnterpriseScope),
+						Name: enterpriseName,
+					},
+					ProviderName:           "kubernetes_external",
+					MaxRunners:             5,
+					MinIdleRunners:         0,
+					ImageName:              "ubuntu-image",
+					Flavor:                 "medium",
+					OSType:                 "linux",
+					OSArch:                 "arm64",
+					Tags:                   []string{"kubernetes", "linux", "arm64", "ubuntu"},
+					Enabled:                false,
+					RunnerBootstrapTimeout: 20,
+					DrainTimeout:           &metav1.Duration{Duration: time.Hour},
+				},
+				Status: garmoperatorv1beta1.PoolStatus{
+					ID:                     poolID,
+					LongRunningIdleRunners: 1,
+					Conditions: []metav1.Condition{
+						{
+							Type:               string(conditions.ReadyCondition),
+							Status:             metav1.ConditionFalse,
+							LastTransitionTime: metav1.NewTime(time.Now()),
+							Reason:             string(conditions.DeletingReason),
+							Message:            conditions.DeletingPoolMsg,
+						},
+						{
+							Type:               string(conditions.Draining),
+							Status:             metav1.ConditionTrue,
+							LastTransitionTime: metav1.NewTime(time.Now()),
+							Reason:             string(conditions.DrainingRunnersReason),
+							Message:            "2 runners left, 1 of them still running a job",
+						},
+					},
+				},
+			},
+			expectGarmRequest: func(m *mock.MockPoolClientMockRecorder, instanceClient *mock.MockInstanceClientMockRecorder) {
+				maxRunners := uint(5)
+				minIdleRunners := uint(0)
+				enabled := false
+				runnerBootstrapTimeout := uint(20)
+				gitHubRunnerGroup := ""
+
+				m.UpdatePool(pools.NewUpdatePoolParams().
+					WithPoolID(poolID).
+					WithBody(params.UpdatePoolParams{
+						MaxRunners:             &maxRunners,
+						MinIdleRunners:         &minIdleRunners,
+						Flavor:                 "medium",
+						OSType:                 "linux",
+						OSArch:                 "arm64",
+						Tags:                   []string{"kubernetes", "linux", "arm64", "ubuntu"},
+						Enabled:                &enabled,
+						RunnerBootstrapTimeout: &runnerBootstrapTimeout,
+						ExtraSpecs:             json.RawMessage([]byte{}),
+						GitHubRunnerGroup:      &gitHubRunnerGroup,
+					})).Return(&pools.UpdatePoolOK{
+					Payload: params.Pool{
+						ID:           poolID,
+						ProviderName: "kubernetes_external",
+						Enabled:      false,
+					},
+				}, nil)
+
+				instanceClient.ListPoolInstances(
+					instances.NewListPoolInstancesParams().
+						WithPoolID(poolID)).
+					Return(&instances.ListPoolInstancesOK{
+						Payload: params.Instances{
+							{
+								Name:         "road-runner-idle",
+								PoolID:       poolID,
+								Status:       garmProviderParams.InstanceRunning,
+								RunnerStatus: params.RunnerIdle,
+							},
+							{
+								Name:         "road-runner-active",
+								PoolID:       poolID,
+								Status:       garmProviderParams.InstanceRunning,
+								RunnerStatus: params.RunnerActive,
+							},
+						},
+					}, nil)
+
+				instanceClient.DeleteInstance(instances.NewDeleteInstanceParams().
+					WithInstanceName("road-runner-idle")).
+					Return(nil)
+			},
+		},
+		{
+			name: "delete pool - drain timeout exceeded",
+			object: &garmoperatorv1beta1.Pool{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       "Pool",
+					APIVersion: garmoperatorv1beta1.GroupVersion.Group + "/" + garmoperatorv1beta1.GroupVersion.Version,
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "my-enterprise-pool",
+					Namespace: namespaceName,
+					Finalizers: []string{
+						key.PoolFinalizerName,
+					},
+				},
+				Spec: garmoperatorv1beta1.PoolSpec{
+					GitHubScopeRef: corev1.TypedLocalObjectReference{
+						APIGroup: &garmoperatorv1beta1.GroupVersion.Group,
+						Kind:     string(garmoperatorv1beta1.EnterpriseScope),
+						Name:     enterpriseName,
+					},
+					ProviderName:           "kubernetes_external",
+					MaxRunners:             5,
+					MinIdleRunners:         3,
+					ImageName:              "ubuntu-image",
+					Flavor:                 "medium",
+					OSType:                 "linux",
+					OSArch:                 "arm64",
+					Tags:                   []string{"kubernetes", "linux", "arm64", "ubuntu"},
+					Enabled:                true,
+					RunnerBootstrapTimeout: 20,
+					DrainTimeout:           &metav1.Duration{},
+				},
+				Status: garmoperatorv1beta1.PoolStatus{
+					ID: poolID,
+				},
+			},
+			expectedObject: &garmoperatorv1beta1.Pool{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       "Pool",
+					APIVersion: garmoperatorv1beta1.GroupVersion.Group + "/" + garmoperatorv1beta1.GroupVersion.Version,
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "my-enterprise-pool",
+					Namespace: namespaceName,
+					Finalizers: []string{
+						key.PoolFinalizerName,
+					},
+				},
+				Spec: garmoperatorv1beta1.PoolSpec{
+					GitHubScopeRef: corev1.TypedLocalObjectReference{
+						Kind: string(garmoperatorv1beta1.EnterpriseScope),
+						Name: enterpriseName,
+					},
+					ProviderName:           "kubernetes_external",
+					MaxRunners:             5,
+					MinIdleRunners:         0,
+					ImageName:              "ubuntu-image",
+					Flavor:                 "medium",
+					OSType:                 "linux",
+					OSArch:                 "arm64",
+					Tags:                   []string{"kubernetes", "linux", "arm64", "ubuntu"},
+					Enabled:                false,
+					RunnerBootstrapTimeout: 20,
+					DrainTimeout:           &metav1.Duration{},
+				},
+				Status: garmoperatorv1beta1.PoolStatus{
+					ID:                     poolID,
+					LongRunningIdleRunners: 0,
+					Conditions: []metav1.Condition{
+						{
+							Type:               string(conditions.ReadyCondition),
+							Status:             metav1.ConditionFalse,
+							LastTransitionTime: metav1.NewTime(time.Now()),
+							Reason:             string(conditions.DeletingReason),
+							Message:            conditions.DeletingPoolMsg,
+						},
+						{
+							Type:               string(conditions.Draining),
+							Status:             metav1.ConditionTrue,
+							LastTransitionTime: metav1.NewTime(time.Now()),
+							Reason:             string(conditions.DrainTimeoutExceededReason),
+							Message:            "drain timeout exceeded, 1 runners left, 1 of them still running a job",
+						},
+					},
+				},
+			},
+			expectGarmRequest: func(m *mock.MockPoolClientMockRecorder, instanceClient *mock.MockInstanceClientMockRecorder) {
+				maxRunners := uint(5)
+				minIdleRunners := uint(0)
+				enabled := false
+				runnerBootstrapTimeout := uint(20)
+				gitHubRunnerGroup := ""
+
+				m.UpdatePool(pools.NewUpdatePoolParams().
+					WithPoolID(poolID).
+					WithBody(params.UpdatePoolParams{
+						MaxRunners:             &maxRunners,
+						MinIdleRunners:         &minIdleRunners,
+						Flavor:                 "medium",
+						OSType:                 "linux",
+						OSArch:                 "arm64",
+						Tags:                   []string{"kubernetes", "linux", "arm64", "ubuntu"},
+						Enabled:                &enabled,
+						RunnerBootstrapTimeout: &runnerBootstrapTimeout,
+						ExtraSpecs:             json.RawMessage([]byte{}),
+						GitHubRunnerGroup:      &gitHubRunnerGroup,
+					})).Return(&pools.UpdatePoolOK{
+					Payload: params.Pool{
+						ID:           poolID,
+						ProviderName: "kubernetes_external",
+						Enabled:      false,
+					},
+				}, nil)
+
+				instanceClient.ListPoolInstances(
+					instances.NewListPoolInstancesParams().
+						WithPoolID(poolID)).
+					Return(&instances.ListPoolInstancesOK{
+						Payload: params.Instances{
+							{
+								Name:         "road-runner-active",
+								PoolID:       poolID,
+								Status:       garmProviderParams.InstanceRunning,
+								RunnerStatus: params.RunnerActive,
+							},
+						},
+					}, nil)
+			},
+		},
+		{
+			name: "delete pool - force deleting runners after drain timeout",
+			object: &garmoperatorv1beta1.Pool{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       "Pool",
+					APIVersion: garmoperatorv1beta1.GroupVersion.Group + "/" + garmoperatorv1beta1.GroupVersion.Version,
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "my-enterprise-pool",
+					Namespace: namespaceName,
+					Finalizers: []string{
+						key.PoolFinalizerName,
+					},
+				},
+				Spec: garmoperatorv1beta1.PoolSpec{
+					GitHubScopeRef: corev1.TypedLocalObjectReference{
+						APIGroup: &garmoperatorv1beta1.GroupVersion.Group,
+						Kind:     string(garmoperatorv1beta1.EnterpriseScope),
+						Name:     enterpriseName,
+					},
+					ProviderName:                 "kubernetes_external",
+					MaxRunners:                   5,
+					MinIdleRunners:               3,
+					ImageName:                    "ubuntu-image",
+					Flavor:                       "medium",
+					OSType:                       "linux",
+					OSArch:                       "arm64",
+					Tags:                         []string{"kubernetes", "linux", "arm64", "ubuntu"},
+					Enabled:                      true,
+					RunnerBootstrapTimeout:       20,
+					DrainTimeout:                 &metav1.Duration{},
+					ForceDeleteAfterDrainTimeout: true,
+				},
+				Status: garmoperatorv1beta1.PoolStatus{
+					ID: poolID,
+				},
+			},
+			expectedObject: &garmoperatorv1beta1.Pool{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       "Pool",
+					APIVersion: garmoperatorv1beta1.GroupVersion.Group + "/" + garmoperatorv1beta1.GroupVersion.Version,
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "my-enterprise-pool",
+					Namespace: namespaceName,
+					Finalizers: []string{
+						key.PoolFinalizerName,
+					},
+				},
+				Spec: garmoperatorv1beta1.PoolSpec{
+					GitHubScopeRef: corev1.TypedLocalObjectReference{
+						Kind: string(garmoperatorv1beta1.EnterpriseScope),
+						Name: enterpriseName,
+					},
+					ProviderName:                 "kubernetes_external",
+					MaxRunners:                   5,
+					MinIdleRunners:               0,
+					ImageName:                    "ubuntu-image",
+					Flavor:                       "medium",
+					OSType:                       "linux",
+					OSArch:                       "arm64",
+					Tags:                         []string{"kubernetes", "linux", "arm64", "ubuntu"},
+					Enabled:                      false,
+					RunnerBootstrapTimeout:       20,
+					DrainTimeout:                 &metav1.Duration{},
+					ForceDeleteAfterDrainTimeout: true,
+				},
+				Status: garmoperatorv1beta1.PoolStatus{
+					ID:                     poolID,
+					LongRunningIdleRunners: 1,
+					Conditions: []metav1.Condition{
+						{
+							Type:               string(conditions.ReadyCondition),
+							Status:             metav1.ConditionFalse,
+							LastTransitionTime: metav1.NewTime(time.Now()),
+							Reason:             string(conditions.DeletingReason),
+							Message:            conditions.DeletingPoolMsg,
+						},
+						{
+							Type:               string(conditions.Draining),
+							Status:             metav1.ConditionTrue,
+							LastTransitionTime: metav1.NewTime(time.Now()),
+							Reason:             string(conditions.DrainTimeoutExceededReason),
+							Message:            "drain timeout exceeded, force deleting 1 runners",
+						},
+					},
+				},
+			},
+			expectGarmRequest: func(m *mock.MockPoolClientMockRecorder, instanceClient *mock.MockInstanceClientMockRecorder) {
+				maxRunners := uint(5)
+				minIdleRunners := uint(0)
+				enabled := false
+				runnerBootstrapTimeout := uint(20)
+				gitHubRunnerGroup := ""
+
+				m.UpdatePool(pools.NewUpdatePoolParams().
+					WithPoolID(poolID).
+					WithBody(params.UpdatePoolParams{
+						MaxRunners:             &maxRunners,
+						MinIdleRunners:         &minIdleRunners,
+						Flavor:                 "medium",
+						OSType:                 "linux",
+						OSArch:                 "arm64",
+						Tags:                   []string{"kubernetes", "linux", "arm64", "ubuntu"},
+						Enabled:                &enabled,
+						RunnerBootstrapTimeout: &runnerBootstrapTimeout,
+						ExtraSpecs:             json.RawMessage([]byte{}),
+						GitHubRunnerGroup:      &gitHubRunnerGroup,
+					})).Return(&pools.UpdatePoolOK{
+					Payload: params.Pool{
+						ID:           poolID,
+						ProviderName: "kubernetes_external",
+						Enabled:      false,
+					},
+				}, nil)
+
+				instanceClient.ListPoolInstances(
+					instances.NewListPoolInstancesParams().
+						WithPoolID(poolID)).
+					Return(&instances.ListPoolInstancesOK{
+						Payload: params.Instances{
+							{
+								Name:         "road-runner-active",
+								PoolID:       poolID,
+								Status:       garmProviderParams.InstanceRunning,
+								RunnerStatus: params.RunnerActive,
+							},
+						},
+					}, nil)
+
+				instanceClient.DeleteInstance(instances.NewDeleteInstanceParams().
+					WithInstanceName("road-runner-active").
+					WithForceRemove(ptr.To(true))).
+					Return(nil)
+			},
+		},
+		{
+			name: "delete pool - force deleting stuck runners after drain timeout",
+			object: &garmoperatorv1beta1.Pool{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       "Pool",
+					APIVersion: garmoperatorv1beta1.GroupVersion.Group + "/" + garmoperatorv1beta1.GroupVersion.Version,
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "my-enterprise-pool",
+					Namespace: namespaceName,
+					Finalizers: []string{
+						key.PoolFinalizerName,
+					},
+				},
+				Spec: garmoperatorv1beta1.PoolSpec{
+					GitHubScopeRef: corev1.TypedLocalObjectReference{
+						APIGroup: &garmoperatorv1beta1.GroupVersion.Group,
+						Kind:     string(garmoperatorv1beta1.EnterpriseScope),
+						Name:     enterpriseName,
+					},
+					ProviderName:                 "kubernetes_external",
+					MaxRunners:                   5,
+					MinIdleRunners:               3,
+					ImageName:                    "ubuntu-image",
+					Flavor:                       "medium",
+					OSType:                       "linux",
+					OSArch:                       "arm64",
+					Tags:                         []string{"kubernetes", "linux", "arm64", "ubuntu"},
+					Enabled:                      true,
+					RunnerBootstrapTimeout:       20,
+					DrainTimeout:                 &metav1.Duration{},
+					ForceDeleteAfterDrainTimeout: true,
+				},
+				Status: garmoperatorv1beta1.PoolStatus{
+					ID: poolID,
+				},
+			},
+			expectedObject: &garmoperatorv1beta1.Pool{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       "Pool",
+					APIVersion: garmoperatorv1beta1.GroupVersion.Group + "/" + garmoperatorv1beta1.GroupVersion.Version,
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "my-enterprise-pool",
+					Namespace: namespaceName,
+					Finalizers: []string{
+						key.PoolFinalizerName,
+					},
+				},
+				Spec: garmoperatorv1beta1.PoolSpec{
+					GitHubScopeRef: corev1.TypedLocalObjectReference{
+						Kind: string(garmoperatorv1beta1.EnterpriseScope),
+						Name: enterpriseName,
+					},
+					ProviderName:                 "kubernetes_external",
+					MaxRunners:                   5,
+					MinIdleRunners:               0,
+					ImageName:                    "ubuntu-image",
+					Flavor:                       "medium",
+					OSType:                       "linux",
+					OSArch:                       "arm64",
+					Tags:                         []string{"kubernetes", "linux", "arm64", "ubuntu"},
+					Enabled:                      false,
+					RunnerBootstrapTimeout:       20,
+					DrainTimeout:                 &metav1.Duration{},
+					ForceDeleteAfterDrainTimeout: true,
+				},
+				Status: garmoperatorv1beta1.PoolStatus{
+					ID:                     poolID,
+					LongRunningIdleRunners: 2,
+					Conditions: []metav1.Condition{
+						{
+							Type:               string(conditions.ReadyCondition),
+							Status:             metav1.ConditionFalse,
+							LastTransitionTime: metav1.NewTime(time.Now()),
+							Reason:             string(conditions.DeletingReason),
+							Message:            conditions.DeletingPoolMsg,
+						},
+						{
+							Type:               string(conditions.Draining),
+							Status:             metav1.ConditionTrue,
+							LastTransitionTime: metav1.NewTime(time.Now()),
+							Reason:             string(conditions.DrainTimeoutExceededReason),
+							Message:            "drain timeout exceeded, force deleting 2 runners",
+						},
+					},
+				},
+			},
+			expectGarmRequest: func(m *mock.MockPoolClientMockRecorder, instanceClient *mock.MockInstanceClientMockRecorder) {
+				maxRunners := uint(5)
+				minIdleRunners := uint(0)
+				enabled := false
+				runnerBootstrapTimeout := uint(20)
+				gitHubRunnerGroup := ""
+
+				m.UpdatePool(pools.NewUpdatePoolParams().
+					WithPoolID(poolID).
+					WithBody(params.UpdatePoolParams{
+						MaxRunners:             &maxRunners,
+						MinIdleRunners:         &minIdleRunners,
+						Flavor:                 "medium",
+						OSType:                 "linux",
+						OSArch:                 "arm64",
+						Tags:                   []string{"kubernetes", "linux", "arm64", "ubuntu"},
+						Enabled:                &enabled,
+						RunnerBootstrapTimeout: &runnerBootstrapTimeout,
+						ExtraSpecs:             json.RawMessage([]byte{}),
+						GitHubRunnerGroup:      &gitHubRunnerGroup,
+					})).Return(&pools.UpdatePoolOK{
+					Payload: params.Pool{
+						ID:           poolID,
+						ProviderName: "kubernetes_external",
+						Enabled:      false,
+					},
+				}, nil)
+
+				instanceClient.ListPoolInstances(
+					instances.NewListPoolInstancesParams().
+						WithPoolID(poolID)).
+					Return(&instances.ListPoolInstancesOK{
+						Payload: params.Instances{
+							{
+								Name:         "road-runner-pending-create",
+								PoolID:       poolID,
+								Status:       garmProviderParams.InstancePendingCreate,
+								RunnerStatus: params.RunnerPending,
+							},
+							{
+								Name:         "road-runner-pending-delete",
+								PoolID:       poolID,
+								Status:       garmProviderParams.InstancePendingDelete,
+								RunnerStatus: params.RunnerTerminated,
+							},
+						},
+					}, nil)
+
+				instanceClient.DeleteInstance(instances.NewDeleteInstanceParams().
+					WithInstanceName("road-runner-pending-create").
+					WithForceRemove(ptr.To(true))).
+					Return(nil)
+
+				instanceClient.DeleteInstance(instances.NewDeleteInstanceParams().
+					WithInstanceName("road-runner-pending-delete").
+					WithForceRemove(ptr.To(true))).
+					Return(nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schemeBuilder := runtime.SchemeBuilder{
+				garmoperatorv1beta1.AddToScheme,
+			}
+
+			err := schemeBuilder.AddToScheme(scheme.Scheme)
+			if err != nil {
+				t.Fatal(err)
+			}
+			runtimeObjects := []runtime.Object{tt.object}
+			runtimeObjects = append(runtimeObjects, tt.runtimeObjects...)
+			client := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithRuntimeObjects(runtimeObjects...).WithStatusSubresource(&garmoperatorv1beta1.Pool{}).Build()
+
+			// create a fake reconciler
+			reconciler := &PoolReconciler{
+				Client:   client,
+				Recorder: record.NewFakeRecorder(3),
+			}
+
+			pool := tt.object.DeepCopyObject().(*garmoperatorv1beta1.Pool)
+
+			mockPoolClient := mock.NewMockPoolClient(mockCtrl)
+			mockInstanceClient := mock.NewMockInstanceClient(mockCtrl)
+
+			tt.expectGarmRequest(mockPoolClient.EXPECT(), mockInstanceClient.EXPECT())
+
+			_, err = reconciler.reconcileDelete(context.Background(), mockPoolClient, pool, mockInstanceClient)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("PoolReconciler.reconcileDelete() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			// empty resource version to avoid comparison errors
+			pool.ResourceVersion = ""
+			pool.Spec.GitHubScopeRef.APIGroup = nil
+			conditions.NilLastTransitionTime(pool)
+			conditions.NilLastTransitionTime(tt.expectedObject)
+
+			if !reflect.DeepEqual(pool, tt.expectedObject) {
+				t.Errorf("PoolReconciler.reconcileNormal() \n got =  %#v \n want = %#v", pool, tt.expectedObject)
+			}
+		})
+	}
+}
+
+func TestPoolReconciler_reportDrift(t *testing.T) {
+	recorder := record.NewFakeRecorder(3)
+	reconciler := &PoolReconciler{
+		Recorder: recorder,
+	}
+	pool := &garmoperatorv1beta1.Pool{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "drifting-pool",
+			Namespace: namespaceName,
+		},
+	}
+	drifts := []poolUtil.FieldDrift{{Field: "max_runners", Expected: "5", Actual: "10"}}
+	reportOnlyDrifts := metrics.PoolDrifts.WithLabelValues(namespaceName, pool.Name, "max_runners", string(garmoperatorv1beta1.DriftPolicyReportOnly))
+
+	// a new drift is reported
+	reconciler.reportDrift(context.Background(), pool, garmoperatorv1beta1.DriftPolicyReportOnly, drifts)
+	assert.InDelta(t, 1, testutil.ToFloat64(reportOnlyDrifts), 0)
+	assert.Equal(t, []string{"max_runners"}, pool.Status.LastDriftedFields)
+	assert.Len(t, recorder.Events, 1)
+	<-recorder.Events
+
+	// the same drift is kept in garm and not reported again
+	reconciler.reportDrift(context.Background(), pool, garmoperatorv1beta1.DriftPolicyReportOnly, drifts)
+	assert.InDelta(t, 1, testutil.ToFloat64(reportOnlyDrifts), 0)
+	assert.Empty(t, recorder.Events)
+
+	// another field drifted
+	drifts = append(drifts, poolUtil.FieldDrift{Field: "flavor", Expected: `"medium"`, Actual: `"large"`})
+	reconciler.reportDrift(context.Background(), pool, garmoperatorv1beta1.DriftPolicyReportOnly, drifts)
+	assert.InDelta(t, 2, testutil.ToFloat64(reportOnlyDrifts), 0)
+	assert.Equal(t, []string{"max_runners", "flavor"}, pool.Status.LastDriftedFields)
+	assert.Len(t, recorder.Events, 1)
+	<-recorder.Events
+
+	// enforced drifts are overwritten in garm, so every drift is reported
+	enforceDrifts := metrics.PoolDrifts.WithLabelValues(namespaceName, pool.Name, "max_runners", string(garmoperatorv1beta1.DriftPolicyEnforce))
+	pool.Status.LastDriftedFields = []string{"max_runners"}
+	reconciler.reportDrift(context.Background(), pool, garmoperatorv1beta1.DriftPolicyEnforce, drifts[:1])
+	reconciler.reportDrift(context.Background(), pool, garmoperatorv1beta1.DriftPolicyEnforce, drifts[:1])
+	assert.InDelta(t, 2, testutil.ToFloat64(enforceDrifts), 0)
+	assert.Len(t, recorder.Events, 2)
+}
+
+func TestDeletePoolMetrics(t *testing.T) {
+	pool := &garmoperatorv1beta1.Pool{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "deleted-pool",
+			Namespace: namespaceName,
+		},
+	}
+
+	metrics.PoolRunners.WithLabelValues(namespaceName, pool.Name, "Organization", "my-org", "kubernetes_external", "idle").Set(1)
+	metrics.PoolDrifts.WithLabelValues(namespaceName, pool.Name, "max_runners", string(garmoperatorv1beta1.DriftPolicyEnforce)).Inc()
+	metrics.RunnersReaped.WithLabelValues(namespaceName, pool.Name, metrics.ReapReasonScaleDown).Inc()
+	metrics.RunnerBootstrapDuration.WithLabelValues(namespaceName, pool.Name, "kubernetes_external").Observe(60)
+	metrics.RunnerIdleDuration.WithLabelValues(namespaceName, pool.Name, "kubernetes_external").Observe(60)
+
+	deletePoolMetrics(pool)
+
+	// the series are already gone
+	assert.False(t, metrics.PoolRunners.DeleteLabelValues(namespaceName, pool.Name, "Organization", "my-org", "kubernetes_external", "idle"))
+	assert.False(t, metrics.PoolDrifts.DeleteLabelValues(namespaceName, pool.Name, "max_runners", string(garmoperatorv1beta1.DriftPolicyEnforce)))
+	assert.False(t, metrics.RunnersReaped.DeleteLabelValues(namespaceName, pool.Name, metrics.ReapReasonScaleDown))
+	assert.False(t, metrics.RunnerBootstrapDuration.DeleteLabelValues(namespaceName, pool.Name, "kubernetes_external"))
+	assert.False(t, metrics.RunnerIdleDuration.DeleteLabelValues(namespaceName, pool.Name, "kubernetes_external"))
+}
+
+func TestSetPoolRunnersMetric(t *testing.T) {
+	pool := &garmoperatorv1beta1.Pool{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "moved-pool",
+			Namespace: namespaceName,
+		},
+		Spec: garmoperatorv1beta1.PoolSpec{
+			GitHubScopeRef: corev1.TypedLocalObjectReference{
+				Kind: string(garmoperatorv1beta1.OrganizationScope),
+				Name: "my-org",
+			},
+			ProviderName: "kubernetes_external",
+		},
+		Status: garmoperatorv1beta1.PoolStatus{
+			IdleRunners: 2,
+		},
+	}
+	defer deletePoolMetrics(pool)
+
+	setPoolRunnersMetric(pool)
+
+	pool.Spec.GitHubScopeRef.Name = "my-other-org"
+	pool.Spec.ProviderName = "openstack"
+	setPoolRunnersMetric(pool)
+
+	// the series with the previous scope and provider are gone
+	assert.False(t, metrics.PoolRunners.DeleteLabelValues(namespaceName, pool.Name, "Organization", "my-org", "kubernetes_external", "idle"))
+	assert.Equal(t, float64(2), testutil.ToFloat64(metrics.PoolRunners.WithLabelValues(namespaceName, pool.Name, "Organization", "my-other-org", "openstack", "idle")))
+}
+
+func TestPoolReconciler_reconcileUpdate(t *testing.T) {
+	poolID := "fb2bceeb-f74d-435d-9648-626c75cb23ce"
+	enterpriseID := "93068607-2d0d-4b76-a950-0e40d31955b8"
+	enterpriseName := "test-enterprise"
+
+	lastScaleDown := metav1.NewTime(time.Now().Add(-5 * time.Minute))
+
+	runner := func(name string, status garmProviderParams.InstanceStatus, runnerStatus params.RunnerStatus, age time.Duration) params.Instance {
+		return params.Instance{
+			Name:         name,
+			PoolID:       poolID,
+			Status:       status,
+			RunnerStatus: runnerStatus,
+			UpdatedAt:    time.Now().Add(-age),
+		}
+	}
+	deleteRunner := func(name string) *instances.DeleteInstanceParams {
+		return instances.NewDeleteInstanceParams().WithInstanceName(name)
+	}
+
+	tests := []struct {
+		name string
+		// pool is the spec and status of the pool, which is in sync with the pool in garm
+		pool func(pool *garmoperatorv1beta1.Pool)
+		// runners of the pool in garm
+		runners []params.Instance
+		// runners whose RunnerCR is annotated with key.DoNotReapAnnotation
+		protectedRunners  []string
+		expectGarmRequest func(poolClient *mock.MockPoolClientMockRecorder, instanceClient *mock.MockInstanceClientMockRecorder)
+		wantRequeue       bool
+		// wantScaleDown is true if the last scale down time is expected to be updated
+		wantScaleDown       bool
+		wantScaleDownReason string
+		// wantRecycled is true if the last recycle time is expected to be updated
+		wantRecycled          bool
+		wantRecycleSurge      uint
+		wantRemediatedRunners uint
+		// wantDegradedReason is the reason of the RunnersDegraded condition, empty if the condition isn't expected
+		wantDegradedReason conditions.ConditionReason
+	}{
+		{
+			name: "scale down with step size - expect deletion of step size runners",
+			pool: func(pool *garmoperatorv1beta1.Pool) {
+				pool.Spec.ScaleDown = &garmoperatorv1beta1.ScaleDownPolicy{StepSize: 2}
+			},
+			runners: []params.Instance{
+				runner("kube-runner-1", garmProviderParams.InstanceRunning, params.RunnerIdle, time.Hour),
+				runner("kube-runner-2", garmProviderParams.InstanceRunning, params.RunnerIdle, time.Hour),
+				runner("kube-runner-3", garmProviderParams.InstanceRunning, params.RunnerIdle, time.Hour),
+				runner("kube-runner-4", garmProviderParams.InstanceRunning, params.RunnerIdle, time.Hour),
+			},
+			expectGarmRequest: func(_ *mock.MockPoolClientMockRecorder, instanceClient *mock.MockInstanceClientMockRecorder) {
+				instanceClient.DeleteInstance(deleteRunner("kube-runner-1")).Return(nil)
+				instanceClient.DeleteInstance(deleteRunner("kube-runner-2")).Return(nil)
+			},
+			wantRequeue:         true,
+			wantScaleDown:       true,
+			wantScaleDownReason: "scale long running idle runners down to 1",
+		},
+		{
+			name: "scale down within cooldown - expect no deletion",
+			pool: func(pool *garmoperatorv1beta1.Pool) {
+				pool.Spec.ScaleDown = &garmoperatorv1beta1.ScaleDownPolicy{Cooldown: &metav1.Duration{Duration: 10 * time.Minute}}
+				pool.Status.LastScaleDownTime = lastScaleDown.DeepCopy()
+				pool.Status.LastScaleDownReason = "scale long running idle runners down to 1"
+			},
+			runners: []params.Instance{
+				runner("kube-runner-1", garmProviderParams.InstanceRunning, params.RunnerIdle, time.Hour),
+				runner("kube-runner-2", garmProviderParams.InstanceRunning, params.RunnerIdle, time.Hour),
+			},
+			expectGarmRequest:   func(_ *mock.MockPoolClientMockRecorder, _ *mock.MockInstanceClientMockRecorder) {},
+			wantRequeue:         true,
+			wantScaleDownReason: "scale long running idle runners down to 1",
+		},
+		{
+			name: "scale down after cooldown - expect deletion",
+			pool: func(pool *garmoperatorv1beta1.Pool) {
+				pool.Spec.ScaleDown = &garmoperatorv1beta1.ScaleDownPolicy{Cooldown: &metav1.Duration{Duration: 2 * time.Minute}}
+				pool.Status.LastScaleDownTime = lastScaleDown.DeepCopy()
+				pool.Status.LastScaleDownReason = "scale long running idle runners down to 1"
+			},
+			runners: []params.Instance{
+				runner("kube-runner-1", garmProviderParams.InstanceRunning, params.RunnerIdle, time.Hour),
+				runner("kube-runner-2", garmProviderParams.InstanceRunning, params.RunnerIdle, time.Hour),
+			},
+			expectGarmRequest: func(_ *mock.MockPoolClientMockRecorder, instanceClient *mock.MockInstanceClientMockRecorder) {
+				instanceClient.DeleteInstance(deleteRunner("kube-runner-1")).Return(nil)
+			},
+			wantScaleDown:       true,
+			wantScaleDownReason: "scale long running idle runners down to 1",
+		},
+		{
+			name: "failed scale down - expect no cooldown",
+			pool: func(pool *garmoperatorv1beta1.Pool) {
+				pool.Spec.ScaleDown = &garmoperatorv1beta1.ScaleDownPolicy{Cooldown: &metav1.Duration{Duration: 10 * time.Minute}}
+			},
+			runners: []params.Instance{
+				runner("kube-runner-1", garmProviderParams.InstanceRunning, params.RunnerIdle, time.Hour),
+				runner("kube-runner-2", garmProviderParams.InstanceRunning, params.RunnerIdle, time.Hour),
+			},
+			expectGarmRequest: func(_ *mock.MockPoolClientMockRecorder, instanceClient *mock.MockInstanceClientMockRecorder) {
+				instanceClient.DeleteInstance(deleteRunner("kube-runner-1")).Return(errors.New("garm unavailable"))
+			},
+		},
+		{
+			name: "recycle runner which exceeds the max idle age",
+			pool: func(pool *garmoperatorv1beta1.Pool) {
+				pool.Spec.RunnerLifecycle = &garmoperatorv1beta1.RunnerLifecycle{MaxIdleAge: &metav1.Duration{Duration: time.Hour}}
+			},
+			runners: []params.Instance{
+				runner("kube-runner-1", garmProviderParams.InstanceRunning, params.RunnerIdle, 2*time.Hour),
+				runner("kube-runner-2", garmProviderParams.InstanceRunning, params.RunnerIdle, 5*time.Minute),
+			},
+			expectGarmRequest: func(_ *mock.MockPoolClientMockRecorder, instanceClient *mock.MockInstanceClientMockRecorder) {
+				instanceClient.DeleteInstance(deleteRunner("kube-runner-1")).Return(nil)
+			},
+			wantRequeue:  true,
+			wantRecycled: true,
+		},
+		{
+			name: "recycle runner at min idle runners - expect surge instead of deletion",
+			pool: func(pool *garmoperatorv1beta1.Pool) {
+				pool.Spec.RunnerLifecycle = &garmoperatorv1beta1.RunnerLifecycle{MaxIdleAge: &metav1.Duration{Duration: time.Hour}}
+			},
+			runners: []params.Instance{
+				runner("kube-runner-1", garmProviderParams.InstanceRunning, params.RunnerIdle, 2*time.Hour),
+			},
+			expectGarmRequest: func(poolClient *mock.MockPoolClientMockRecorder, _ *mock.MockInstanceClientMockRecorder) {
+				minIdleRunners := uint(2)
+				poolClient.UpdatePool(pools.NewUpdatePoolParams().WithPoolID(poolID).WithBody(params.UpdatePoolParams{MinIdleRunners: &minIdleRunners})).Return(&pools.UpdatePoolOK{}, nil)
+			},
+			wantRequeue:      true,
+			wantRecycleSurge: 1,
+		},
+		{
+			name: "recycle runner with surged idle runners - expect deletion and keep surge",
+			pool: func(pool *garmoperatorv1beta1.Pool) {
+				pool.Spec.RunnerLifecycle = &garmoperatorv1beta1.RunnerLifecycle{MaxIdleAge: &metav1.Duration{Duration: time.Hour}}
+				pool.Status.RecycleSurge = 1
+			},
+			runners: []params.Instance{
+				runner("kube-runner-1", garmProviderParams.InstanceRunning, params.RunnerIdle, 2*time.Hour),
+				runner("kube-runner-2", garmProviderParams.InstanceRunning, params.RunnerIdle, 5*time.Minute),
+			},
+			expectGarmRequest: func(_ *mock.MockPoolClientMockRecorder, instanceClient *mock.MockInstanceClientMockRecorder) {
+				instanceClient.DeleteInstance(deleteRunner("kube-runner-1")).Return(nil)
+			},
+			wantRequeue:      true,
+			wantRecycled:     true,
+			wantRecycleSurge: 1,
+		},
+		{
+			name: "all runners are recycled - expect surge reset",
+			pool: func(pool *garmoperatorv1beta1.Pool) {
+				pool.Spec.RunnerLifecycle = &garmoperatorv1beta1.RunnerLifecycle{MaxIdleAge: &metav1.Duration{Duration: time.Hour}}
+				pool.Status.RecycleSurge = 1
+			},
+			runners: []params.Instance{
+				runner("kube-runner-1", garmProviderParams.InstanceRunning, params.RunnerIdle, 5*time.Minute),
+				runner("kube-runner-2", garmProviderParams.InstanceRunning, params.RunnerIdle, 5*time.Minute),
+			},
+			expectGarmRequest: func(poolClient *mock.MockPoolClientMockRecorder, _ *mock.MockInstanceClientMockRecorder) {
+				minIdleRunners := uint(1)
+				poolClient.UpdatePool(pools.NewUpdatePoolParams().WithPoolID(poolID).WithBody(params.UpdatePoolParams{MinIdleRunners: &minIdleRunners})).Return(&pools.UpdatePoolOK{}, nil)
+			},
+		},
+		{
+			name: "recycle runner while replacements are pending - expect no deletion and no surge",
+			pool: func(pool *garmoperatorv1beta1.Pool) {
+				pool.Spec.RunnerLifecycle = &garmoperatorv1beta1.RunnerLifecycle{MaxIdleAge: &metav1.Duration{Duration: time.Hour}}
+			},
+			runners: []params.Instance{
+				runner("kube-runner-1", garmProviderParams.InstanceRunning, params.RunnerIdle, 2*time.Hour),
+				runner("kube-runner-2", garmProviderParams.InstancePendingCreate, params.RunnerPending, time.Minute),
+			},
+			expectGarmRequest: func(_ *mock.MockPoolClientMockRecorder, _ *mock.MockInstanceClientMockRecorder) {},
+			wantRequeue:       true,
+		},
+		{
+			name: "recycle runners - expect active and protected runners to be skipped",
+			pool: func(pool *garmoperatorv1beta1.Pool) {
+				pool.Spec.MinIdleRunners = 2
+				pool.Spec.RunnerLifecycle = &garmoperatorv1beta1.RunnerLifecycle{MaxIdleAge: &metav1.Duration{Duration: time.Hour}}
+			},
+			runners: []params.Instance{
+				runner("kube-runner-1", garmProviderParams.InstanceRunning, params.RunnerActive, 3*time.Hour),
+				runner("kube-runner-2", garmProviderParams.InstanceRunning, params.RunnerIdle, 3*time.Hour),
+				runner("kube-runner-3", garmProviderParams.InstanceRunning, params.RunnerIdle, 2*time.Hour),
+				runner("kube-runner-4", garmProviderParams.InstanceRunning, params.RunnerIdle, 5*time.Minute),
+			},
+			protectedRunners: []string{"kube-runner-2"},
+			expectGarmRequest: func(_ *mock.MockPoolClientMockRecorder, instanceClient *mock.MockInstanceClientMockRecorder) {
+				instanceClient.DeleteInstance(deleteRunner("kube-runner-3")).Return(nil)
+			},
+			wantRequeue:  true,
+			wantRecycled: true,
+		},
+		{
+			name: "recycle protected runner - expect no deletion and no surge",
+			pool: func(pool *garmoperatorv1beta1.Pool) {
+				pool.Spec.RunnerLifecycle = &garmoperatorv1beta1.RunnerLifecycle{MaxIdleAge: &metav1.Duration{Duration: time.Hour}}
+			},
+			runners: []params.Instance{
+				runner("kube-runner-1", garmProviderParams.InstanceRunning, params.RunnerIdle, 2*time.Hour),
+				runner("kube-runner-2", garmProviderParams.InstanceRunning, params.RunnerIdle, 5*time.Minute),
+			},
+			protectedRunners:  []string{"kube-runner-1"},
+			expectGarmRequest: func(_ *mock.MockPoolClientMockRecorder, _ *mock.MockInstanceClientMockRecorder) {},
+		},
+		{
+			name: "remediate stuck runner - expect forced deletion",
+			pool: func(_ *garmoperatorv1beta1.Pool) {},
+			runners: []params.Instance{
+				runner("kube-runner-1", garmProviderParams.InstancePendingCreate, params.RunnerPending, time.Hour),
+			},
+			expectGarmRequest: func(_ *mock.MockPoolClientMockRecorder, instanceClient *mock.MockInstanceClientMockRecorder) {
+				instanceClient.DeleteInstance(deleteRunner("kube-runner-1").WithForceRemove(ptr.To(true))).Return(nil)
+			},
+			wantRemediatedRunners: 1,
+			wantDegradedReason:    conditions.StuckRunnersReason,
+		},
+		{
+			name: "remediate faulty runner - expect forced deletion",
+			pool: func(_ *garmoperatorv1beta1.Pool) {},
+			runners: []params.Instance{
+				func() params.Instance {
+					faultyRunner := runner("kube-runner-1", garmProviderParams.InstanceError, params.RunnerFailed, time.Minute)
+					faultyRunner.ProviderFault = []byte("quota exceeded")
+					return faultyRunner
+				}(),
+			},
+			expectGarmRequest: func(_ *mock.MockPoolClientMockRecorder, instanceClient *mock.MockInstanceClientMockRecorder) {
+				instanceClient.DeleteInstance(deleteRunner("kube-runner-1").WithForceRemove(ptr.To(true))).Return(nil)
+			},
+			wantRemediatedRunners: 1,
+			wantDegradedReason:    conditions.ProviderFaultReason,
+		},
+		{
+			name: "remediate stuck runners with exhausted retry budget - expect deletion within the budget only",
+			pool: func(pool *garmoperatorv1beta1.Pool) {
+				pool.Spec.RunnerRemediation = &garmoperatorv1beta1.RunnerRemediation{MaxRetries: ptr.To(uint(2))}
+				pool.Status.RemediationWindowStart = ptr.To(metav1.NewTime(time.Now().Add(-10 * time.Minute)))
+				pool.Status.RemediatedRunners = 1
+			},
+			runners: []params.Instance{
+				runner("kube-runner-1", garmProviderParams.InstancePendingCreate, params.RunnerPending, time.Hour),
+				runner("kube-runner-2", garmProviderParams.InstanceCreating, params.RunnerPending, time.Hour),
+			},
+			expectGarmRequest: func(_ *mock.MockPoolClientMockRecorder, instanceClient *mock.MockInstanceClientMockRecorder) {
+				instanceClient.DeleteInstance(deleteRunner("kube-runner-1").WithForceRemove(ptr.To(true))).Return(nil)
+			},
+			wantRemediatedRunners: 2,
+			wantDegradedReason:    conditions.RemediationBudgetExhaustedReason,
+		},
+		{
+			name: "remediate protected stuck runner - expect no deletion",
+			pool: func(_ *garmoperatorv1beta1.Pool) {},
+			runners: []params.Instance{
+				runner("kube-runner-1", garmProviderParams.InstancePendingCreate, params.RunnerPending, time.Hour),
+			},
+			protectedRunners:  []string{"kube-runner-1"},
+			expectGarmRequest: func(_ *mock.MockPoolClientMockRecorder, _ *mock.MockInstanceClientMockRecorder) {},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			schemeBuilder := runtime.SchemeBuilder{
+				garmoperatorv1beta1.AddToScheme,
+			}
+
+			err := schemeBuilder.AddToScheme(scheme.Scheme)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			pool := &garmoperatorv1beta1.Pool{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "my-enterprise-pool",
+					Namespace: namespaceName,
+				},
+				Spec: garmoperatorv1beta1.PoolSpec{
+					GitHubScopeRef: corev1.TypedLocalObjectReference{
+						APIGroup: &garmoperatorv1beta1.GroupVersion.Group,
+						Kind:     string(garmoperatorv1beta1.EnterpriseScope),
+						Name:     enterpriseName,
+					},
+					ProviderName:           "kubernetes_external",
+					MaxRunners:             10,
+					MinIdleRunners:         1,
+					ImageName:              "ubuntu-image",
+					Flavor:                 "medium",
+					OSType:                 "linux",
+					OSArch:                 "arm64",
+					Tags:                   []string{"kubernetes", "linux", "arm64", "ubuntu"},
+					Enabled:                true,
+					RunnerBootstrapTimeout: 20,
+				},
+				Status: garmoperatorv1beta1.PoolStatus{
+					ID: poolID,
+				},
+			}
+			tt.pool(pool)
+			initialPool := pool.DeepCopy()
+
+			runtimeObjects := []runtime.Object{
+				pool.DeepCopy(),
+				&garmoperatorv1beta1.Image{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "ubuntu-image",
+						Namespace: namespaceName,
+					},
+					Spec: garmoperatorv1beta1.ImageSpec{
+						Tag: "linux-ubuntu-22.04-arm64",
+					},
+				},
+				&garmoperatorv1beta1.Enterprise{
+					TypeMeta: metav1.TypeMeta{
+						Kind:       "Enterprise",
+						APIVersion: garmoperatorv1beta1.GroupVersion.Group + "/" + garmoperatorv1beta1.GroupVersion.Version,
+					},
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      enterpriseName,
+						Namespace: namespaceName,
+					},
+					Status: garmoperatorv1beta1.EnterpriseStatus{
+						ID: enterpriseID,
+					},
+				},
+			}
+			for _, name := range tt.protectedRunners {
+				runtimeObjects = append(runtimeObjects, &garmoperatorv1beta1.Runner{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:        name,
+						Namespace:   namespaceName,
+						Labels:      map[string]string{key.PoolLabel: pool.Name},
+						Annotations: map[string]string{key.DoNotReapAnnotation: "true"},
+					},
+				})
+			}
+			client := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithRuntimeObjects(runtimeObjects...).WithStatusSubresource(&garmoperatorv1beta1.Pool{}).Build()
+
+			reconciler := &PoolReconciler{
+				Client:   client,
+				Recorder: record.NewFakeRecorder(10),
+			}
+
+			config.Config.Operator.MinIdleRunnersAge = time.Duration(30) * time.Minute
+			config.Config.Operator.ScaleDownStepSize = 0
+			config.Config.Operator.ScaleDownCooldown = 0
+
+			mockPoolClient := mock.NewMockPoolClient(mockCtrl)
+			mockInstanceClient := mock.NewMockInstanceClient(mockCtrl)
+
+			garmTags, err := tags.CreateComparableRunnerTags(pool.Spec.Tags, pool.Spec.OSArch, pool.Spec.OSType)
+			if err != nil {
+				t.Fatal(err)
+			}
+			mockPoolClient.EXPECT().GetPool(pools.NewGetPoolParams().WithPoolID(poolID)).Return(&pools.GetPoolOK{Payload: params.Pool{
+				ID:                     poolID,
+				ProviderName:           "kubernetes_external",
+				MaxRunners:             pool.Spec.MaxRunners,
+				MinIdleRunners:         pool.GarmMinIdleRunners(),
+				Image:                  "linux-ubuntu-22.04-arm64",
+				Flavor:                 "medium",
+				OSType:                 "linux",
+				OSArch:                 "arm64",
+				Tags:                   garmTags,
+				Enabled:                true,
+				RunnerBootstrapTimeout: 20,
+				Instances:              tt.runners,
+				EnterpriseID:           enterpriseID,
+				EnterpriseName:         enterpriseName,
+			}}, nil)
+			tt.expectGarmRequest(mockPoolClient.EXPECT(), mockInstanceClient.EXPECT())
+
+			result, err := reconciler.reconcileUpdate(context.Background(), mockPoolClient, pool, mockInstanceClient)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantRequeue, result.RequeueAfter > 0)
+
+			if tt.wantScaleDown {
+				assert.WithinDuration(t, time.Now(), pool.Status.LastScaleDownTime.Time, time.Minute)
+			} else {
+				assert.Equal(t, initialPool.Status.LastScaleDownTime, pool.Status.LastScaleDownTime)
+			}
+			assert.Equal(t, tt.wantScaleDownReason, pool.Status.LastScaleDownReason)
+
+			if tt.wantRecycled {
+				assert.WithinDuration(t, time.Now(), pool.Status.LastRecycleTime.Time, time.Minute)
+			} else {
+				assert.Equal(t, initialPool.Status.LastRecycleTime, pool.Status.LastRecycleTime)
+			}
+			assert.Equal(t, tt.wantRecycleSurge, pool.Status.RecycleSurge)
+
+			assert.Equal(t, tt.wantRemediatedRunners, pool.Status.RemediatedRunners)
+			if degraded := conditions.Get(pool, conditions.RunnersDegraded); tt.wantDegradedReason == "" {
+				assert.Nil(t, degraded)
+			} else if assert.NotNil(t, degraded) {
+				assert.Equal(t, string(tt.wantDegradedReason), degraded.Reason)
 			}
 		})
 	}
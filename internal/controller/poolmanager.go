@@ -0,0 +1,80 @@
+// SPDX-License-Identifier: MIT
+
+package controller
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cloudbase/garm/params"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	garmoperatorv1beta1 "github.com/mercedes-benz/garm-operator/api/v1beta1"
+	"github.com/mercedes-benz/garm-operator/pkg/conditions"
+	"github.com/mercedes-benz/garm-operator/pkg/event"
+	"github.com/mercedes-benz/garm-operator/pkg/metrics"
+)
+
+const (
+	// poolManagerMinBackoff and poolManagerMaxBackoff bound the interval in which an enterprise,
+	// organization or repository is requeued as long as its pool manager is not running.
+	poolManagerMinBackoff = 10 * time.Second
+	poolManagerMaxBackoff = 5 * time.Minute
+)
+
+// poolManagerScope is an enterprise, organization or repository, which has a pool manager in garm.
+type poolManagerScope interface {
+	client.Object
+	conditions.ConditionStatusObject
+}
+
+// reconcilePoolManagerStatus reflects the status of the garm pool manager of scope in its conditions and in
+// the pool manager metric and records an event whenever the pool manager starts or stops running.
+// As long as the pool manager is not running, it returns the interval to requeue scope in. The interval grows
+// with the time the pool manager has been failing, so that a recovered pool manager is noticed without a resync.
+func reconcilePoolManagerStatus(recorder record.EventRecorder, scope poolManagerScope, kind garmoperatorv1beta1.GitHubScopeKind, status params.PoolManagerStatus) time.Duration {
+	previous := conditions.Get(scope, conditions.PoolManager)
+	wasFailing := previous != nil && previous.Status == metav1.ConditionFalse
+
+	up := metrics.PoolManagerUp.WithLabelValues(scope.GetNamespace(), string(kind), scope.GetName())
+
+	if status.IsRunning {
+		up.Set(1)
+		conditions.MarkTrue(scope, conditions.PoolManager, conditions.PoolManagerRunningReason, "")
+		if wasFailing {
+			event.PoolManagerRunning(recorder, scope, "pool manager is running again")
+		}
+		return 0
+	}
+
+	up.Set(0)
+	conditions.MarkFalse(scope, conditions.ReadyCondition, conditions.PoolManagerFailureReason, "Pool Manager is not running")
+	conditions.MarkFalse(scope, conditions.PoolManager, conditions.PoolManagerFailureReason, status.FailureReason)
+	if !wasFailing {
+		event.PoolManagerFailure(recorder, scope, fmt.Sprintf("pool manager is not running: %s", status.FailureReason))
+	}
+
+	failingSince := time.Now()
+	if wasFailing {
+		failingSince = previous.LastTransitionTime.Time
+	}
+	return min(max(time.Since(failingSince), poolManagerMinBackoff), poolManagerMaxBackoff)
+}
+
+// deletePoolManagerMetric removes the pool manager metric of a deleted enterprise, organization or repository.
+func deletePoolManagerMetric(scope client.Object, kind garmoperatorv1beta1.GitHubScopeKind) {
+	metrics.PoolManagerUp.DeleteLabelValues(scope.GetNamespace(), string(kind), scope.GetName())
+}
+
+// nextRequeue returns the shortest of the given requeue intervals which is greater than zero.
+func nextRequeue(intervals ...time.Duration) time.Duration {
+	var next time.Duration
+	for _, interval := range intervals {
+		if interval > 0 && (next == 0 || interval < next) {
+			next = interval
+		}
+	}
+	return next
+}
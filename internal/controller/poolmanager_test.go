@@ -0,0 +1,71 @@
+// SPDX-License-Identifier: MIT
+
+package controller
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cloudbase/garm/params"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+
+	garmoperatorv1beta1 "github.com/mercedes-benz/garm-operator/api/v1beta1"
+	"github.com/mercedes-benz/garm-operator/pkg/conditions"
+	"github.com/mercedes-benz/garm-operator/pkg/metrics"
+)
+
+func TestReconcilePoolManagerStatus(t *testing.T) {
+	recorder := record.NewFakeRecorder(3)
+	organization := &garmoperatorv1beta1.Organization{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "pool-manager-organization",
+			Namespace: "default",
+		},
+	}
+	organization.InitializeConditions()
+	up := metrics.PoolManagerUp.WithLabelValues("default", string(garmoperatorv1beta1.OrganizationScope), organization.Name)
+
+	// pool manager fails for the first time
+	retry := reconcilePoolManagerStatus(recorder, organization, garmoperatorv1beta1.OrganizationScope, params.PoolManagerStatus{FailureReason: "bad credentials"})
+	assert.Equal(t, poolManagerMinBackoff, retry)
+	assert.InDelta(t, 0, testutil.ToFloat64(up), 0)
+	assert.Equal(t, metav1.ConditionFalse, conditions.Get(organization, conditions.PoolManager).Status)
+	assert.Equal(t, metav1.ConditionFalse, conditions.Get(organization, conditions.ReadyCondition).Status)
+	assert.Equal(t, "Warning PoolManagerFailure pool manager is not running: bad credentials", <-recorder.Events)
+
+	// pool manager is still failing, the backoff grows with the time it has been failing
+	conditions.Get(organization, conditions.PoolManager).LastTransitionTime = metav1.NewTime(time.Now().Add(-time.Minute))
+	retry = reconcilePoolManagerStatus(recorder, organization, garmoperatorv1beta1.OrganizationScope, params.PoolManagerStatus{FailureReason: "bad credentials"})
+	assert.InDelta(t, time.Minute.Seconds(), retry.Seconds(), 5)
+
+	conditions.Get(organization, conditions.PoolManager).LastTransitionTime = metav1.NewTime(time.Now().Add(-time.Hour))
+	retry = reconcilePoolManagerStatus(recorder, organization, garmoperatorv1beta1.OrganizationScope, params.PoolManagerStatus{FailureReason: "bad credentials"})
+	assert.Equal(t, poolManagerMaxBackoff, retry)
+	assert.Empty(t, recorder.Events)
+
+	// pool manager recovered
+	retry = reconcilePoolManagerStatus(recorder, organization, garmoperatorv1beta1.OrganizationScope, params.PoolManagerStatus{IsRunning: true})
+	assert.Zero(t, retry)
+	assert.InDelta(t, 1, testutil.ToFloat64(up), 0)
+	assert.Equal(t, metav1.ConditionTrue, conditions.Get(organization, conditions.PoolManager).Status)
+	assert.Equal(t, "Normal PoolManagerRunning pool manager is running again", <-recorder.Events)
+
+	// pool manager keeps running
+	retry = reconcilePoolManagerStatus(recorder, organization, garmoperatorv1beta1.OrganizationScope, params.PoolManagerStatus{IsRunning: true})
+	assert.Zero(t, retry)
+	assert.Empty(t, recorder.Events)
+
+	deletePoolManagerMetric(organization, garmoperatorv1beta1.OrganizationScope)
+	// the metric is already gone
+	assert.False(t, metrics.PoolManagerUp.DeleteLabelValues("default", string(garmoperatorv1beta1.OrganizationScope), organization.Name))
+}
+
+func TestNextRequeue(t *testing.T) {
+	assert.Zero(t, nextRequeue())
+	assert.Zero(t, nextRequeue(0, 0))
+	assert.Equal(t, time.Minute, nextRequeue(0, time.Minute))
+	assert.Equal(t, 10*time.Second, nextRequeue(time.Minute, 0, 10*time.Second))
+}
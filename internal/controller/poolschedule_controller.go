@@ -0,0 +1,360 @@
+// SPDX-License-Identifier: MIT
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	garmoperatorv1beta1 "github.com/mercedes-benz/garm-operator/api/v1beta1"
+	"github.com/mercedes-benz/garm-operator/pkg/annotations"
+	"github.com/mercedes-benz/garm-operator/pkg/conditions"
+	"github.com/mercedes-benz/garm-operator/pkg/event"
+	"github.com/mercedes-benz/garm-operator/pkg/schedule"
+)
+
+// poolKind is the kind a HorizontalPodAutoscaler uses in its scaleTargetRef to scale a pool
+const poolKind = "Pool"
+
+const holidayLayout = "2006-01-02"
+
+// PoolScheduleReconciler reconciles a PoolSchedule object
+type PoolScheduleReconciler struct {
+	client.Client
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
+}
+
+//+kubebuilder:rbac:groups=core,resources=events,verbs=create;patch
+//+kubebuilder:rbac:groups=garm-operator.mercedes-benz.com,namespace=xxxxx,resources=poolschedules,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=garm-operator.mercedes-benz.com,namespace=xxxxx,resources=poolschedules/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=garm-operator.mercedes-benz.com,namespace=xxxxx,resources=pools,verbs=get;list;watch;update;patch
+//+kubebuilder:rbac:groups=autoscaling,namespace=xxxxx,resources=horizontalpodautoscalers,verbs=get;list;watch
+
+func (r *PoolScheduleReconciler) Reconcile(ctx context.Context, req ctrl.Request) (res ctrl.Result, retErr error) {
+	log := log.FromContext(ctx)
+
+	poolSchedule := &garmoperatorv1beta1.PoolSchedule{}
+	if err := r.Get(ctx, req.NamespacedName, poolSchedule); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.Info("PoolSchedule resource not found.")
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, err
+	}
+
+	initialPoolSchedule := poolSchedule.DeepCopy()
+
+	// Ignore objects that are paused
+	if annotations.IsPaused(poolSchedule) {
+		log.Info("Reconciliation is paused for this object")
+		return ctrl.Result{}, nil
+	}
+
+	// Initialize conditions to unknown if not set already
+	poolSchedule.InitializeConditions()
+
+	// always update the status
+	defer func() {
+		if !reflect.DeepEqual(poolSchedule.Status, initialPoolSchedule.Status) {
+			if err := r.Status().Update(ctx, poolSchedule); err != nil {
+				log.Error(err, "failed to update status")
+				res = ctrl.Result{}
+				retErr = err
+			}
+		}
+	}()
+
+	// the pools are not owned by the schedule, so there is nothing to clean up on deletion
+	if !poolSchedule.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+
+	return r.reconcileNormal(ctx, poolSchedule, time.Now())
+}
+
+func (r *PoolScheduleReconciler) reconcileNormal(ctx context.Context, poolSchedule *garmoperatorv1beta1.PoolSchedule, now time.Time) (ctrl.Result, error) {
+	log := log.FromContext(ctx)
+	log.WithValues("poolSchedule", poolSchedule.Name)
+
+	location, windows, holidays, err := parsePoolSchedule(poolSchedule)
+	if err != nil {
+		// an invalid schedule can only be fixed by changing the spec, which triggers a new reconciliation anyway
+		event.Error(r.Recorder, poolSchedule, err.Error())
+		conditions.MarkFalse(poolSchedule, conditions.ReadyCondition, conditions.InvalidScheduleReason, err.Error())
+		return ctrl.Result{}, nil
+	}
+	now = now.In(location)
+
+	pools, err := r.getPools(ctx, poolSchedule)
+	if err != nil {
+		event.Error(r.Recorder, poolSchedule, err.Error())
+		conditions.MarkFalse(poolSchedule, conditions.ReadyCondition, conditions.FetchingPoolRefFailedReason, err.Error())
+		conditions.MarkFalse(poolSchedule, conditions.PoolReference, conditions.FetchingPoolRefFailedReason, err.Error())
+		return ctrl.Result{}, err
+	}
+	conditions.MarkTrue(poolSchedule, conditions.PoolReference, conditions.FetchingPoolRefSuccessReason, "")
+
+	activeWindow, nextTransition := evaluateWindows(windows, holidays, now)
+
+	values := poolSchedule.Spec.Default
+	activeWindowName := ""
+	if activeWindow >= 0 {
+		values = &poolSchedule.Spec.Windows[activeWindow].PoolScheduleValues
+		activeWindowName = poolSchedule.Spec.Windows[activeWindow].Name
+	}
+
+	if poolSchedule.Status.ActiveWindow != activeWindowName {
+		msg := fmt.Sprintf("window %s is active", activeWindowName)
+		if activeWindowName == "" {
+			msg = fmt.Sprintf("window %s ended, no window is active", poolSchedule.Status.ActiveWindow)
+		}
+		log.Info(msg)
+		event.Scheduling(r.Recorder, poolSchedule, msg)
+		poolSchedule.Status.ActiveWindow = activeWindowName
+	}
+
+	if values != nil {
+		autoscaledPools, err := r.getAutoscaledPools(ctx, poolSchedule.Namespace)
+		if err != nil {
+			event.Error(r.Recorder, poolSchedule, err.Error())
+			conditions.MarkFalse(poolSchedule, conditions.ReadyCondition, conditions.ReconcileErrorReason, err.Error())
+			return ctrl.Result{}, err
+		}
+
+		for _, pool := range pools {
+			if err := r.applyValues(ctx, poolSchedule, pool, values, autoscaledPools[pool.Name]); err != nil {
+				event.Error(r.Recorder, poolSchedule, err.Error())
+				conditions.MarkFalse(poolSchedule, conditions.ReadyCondition, conditions.ReconcileErrorReason, err.Error())
+				return ctrl.Result{}, err
+			}
+		}
+	}
+
+	conditions.MarkTrue(poolSchedule, conditions.ReadyCondition, conditions.SuccessfulReconcileReason, "")
+
+	if nextTransition.IsZero() {
+		poolSchedule.Status.NextTransitionTime = nil
+		return ctrl.Result{}, nil
+	}
+
+	poolSchedule.Status.NextTransitionTime = &metav1.Time{Time: nextTransition.UTC()}
+	log.V(1).Info("requeue pool schedule until next transition", "nextTransition", nextTransition)
+
+	return ctrl.Result{RequeueAfter: nextTransition.Sub(now)}, nil
+}
+
+// parsePoolSchedule validates the time zone, windows and holidays of a PoolSchedule
+func parsePoolSchedule(poolSchedule *garmoperatorv1beta1.PoolSchedule) (*time.Location, []schedule.Window, map[string]bool, error) {
+	location, err := time.LoadLocation(poolSchedule.Spec.TimeZone)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("invalid time zone %s: %w", poolSchedule.Spec.TimeZone, err)
+	}
+
+	windows := make([]schedule.Window, 0, len(poolSchedule.Spec.Windows))
+	for _, w := range poolSchedule.Spec.Windows {
+		start, err := schedule.ParseCron(w.Schedule)
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("invalid schedule of window %s: %w", w.Name, err)
+		}
+		if w.Duration.Duration <= 0 {
+			return nil, nil, nil, fmt.Errorf("invalid duration of window %s: must be greater than zero", w.Name)
+		}
+		windows = append(windows, schedule.Window{Start: start, Duration: w.Duration.Duration})
+	}
+
+	holidays := make(map[string]bool, len(poolSchedule.Spec.Holidays))
+	for _, h := range poolSchedule.Spec.Holidays {
+		if _, err := time.ParseInLocation(holidayLayout, h, location); err != nil {
+			return nil, nil, nil, fmt.Errorf("invalid holiday %s: %w", h, err)
+		}
+		holidays[h] = true
+	}
+
+	return location, windows, holidays, nil
+}
+
+// evaluateWindows returns the index of the first window which is active at now (-1 if none)
+// and the next point in time at which any window starts or ends.
+// Window occurrences which start on a holiday are skipped.
+func evaluateWindows(windows []schedule.Window, holidays map[string]bool, now time.Time) (int, time.Time) {
+	activeWindow := -1
+	var nextTransition time.Time
+
+	for i, w := range windows {
+		if start, active := w.ActiveAt(now); active && activeWindow < 0 && !holidays[start.Format(holidayLayout)] {
+			activeWindow = i
+		}
+
+		if next := w.NextTransition(now); !next.IsZero() && (nextTransition.IsZero() || next.Before(nextTransition)) {
+			nextTransition = next
+		}
+	}
+
+	return activeWindow, nextTransition
+}
+
+func (r *PoolScheduleReconciler) getPools(ctx context.Context, poolSchedule *garmoperatorv1beta1.PoolSchedule) ([]*garmoperatorv1beta1.Pool, error) {
+	pools := make([]*garmoperatorv1beta1.Pool, 0, len(poolSchedule.Spec.PoolRefs))
+	for _, ref := range poolSchedule.Spec.PoolRefs {
+		pool := &garmoperatorv1beta1.Pool{}
+		if err := r.Get(ctx, types.NamespacedName{Namespace: poolSchedule.Namespace, Name: ref.Name}, pool); err != nil {
+			return nil, err
+		}
+		// pools which are being deleted are drained and must not be scaled up again
+		if !pool.DeletionTimestamp.IsZero() {
+			continue
+		}
+		pools = append(pools, pool)
+	}
+	return pools, nil
+}
+
+// getAutoscaledPools returns the names of the pools in namespace which are the scale target of a HorizontalPodAutoscaler.
+// This includes pools which are scaled by a KEDA ScaledObject, as KEDA creates a HorizontalPodAutoscaler for them.
+func (r *PoolScheduleReconciler) getAutoscaledPools(ctx context.Context, namespace string) (map[string]bool, error) {
+	var hpas autoscalingv2.HorizontalPodAutoscalerList
+	if err := r.List(ctx, &hpas, client.InNamespace(namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list horizontal pod autoscalers: %w", err)
+	}
+
+	autoscaledPools := map[string]bool{}
+	for _, hpa := range hpas.Items {
+		if pool, ok := scaledPool(&hpa); ok {
+			autoscaledPools[pool] = true
+		}
+	}
+	return autoscaledPools, nil
+}
+
+// scaledPool returns the name of the pool the HorizontalPodAutoscaler scales, if it scales a pool
+func scaledPool(hpa *autoscalingv2.HorizontalPodAutoscaler) (string, bool) {
+	ref := hpa.Spec.ScaleTargetRef
+	gv, err := schema.ParseGroupVersion(ref.APIVersion)
+	if err != nil || gv.Group != garmoperatorv1beta1.GroupVersion.Group || ref.Kind != poolKind {
+		return "", false
+	}
+	return ref.Name, true
+}
+
+// applyValues patches the pool with the values of the schedule.
+// The minIdleRunners of a pool which is scaled by a HorizontalPodAutoscaler through the scale subresource are left to the autoscaler,
+// otherwise both would overwrite each other.
+func (r *PoolScheduleReconciler) applyValues(ctx context.Context, poolSchedule *garmoperatorv1beta1.PoolSchedule, pool *garmoperatorv1beta1.Pool, values *garmoperatorv1beta1.PoolScheduleValues, autoscaled bool) error {
+	log := log.FromContext(ctx)
+
+	original := pool.DeepCopy()
+	if values.MinIdleRunners != nil {
+		if autoscaled {
+			if *values.MinIdleRunners != pool.Spec.MinIdleRunners {
+				msg := fmt.Sprintf("PoolSchedule %s doesn't set minIdleRunners of pool %s to %d, as the pool is scaled by a HorizontalPodAutoscaler", poolSchedule.Name, pool.Name, *values.MinIdleRunners)
+				log.Info(msg, "pool", pool.Name)
+				event.ScheduleConflict(r.Recorder, poolSchedule, msg)
+				event.ScheduleConflict(r.Recorder, pool, msg)
+			}
+		} else {
+			pool.Spec.MinIdleRunners = *values.MinIdleRunners
+		}
+	}
+	if values.MaxRunners != nil {
+		pool.Spec.MaxRunners = *values.MaxRunners
+	}
+
+	if reflect.DeepEqual(pool.Spec, original.Spec) {
+		return nil
+	}
+
+	msg := fmt.Sprintf("PoolSchedule %s sets minIdleRunners to %d and maxRunners to %d", poolSchedule.Name, pool.Spec.MinIdleRunners, pool.Spec.MaxRunners)
+	log.Info(msg, "pool", pool.Name)
+	event.Scheduling(r.Recorder, pool, msg)
+
+	if err := r.Patch(ctx, pool, client.MergeFrom(original)); err != nil {
+		return fmt.Errorf("failed to patch pool %s: %w", pool.Name, err)
+	}
+
+	return nil
+}
+
+func (r *PoolScheduleReconciler) findPoolSchedulesForPool(ctx context.Context, obj client.Object) []reconcile.Request {
+	pool, ok := obj.(*garmoperatorv1beta1.Pool)
+	if !ok {
+		return nil
+	}
+
+	return r.poolScheduleRequests(ctx, pool.Namespace, pool.Name)
+}
+
+// findPoolSchedulesForHorizontalPodAutoscaler enqueues the schedules of the pool the autoscaler scales,
+// so they stop or start to apply minIdleRunners to it
+func (r *PoolScheduleReconciler) findPoolSchedulesForHorizontalPodAutoscaler(ctx context.Context, obj client.Object) []reconcile.Request {
+	hpa, ok := obj.(*autoscalingv2.HorizontalPodAutoscaler)
+	if !ok {
+		return nil
+	}
+
+	pool, ok := scaledPool(hpa)
+	if !ok {
+		return nil
+	}
+
+	return r.poolScheduleRequests(ctx, hpa.Namespace, pool)
+}
+
+// poolScheduleRequests returns the requests for all schedules in namespace which reference the pool
+func (r *PoolScheduleReconciler) poolScheduleRequests(ctx context.Context, namespace, pool string) []reconcile.Request {
+	var poolSchedules garmoperatorv1beta1.PoolScheduleList
+	if err := r.List(ctx, &poolSchedules, client.InNamespace(namespace)); err != nil {
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, s := range poolSchedules.Items {
+		for _, ref := range s.Spec.PoolRefs {
+			if ref.Name == pool {
+				requests = append(requests, reconcile.Request{
+					NamespacedName: types.NamespacedName{
+						Namespace: s.Namespace,
+						Name:      s.Name,
+					},
+				})
+				break
+			}
+		}
+	}
+
+	return requests
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *PoolScheduleReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&garmoperatorv1beta1.PoolSchedule{}).
+		Watches(
+			&garmoperatorv1beta1.Pool{},
+			handler.EnqueueRequestsFromMapFunc(r.findPoolSchedulesForPool),
+			builder.WithPredicates(predicate.GenerationChangedPredicate{}),
+		).
+		Watches(
+			&autoscalingv2.HorizontalPodAutoscaler{},
+			handler.EnqueueRequestsFromMapFunc(r.findPoolSchedulesForHorizontalPodAutoscaler),
+			builder.WithPredicates(predicate.GenerationChangedPredicate{}),
+		).
+		Complete(r)
+}
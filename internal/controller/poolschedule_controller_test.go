@@ -0,0 +1,517 @@
+// SPDX-License-Identifier: MIT
+
+package controller
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	garmoperatorv1beta1 "github.com/mercedes-benz/garm-operator/api/v1beta1"
+	"github.com/mercedes-benz/garm-operator/pkg/client/key"
+	"github.com/mercedes-benz/garm-operator/pkg/conditions"
+)
+
+func TestPoolScheduleReconciler_reconcileNormal(t *testing.T) {
+	// monday, 2024-01-08 10:00 UTC
+	now := time.Date(2024, 1, 8, 10, 0, 0, 0, time.UTC)
+
+	uintPtr := func(i uint) *uint { return &i }
+
+	pool := &garmoperatorv1beta1.Pool{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-pool",
+			Namespace: "default",
+		},
+		Spec: garmoperatorv1beta1.PoolSpec{
+			MinIdleRunners: 1,
+			MaxRunners:     5,
+		},
+	}
+
+	deletingPool := pool.DeepCopy()
+	deletingPool.DeletionTimestamp = &metav1.Time{Time: now}
+	deletingPool.Finalizers = []string{key.PoolFinalizerName}
+
+	hpa := &autoscalingv2.HorizontalPodAutoscaler{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-pool",
+			Namespace: "default",
+		},
+		Spec: autoscalingv2.HorizontalPodAutoscalerSpec{
+			ScaleTargetRef: autoscalingv2.CrossVersionObjectReference{
+				APIVersion: garmoperatorv1beta1.GroupVersion.String(),
+				Kind:       "Pool",
+				Name:       "my-pool",
+			},
+			MaxReplicas: 10,
+		},
+	}
+
+	deploymentHPA := hpa.DeepCopy()
+	deploymentHPA.Spec.ScaleTargetRef = autoscalingv2.CrossVersionObjectReference{
+		APIVersion: "apps/v1",
+		Kind:       "Deployment",
+		Name:       "my-pool",
+	}
+
+		spec := garmoperatorv1beta1.PoolScheduleSpec{
+		PoolRefs: []corev1.LocalObjectReference{
+			{Name: "my-pool"},
+		},
+		TimeZone: "UTC",
+		Windows: []garmoperatorv1beta1.PoolScheduleWindow{
+			{
+				Name:     "working-hours",
+				Schedule: "0 7 * * 1-5",
+				Duration: metav1.Duration{Duration: 12 * time.Hour},
+				PoolScheduleValues: garmoperatorv1beta1.PoolScheduleValues{
+					MinIdleRunners: uintPtr(4),
+					MaxRunners:     uintPtr(10),
+				},
+			},
+		},
+		Default: &garmoperatorv1beta1.PoolScheduleValues{
+			MinIdleRunners: uintPtr(0),
+		},
+	}
+
+	tests := []struct {
+		name             string
+		object           *garmoperatorv1beta1.PoolSchedule
+		runtimeObjects   []runtime.Object
+		now              time.Time
+		wantErr          bool
+		wantRequeueAfter time.Duration
+		expectedObject   *garmoperatorv1beta1.PoolSchedule
+		expectedPoolSpec *garmoperatorv1beta1.PoolSpec
+		expectedEvents   []string
+	}{
+		{
+			name: "window is active",
+			object: &garmoperatorv1beta1.PoolSchedule{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "my-schedule",
+					Namespace: "default",
+				},
+				Spec: spec,
+			},
+			runtimeObjects:   []runtime.Object{pool},
+			now:              now,
+			wantRequeueAfter: 9 * time.Hour,
+			expectedObject: &garmoperatorv1beta1.PoolSchedule{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "my-schedule",
+					Namespace: "default",
+				},
+				Spec: spec,
+				Status: garmoperatorv1beta1.PoolScheduleStatus{
+					ActiveWindow:       "working-hours",
+					NextTransitionTime: &metav1.Time{Time: time.Date(2024, 1, 8, 19, 0, 0, 0, time.UTC)},
+					Conditions: []metav1.Condition{
+						{
+							Type:   string(conditions.ReadyCondition),
+							Status: metav1.ConditionTrue,
+							Reason: string(conditions.SuccessfulReconcileReason),
+						},
+						{
+							Type:   string(conditions.PoolReference),
+							Status: metav1.ConditionTrue,
+							Reason: string(conditions.FetchingPoolRefSuccessReason),
+						},
+					},
+				},
+			},
+			expectedPoolSpec: &garmoperatorv1beta1.PoolSpec{
+				MinIdleRunners: 4,
+				MaxRunners:     10,
+			},
+		},
+		{
+			name: "window is active - pool in deletion is skipped",
+			object: &garmoperatorv1beta1.PoolSchedule{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "my-schedule",
+					Namespace: "default",
+				},
+				Spec: spec,
+			},
+			runtimeObjects:   []runtime.Object{deletingPool},
+			now:              now,
+			wantRequeueAfter: 9 * time.Hour,
+			expectedObject: &garmoperatorv1beta1.PoolSchedule{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "my-schedule",
+					Namespace: "default",
+				},
+				Spec: spec,
+				Status: garmoperatorv1beta1.PoolScheduleStatus{
+					ActiveWindow:       "working-hours",
+					NextTransitionTime: &metav1.Time{Time: time.Date(2024, 1, 8, 19, 0, 0, 0, time.UTC)},
+					Conditions: []metav1.Condition{
+						{
+							Type:   string(conditions.ReadyCondition),
+							Status: metav1.ConditionTrue,
+							Reason: string(conditions.SuccessfulReconcileReason),
+						},
+						{
+							Type:   string(conditions.PoolReference),
+							Status: metav1.ConditionTrue,
+							Reason: string(conditions.FetchingPoolRefSuccessReason),
+						},
+					},
+				},
+			},
+			expectedPoolSpec: &garmoperatorv1beta1.PoolSpec{
+				MinIdleRunners: 1,
+				MaxRunners:     5,
+			},
+		},
+		{
+			name: "window is active - minIdleRunners of pool scaled by a horizontal pod autoscaler is left to the autoscaler",
+			object: &garmoperatorv1beta1.PoolSchedule{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "my-schedule",
+					Namespace: "default",
+				},
+				Spec: spec,
+			},
+			runtimeObjects:   []runtime.Object{pool, hpa},
+			now:              now,
+			wantRequeueAfter: 9 * time.Hour,
+			expectedObject: &garmoperatorv1beta1.PoolSchedule{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "my-schedule",
+					Namespace: "default",
+				},
+				Spec: spec,
+				Status: garmoperatorv1beta1.PoolScheduleStatus{
+					ActiveWindow:       "working-hours",
+					NextTransitionTime: &metav1.Time{Time: time.Date(2024, 1, 8, 19, 0, 0, 0, time.UTC)},
+					Conditions: []metav1.Condition{
+						{
+							Type:   string(conditions.ReadyCondition),
+							Status: metav1.ConditionTrue,
+							Reason: string(conditions.SuccessfulReconcileReason),
+						},
+						{
+							Type:   string(conditions.PoolReference),
+							Status: metav1.ConditionTrue,
+							Reason: string(conditions.FetchingPoolRefSuccessReason),
+						},
+					},
+				},
+			},
+			expectedPoolSpec: &garmoperatorv1beta1.PoolSpec{
+				MinIdleRunners: 1,
+				MaxRunners:     10,
+			},
+			expectedEvents: []string{
+				"Normal Scheduling window working-hours is active",
+				"Warning ScheduleConflict PoolSchedule my-schedule doesn't set minIdleRunners of pool my-pool to 4, as the pool is scaled by a HorizontalPodAutoscaler",
+				"Warning ScheduleConflict PoolSchedule my-schedule doesn't set minIdleRunners of pool my-pool to 4, as the pool is scaled by a HorizontalPodAutoscaler",
+				"Normal Scheduling PoolSchedule my-schedule sets minIdleRunners to 1 and maxRunners to 10",
+			},
+		},
+		{
+			name: "window is active - horizontal pod autoscaler of another kind is ignored",
+			object: &garmoperatorv1beta1.PoolSchedule{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "my-schedule",
+					Namespace: "default",
+				},
+				Spec: spec,
+			},
+			runtimeObjects:   []runtime.Object{pool, deploymentHPA},
+			now:              now,
+			wantRequeueAfter: 9 * time.Hour,
+			expectedObject: &garmoperatorv1beta1.PoolSchedule{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "my-schedule",
+					Namespace: "default",
+				},
+				Spec: spec,
+				Status: garmoperatorv1beta1.PoolScheduleStatus{
+					ActiveWindow:       "working-hours",
+					NextTransitionTime: &metav1.Time{Time: time.Date(2024, 1, 8, 19, 0, 0, 0, time.UTC)},
+					Conditions: []metav1.Condition{
+						{
+							Type:   string(conditions.ReadyCondition),
+							Status: metav1.ConditionTrue,
+							Reason: string(conditions.SuccessfulReconcileReason),
+						},
+						{
+							Type:   string(conditions.PoolReference),
+							Status: metav1.ConditionTrue,
+							Reason: string(conditions.FetchingPoolRefSuccessReason),
+						},
+					},
+				},
+			},
+			expectedPoolSpec: &garmoperatorv1beta1.PoolSpec{
+				MinIdleRunners: 4,
+				MaxRunners:     10,
+			},
+			expectedEvents: []string{
+				"Normal Scheduling window working-hours is active",
+				"Normal Scheduling PoolSchedule my-schedule sets minIdleRunners to 4 and maxRunners to 10",
+			},
+		},
+		{
+			name: "window ended - default values are applied",
+			object: &garmoperatorv1beta1.PoolSchedule{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "my-schedule",
+					Namespace: "default",
+				},
+				Spec: spec,
+				Status: garmoperatorv1beta1.PoolScheduleStatus{
+					ActiveWindow: "working-hours",
+				},
+			},
+			runtimeObjects:   []runtime.Object{pool},
+			now:              now.Add(10 * time.Hour),
+			wantRequeueAfter: 11 * time.Hour,
+			expectedObject: &garmoperatorv1beta1.PoolSchedule{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "my-schedule",
+					Namespace: "default",
+				},
+				Spec: spec,
+				Status: garmoperatorv1beta1.PoolScheduleStatus{
+					NextTransitionTime: &metav1.Time{Time: time.Date(2024, 1, 9, 7, 0, 0, 0, time.UTC)},
+					Conditions: []metav1.Condition{
+						{
+							Type:   string(conditions.ReadyCondition),
+							Status: metav1.ConditionTrue,
+							Reason: string(conditions.SuccessfulReconcileReason),
+						},
+						{
+							Type:   string(conditions.PoolReference),
+							Status: metav1.ConditionTrue,
+							Reason: string(conditions.FetchingPoolRefSuccessReason),
+						},
+					},
+				},
+			},
+			expectedPoolSpec: &garmoperatorv1beta1.PoolSpec{
+				MinIdleRunners: 0,
+				MaxRunners:     5,
+			},
+		},
+		{
+			name: "window is skipped on holidays",
+			object: &garmoperatorv1beta1.PoolSchedule{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "my-schedule",
+					Namespace: "default",
+				},
+				Spec: func() garmoperatorv1beta1.PoolScheduleSpec {
+					s := *spec.DeepCopy()
+					s.Holidays = []string{"2024-01-08"}
+					return s
+				}(),
+			},
+			runtimeObjects:   []runtime.Object{pool},
+			now:              now,
+			wantRequeueAfter: 9 * time.Hour,
+			expectedObject: &garmoperatorv1beta1.PoolSchedule{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "my-schedule",
+					Namespace: "default",
+				},
+				Spec: func() garmoperatorv1beta1.PoolScheduleSpec {
+					s := *spec.DeepCopy()
+					s.Holidays = []string{"2024-01-08"}
+					return s
+				}(),
+				Status: garmoperatorv1beta1.PoolScheduleStatus{
+					NextTransitionTime: &metav1.Time{Time: time.Date(2024, 1, 8, 19, 0, 0, 0, time.UTC)},
+					Conditions: []metav1.Condition{
+						{
+							Type:   string(conditions.ReadyCondition),
+							Status: metav1.ConditionTrue,
+							Reason: string(conditions.SuccessfulReconcileReason),
+						},
+						{
+							Type:   string(conditions.PoolReference),
+							Status: metav1.ConditionTrue,
+							Reason: string(conditions.FetchingPoolRefSuccessReason),
+						},
+					},
+				},
+			},
+			expectedPoolSpec: &garmoperatorv1beta1.PoolSpec{
+				MinIdleRunners: 0,
+				MaxRunners:     5,
+			},
+		},
+		{
+			name: "invalid schedule",
+			object: &garmoperatorv1beta1.PoolSchedule{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "my-schedule",
+					Namespace: "default",
+				},
+				Spec: garmoperatorv1beta1.PoolScheduleSpec{
+					PoolRefs: spec.PoolRefs,
+					Windows: []garmoperatorv1beta1.PoolScheduleWindow{
+						{
+							Name:     "broken",
+							Schedule: "0 7 * *",
+							Duration: metav1.Duration{Duration: time.Hour},
+						},
+					},
+				},
+			},
+			runtimeObjects: []runtime.Object{pool},
+			now:            now,
+			expectedObject: &garmoperatorv1beta1.PoolSchedule{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "my-schedule",
+					Namespace: "default",
+				},
+				Spec: garmoperatorv1beta1.PoolScheduleSpec{
+					PoolRefs: spec.PoolRefs,
+					Windows: []garmoperatorv1beta1.PoolScheduleWindow{
+						{
+							Name:     "broken",
+							Schedule: "0 7 * *",
+							Duration: metav1.Duration{Duration: time.Hour},
+						},
+					},
+				},
+				Status: garmoperatorv1beta1.PoolScheduleStatus{
+					Conditions: []metav1.Condition{
+						{
+							Type:    string(conditions.ReadyCondition),
+							Status:  metav1.ConditionFalse,
+							Reason:  string(conditions.InvalidScheduleReason),
+							Message: "invalid schedule of window broken: expected 5 fields in cron expression \"0 7 * *\", got 4",
+						},
+					},
+				},
+			},
+			expectedPoolSpec: &garmoperatorv1beta1.PoolSpec{
+				MinIdleRunners: 1,
+				MaxRunners:     5,
+			},
+		},
+		{
+			name: "pool not found",
+			object: &garmoperatorv1beta1.PoolSchedule{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "my-schedule",
+					Namespace: "default",
+				},
+				Spec: spec,
+			},
+			now:     now,
+			wantErr: true,
+			expectedObject: &garmoperatorv1beta1.PoolSchedule{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "my-schedule",
+					Namespace: "default",
+				},
+				Spec: spec,
+				Status: garmoperatorv1beta1.PoolScheduleStatus{
+					Conditions: []metav1.Condition{
+						{
+							Type:    string(conditions.ReadyCondition),
+							Status:  metav1.ConditionFalse,
+							Reason:  string(conditions.FetchingPoolRefFailedReason),
+							Message: "pools.garm-operator.mercedes-benz.com \"my-pool\" not found",
+						},
+						{
+							Type:    string(conditions.PoolReference),
+							Status:  metav1.ConditionFalse,
+							Reason:  string(conditions.FetchingPoolRefFailedReason),
+							Message: "pools.garm-operator.mercedes-benz.com \"my-pool\" not found",
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schemeBuilder := runtime.SchemeBuilder{
+				garmoperatorv1beta1.AddToScheme,
+			}
+
+			err := schemeBuilder.AddToScheme(scheme.Scheme)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			runtimeObjects := []runtime.Object{tt.object}
+			runtimeObjects = append(runtimeObjects, tt.runtimeObjects...)
+			client := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithRuntimeObjects(runtimeObjects...).WithStatusSubresource(&garmoperatorv1beta1.PoolSchedule{}).Build()
+
+			recorder := record.NewFakeRecorder(5)
+
+			// create a fake reconciler
+			reconciler := &PoolScheduleReconciler{
+				Client:   client,
+				Recorder: recorder,
+			}
+
+			poolSchedule := tt.object.DeepCopy()
+
+			res, err := reconciler.reconcileNormal(context.Background(), poolSchedule, tt.now)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("PoolScheduleReconciler.reconcileNormal() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if res.RequeueAfter != tt.wantRequeueAfter {
+				t.Errorf("PoolScheduleReconciler.reconcileNormal() requeueAfter = %v, want %v", res.RequeueAfter, tt.wantRequeueAfter)
+			}
+
+			// empty resource version to avoid comparison errors
+			poolSchedule.ResourceVersion = ""
+
+			// clear conditions lastTransitionTime to avoid comparison errors
+			conditions.NilLastTransitionTime(tt.expectedObject)
+			conditions.NilLastTransitionTime(poolSchedule)
+
+			if !reflect.DeepEqual(poolSchedule, tt.expectedObject) {
+				t.Errorf("PoolScheduleReconciler.reconcileNormal() \ngot = %#v\n want %#v", poolSchedule, tt.expectedObject)
+			}
+
+			if tt.expectedEvents != nil {
+				close(recorder.Events)
+				var events []string
+				for e := range recorder.Events {
+					events = append(events, e)
+				}
+				if !reflect.DeepEqual(events, tt.expectedEvents) {
+					t.Errorf("PoolScheduleReconciler.reconcileNormal() \ngot events = %#v\n want %#v", events, tt.expectedEvents)
+				}
+			}
+
+			if tt.expectedPoolSpec == nil {
+				return
+			}
+
+			pool := &garmoperatorv1beta1.Pool{}
+			if err := client.Get(context.Background(), types.NamespacedName{Namespace: "default", Name: "my-pool"}, pool); err != nil {
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(pool.Spec, *tt.expectedPoolSpec) {
+				t.Errorf("PoolScheduleReconciler.reconcileNormal() \ngot pool spec = %#v\n want %#v", pool.Spec, *tt.expectedPoolSpec)
+			}
+		})
+	}
+}
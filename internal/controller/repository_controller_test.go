@@ -20,6 +20,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
 	garmoperatorv1beta1 "github.com/mercedes-benz/garm-operator/api/v1beta1"
+	garmClient "github.com/mercedes-benz/garm-operator/pkg/client"
 	"github.com/mercedes-benz/garm-operator/pkg/client/key"
 	"github.com/mercedes-benz/garm-operator/pkg/client/mock"
 	"github.com/mercedes-benz/garm-operator/pkg/conditions"
@@ -172,6 +173,148 @@ func TestRepositoryReconciler_reconcileNormal(t *testing.T) {
 				}, nil)
 			},
 		},
+		{
+			name: "repository exist - uninstall webhook after install has been disabled",
+			object: &garmoperatorv1beta1.Repository{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "existing-repository",
+					Namespace: "default",
+					Finalizers: []string{
+						key.RepositoryFinalizerName,
+					},
+				},
+				Spec: garmoperatorv1beta1.RepositorySpec{
+					CredentialsRef: corev1.TypedLocalObjectReference{
+						APIGroup: &garmoperatorv1beta1.GroupVersion.Group,
+						Kind:     "GitHubCredential",
+						Name:     "github-creds",
+					},
+					Owner: "test-repo",
+					WebhookSecretRef: garmoperatorv1beta1.SecretRef{
+						Name: "my-webhook-secret",
+						Key:  "webhookSecret",
+					},
+				},
+				Status: garmoperatorv1beta1.RepositoryStatus{
+					ID: "e1dbf9a6-a9f6-4594-a5ac-ae78a8f27a3e",
+					Webhook: &garmoperatorv1beta1.WebhookStatus{
+						ID:     123456,
+						Active: true,
+					},
+				},
+			},
+			runtimeObjects: []runtime.Object{
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "default",
+						Name:      "my-webhook-secret",
+					},
+					Data: map[string][]byte{
+						"webhookSecret": []byte("foobar"),
+					},
+				},
+				&garmoperatorv1beta1.GitHubCredential{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "github-creds",
+						Namespace: "default",
+					},
+					Spec: garmoperatorv1beta1.GitHubCredentialSpec{
+						Description: "github-creds",
+						EndpointRef: corev1.TypedLocalObjectReference{},
+						AuthType:    "pat",
+						SecretRef: garmoperatorv1beta1.SecretRef{
+							Name: "github-secret",
+							Key:  "token",
+						},
+					},
+				},
+			},
+			expectedObject: &garmoperatorv1beta1.Repository{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "existing-repository",
+					Namespace: "default",
+					Finalizers: []string{
+						key.RepositoryFinalizerName,
+					},
+				},
+				Spec: garmoperatorv1beta1.RepositorySpec{
+					CredentialsRef: corev1.TypedLocalObjectReference{
+						APIGroup: &garmoperatorv1beta1.GroupVersion.Group,
+						Kind:     "GitHubCredential",
+						Name:     "github-creds",
+					},
+					Owner: "test-repo",
+					WebhookSecretRef: garmoperatorv1beta1.SecretRef{
+						Name: "my-webhook-secret",
+						Key:  "webhookSecret",
+					},
+				},
+				Status: garmoperatorv1beta1.RepositoryStatus{
+					ID: "e1dbf9a6-a9f6-4594-a5ac-ae78a8f27a3e",
+					Conditions: []metav1.Condition{
+						{
+							Type:               string(conditions.ReadyCondition),
+							Reason:             string(conditions.PoolManagerFailureReason),
+							Status:             metav1.ConditionFalse,
+							LastTransitionTime: metav1.NewTime(time.Now()),
+							Message:            "Pool Manager is not running",
+						},
+						{
+							Type:               string(conditions.GithubCredentialsReference),
+							Reason:             string(conditions.FetchingGithubCredentialsRefSuccessReason),
+							Status:             metav1.ConditionTrue,
+							Message:            "",
+							LastTransitionTime: metav1.NewTime(time.Now()),
+						},
+						{
+							Type:               string(conditions.PoolManager),
+							Reason:             string(conditions.PoolManagerFailureReason),
+							Status:             metav1.ConditionFalse,
+							Message:            "",
+							LastTransitionTime: metav1.NewTime(time.Now()),
+						},
+						{
+							Type:               string(conditions.WebhookSecretReference),
+							Reason:             string(conditions.FetchingWebhookSecretRefSuccessReason),
+							Status:             metav1.ConditionTrue,
+							Message:            "",
+							LastTransitionTime: metav1.NewTime(time.Now()),
+						},
+					},
+				},
+			},
+			expectGarmRequest: func(m *mock.MockRepositoryClientMockRecorder) {
+				m.ListRepositories(repositories.NewListReposParams()).Return(&repositories.ListReposOK{Payload: params.Repositories{
+					//nolint:gosec
+					{
+						ID:              "e1dbf9a6-a9f6-4594-a5ac-ae78a8f27a3e",
+						Name:            "existing-repository",
+						Owner:           "test-repo",
+						CredentialsName: "github-creds",
+						WebhookSecret:   "foobar",
+					},
+				}}, nil)
+				m.UpdateRepository(repositories.NewUpdateRepoParams().
+					WithRepoID("e1dbf9a6-a9f6-4594-a5ac-ae78a8f27a3e").
+					//nolint:gosec
+					WithBody(params.UpdateEntityParams{
+						CredentialsName: "github-creds",
+						WebhookSecret:   "foobar",
+					})).Return(&repositories.UpdateRepoOK{
+					//nolint:gosec
+					Payload: params.Repository{
+						ID:              "e1dbf9a6-a9f6-4594-a5ac-ae78a8f27a3e",
+						Name:            "existing-repository",
+						Owner:           "test-repo",
+						CredentialsName: "github-creds",
+						WebhookSecret:   "foobar",
+					},
+				}, nil)
+				m.UninstallRepositoryWebhook(repositories.NewUninstallRepoWebhookParams().
+					WithRepoID("e1dbf9a6-a9f6-4594-a5ac-ae78a8f27a3e")).
+					Return(nil)
+			},
+		},
 		{
 			name: "repository exist but spec has changed - update",
 			object: &garmoperatorv1beta1.Repository{
@@ -978,7 +1121,7 @@ func TestRepositoryReconciler_reconcileNormal(t *testing.T) {
 
 			repository.InitializeConditions()
 
-			_, err = reconciler.reconcileNormal(context.Background(), mockRepositoryClient, repository)
+			_, err = reconciler.scopeReconciler().reconcileNormal(context.Background(), garmClient.NewRepositoryScopeClient(mockRepositoryClient), repository)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("RepositoryReconciler.reconcileNormal() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -1097,7 +1240,7 @@ func TestRepositoryReconciler_reconcileDelete(t *testing.T) {
 			mockRepository := mock.NewMockRepositoryClient(mockCtrl)
 			tt.expectGarmRequest(mockRepository.EXPECT())
 
-			_, err = reconciler.reconcileDelete(context.Background(), mockRepository, repository)
+			_, err = reconciler.scopeReconciler().reconcileDelete(context.Background(), garmClient.NewRepositoryScopeClient(mockRepository), mock.NewMockPoolClient(mockCtrl), repository)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("RepositoryReconciler.reconcileDelete() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -4,13 +4,18 @@ package controller
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"reflect"
+	"sort"
 	"strings"
 	"time"
 
+	commonParams "github.com/cloudbase/garm-provider-common/params"
 	"github.com/cloudbase/garm/client/instances"
 	"github.com/cloudbase/garm/params"
 	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -28,8 +33,11 @@ import (
 	garmoperatorv1beta1 "github.com/mercedes-benz/garm-operator/api/v1beta1"
 	garmClient "github.com/mercedes-benz/garm-operator/pkg/client"
 	"github.com/mercedes-benz/garm-operator/pkg/client/key"
+	"github.com/mercedes-benz/garm-operator/pkg/conditions"
 	"github.com/mercedes-benz/garm-operator/pkg/config"
+	garmEvent "github.com/mercedes-benz/garm-operator/pkg/event"
 	"github.com/mercedes-benz/garm-operator/pkg/filter"
+	"github.com/mercedes-benz/garm-operator/pkg/metrics"
 	runnerUtil "github.com/mercedes-benz/garm-operator/pkg/runners"
 )
 
@@ -39,6 +47,8 @@ type RunnerReconciler struct {
 	Scheme        *runtime.Scheme
 	Recorder      record.EventRecorder
 	ReconcileChan chan event.GenericEvent
+	// Jobs counts the jobs of the runners, it is shared with the PoolReconciler which recycles runners based on it
+	Jobs *runnerUtil.JobTracker
 }
 
 //+kubebuilder:rbac:groups=garm-operator.mercedes-benz.com,namespace=xxxxx,resources=runners,verbs=get;list;watch;create;update;patch;delete
@@ -46,8 +56,58 @@ type RunnerReconciler struct {
 //+kubebuilder:rbac:groups=garm-operator.mercedes-benz.com,namespace=xxxxx,resources=runners/finalizers,verbs=update
 
 func (r *RunnerReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
-	instanceClient := garmClient.NewInstanceClient()
-	return r.reconcileNormal(ctx, req, instanceClient)
+	garmServer, err := r.getGarmServerOfRunner(ctx, req)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// runners have no conditions, so they are only requeued until the GARM server is available again
+	if retryAfter, open := garmServer.CircuitOpen(); open {
+		return ctrl.Result{RequeueAfter: max(retryAfter, time.Second)}, nil
+	}
+
+	instanceClient := garmClient.NewInstanceClient(garmServer)
+	res, err := r.reconcileNormal(ctx, req, instanceClient)
+	var unavailableErr *garmClient.UnavailableError
+	if errors.As(err, &unavailableErr) {
+		return ctrl.Result{RequeueAfter: max(unavailableErr.RetryAfter, time.Second)}, nil
+	}
+	return res, err
+}
+
+// getGarmServerOfRunner returns the client of the GARM server which manages the runner.
+// The server is looked up via the pool of the RunnerCR. Runners without RunnerCR or pool
+// are searched on all known GARM servers.
+func (r *RunnerReconciler) getGarmServerOfRunner(ctx context.Context, req ctrl.Request) (garmClient.GarmClient, error) {
+	log := log.FromContext(ctx)
+
+	runner := &garmoperatorv1beta1.Runner{}
+	if err := r.Get(ctx, types.NamespacedName{Namespace: req.Namespace, Name: strings.ToLower(req.Name)}, runner); err == nil {
+		if poolName, ok := runner.Labels[key.PoolLabel]; ok {
+			pool := &garmoperatorv1beta1.Pool{}
+			if err := r.Get(ctx, types.NamespacedName{Namespace: runner.Namespace, Name: poolName}, pool); err == nil {
+				return garmServerClient(ctx, r.Client, pool.Namespace, pool.Spec.GarmServerRef)
+			}
+		}
+	}
+
+	servers := garmClient.Servers()
+	if len(servers) <= 1 {
+		return garmClient.Client, nil
+	}
+
+	for _, server := range servers {
+		garmRunner, err := r.getGarmRunnerInstanceByName(garmClient.NewInstanceClient(server), req.Name)
+		if err != nil {
+			log.Error(err, "Failed searching runner on garm server", "server", server.Name())
+			continue
+		}
+		if garmRunner != nil {
+			return server, nil
+		}
+	}
+
+	return garmClient.Client, nil
 }
 
 func (r *RunnerReconciler) reconcileNormal(ctx context.Context, req ctrl.Request, instanceClient garmClient.InstanceClient) (res ctrl.Result, retErr error) {
@@ -63,6 +123,17 @@ func (r *RunnerReconciler) reconcileNormal(ctx context.Context, req ctrl.Request
 	runner := &garmoperatorv1beta1.Runner{}
 	err = r.Get(ctx, types.NamespacedName{Namespace: req.Namespace, Name: strings.ToLower(req.Name)}, runner)
 
+	// RunnerCRs live in the namespace of their pool, a RunnerCR in another namespace is a leftover and must not delete the garm runner
+	if garmRunner != nil {
+		if pool := r.getPoolByID(ctx, garmRunner.PoolID); pool != nil && pool.Namespace != req.Namespace {
+			if err == nil {
+				log.Info("Found RunnerCR outside of the namespace of its pool, removing RunnerCR", "runner", runner.Name, "poolNamespace", pool.Namespace)
+				return ctrl.Result{}, r.removeRunnerCR(ctx, runner)
+			}
+			return ctrl.Result{}, client.IgnoreNotFound(err)
+		}
+	}
+
 	switch {
 	// found Runner CR and matching garm runner or RunnerCR is already in deleting state, continue with reconcile
 	case err == nil && garmRunner != nil || !runner.DeletionTimestamp.IsZero():
@@ -74,6 +145,7 @@ func (r *RunnerReconciler) reconcileNormal(ctx context.Context, req ctrl.Request
 		if err := r.Delete(ctx, runner); err != nil {
 			return ctrl.Result{}, err
 		}
+		return ctrl.Result{}, nil
 
 	// Did not find RunnerCR and found garm runner, create the RunnerCR
 	case apierrors.IsNotFound(err) && garmRunner != nil:
@@ -112,15 +184,81 @@ func (r *RunnerReconciler) reconcileNormal(ctx context.Context, req ctrl.Request
 		return r.reconcileDelete(ctx, instanceClient, runner, garmRunner)
 	}
 
+	// ensure RunnerCR is selectable by the pool it belongs to and gets garbage collected with it
+	if err := r.ensureLabelsAndOwner(ctx, runner, garmRunner); err != nil {
+		log.Error(err, "Failed to update runner labels", "runner", runner.Name)
+		return ctrl.Result{}, err
+	}
+
 	// sync garm runner status back to RunnerCR
 	err = r.updateRunnerStatus(ctx, runner, garmRunner)
 	if err != nil {
 		log.Error(err, "Failed to update runner status", "runner", runner.Name)
+		return ctrl.Result{}, err
+	}
+
+	// execute actions requested by annotations on the RunnerCR
+	err = r.reconcileActions(ctx, instanceClient, runner, garmRunner)
+	if err != nil {
+		log.Error(err, "Failed to execute runner action", "runner", runner.Name)
 	}
 
 	return ctrl.Result{}, err
 }
 
+// reconcileActions executes the actions requested by annotations on the RunnerCR and records their result as condition.
+// Runners annotated with key.DoNotReapAnnotation are excluded from scale down, recycling and remediation by the pool controller.
+func (r *RunnerReconciler) reconcileActions(ctx context.Context, instanceClient garmClient.InstanceClient, runner *garmoperatorv1beta1.Runner, garmRunner *params.Instance) error {
+	if runner.Annotations[key.DoNotReapAnnotation] == "true" {
+		conditions.MarkTrue(runner, conditions.ReapProtection, conditions.DoNotReapReason, "Runner is excluded from scale down, recycling and remediation of its pool")
+	} else {
+		conditions.Remove(runner, conditions.ReapProtection)
+	}
+
+	// the runner is already gone in garm, there is nothing left to act on
+	if garmRunner == nil {
+		conditions.Remove(runner, conditions.RunnerAction)
+		return nil
+	}
+
+	switch {
+	case runner.Annotations[key.ForceDeleteAnnotation] == "true":
+		return r.deleteGarmRunner(ctx, instanceClient, runner, garmRunner, true, conditions.ForceDeleteRequestedReason, "Force deleting runner")
+	case runner.Annotations[key.RecycleAnnotation] == "true":
+		if garmRunner.RunnerStatus == params.RunnerActive {
+			conditions.MarkFalse(runner, conditions.RunnerAction, conditions.RecycleScheduledReason, "Runner gets recycled once its current job is finished")
+			return nil
+		}
+		return r.deleteGarmRunner(ctx, instanceClient, runner, garmRunner, false, conditions.RecycleRequestedReason, "Recycling runner")
+	default:
+		conditions.Remove(runner, conditions.RunnerAction)
+	}
+
+	return nil
+}
+
+// deleteGarmRunner deletes the runner in garm, the RunnerCR is removed as soon as the runner is gone in garm
+func (r *RunnerReconciler) deleteGarmRunner(ctx context.Context, instanceClient garmClient.InstanceClient, runner *garmoperatorv1beta1.Runner, garmRunner *params.Instance, forceRemove bool, reason conditions.ConditionReason, msg string) error {
+	log := log.FromContext(ctx)
+
+	switch garmRunner.Status {
+	case commonParams.InstancePendingDelete, commonParams.InstancePendingForceDelete, commonParams.InstanceDeleting:
+		// deletion is already in progress
+		return nil
+	}
+
+	log.Info(msg, "runner", runner.Name)
+	if err := instanceClient.DeleteInstance(instances.NewDeleteInstanceParams().WithInstanceName(garmRunner.Name).WithForceRemove(&forceRemove)); err != nil {
+		conditions.MarkFalse(runner, conditions.RunnerAction, conditions.ActionFailedReason, err.Error())
+		garmEvent.Error(r.Recorder, runner, err.Error())
+		return err
+	}
+
+	conditions.MarkTrue(runner, conditions.RunnerAction, reason, msg)
+	garmEvent.Action(r.Recorder, runner, msg)
+	return nil
+}
+
 func (r *RunnerReconciler) createRunnerCR(ctx context.Context, garmRunner *params.Instance, namespace string) (ctrl.Result, error) {
 	log := log.FromContext(ctx)
 	log.Info("Creating RunnerCR", "Runner", garmRunner.Name)
@@ -133,6 +271,13 @@ func (r *RunnerReconciler) createRunnerCR(ctx context.Context, garmRunner *param
 		Spec: garmoperatorv1beta1.RunnerSpec{},
 	}
 
+	if pool := r.getPoolByID(ctx, garmRunner.PoolID); pool != nil {
+		runnerCR.Labels = runnerLabels(pool, garmRunner)
+		if err := r.setPoolOwner(runnerCR, pool); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
 	if err := r.Create(ctx, runnerCR); err != nil {
 		return ctrl.Result{}, err
 	}
@@ -166,6 +311,17 @@ func (r *RunnerReconciler) reconcileDelete(ctx context.Context, runnerClient gar
 	return ctrl.Result{}, nil
 }
 
+// removeRunnerCR deletes the RunnerCR without deleting the runner in garm
+func (r *RunnerReconciler) removeRunnerCR(ctx context.Context, runnerCR *garmoperatorv1beta1.Runner) error {
+	if controllerutil.ContainsFinalizer(runnerCR, key.RunnerFinalizerName) {
+		controllerutil.RemoveFinalizer(runnerCR, key.RunnerFinalizerName)
+		if err := r.Update(ctx, runnerCR); err != nil {
+			return err
+		}
+	}
+	return client.IgnoreNotFound(r.Delete(ctx, runnerCR))
+}
+
 func (r *RunnerReconciler) getGarmRunnerInstanceByName(client garmClient.InstanceClient, name string) (*params.Instance, error) {
 	allInstances, err := client.ListInstances(instances.NewListInstancesParams().WithDefaults())
 	if err != nil {
@@ -188,19 +344,91 @@ func (r *RunnerReconciler) ensureFinalizer(ctx context.Context, runner *garmoper
 	return nil
 }
 
-func (r *RunnerReconciler) updateRunnerStatus(ctx context.Context, runner *garmoperatorv1beta1.Runner, garmRunner *params.Instance) error {
+func (r *RunnerReconciler) ensureLabelsAndOwner(ctx context.Context, runner *garmoperatorv1beta1.Runner, garmRunner *params.Instance) error {
 	if garmRunner == nil {
 		return nil
 	}
 
-	poolName := garmRunner.PoolID
+	pool := r.getPoolByID(ctx, garmRunner.PoolID)
+	if pool == nil {
+		return nil
+	}
+
+	initialRunner := runner.DeepCopy()
+
+	if runner.Labels == nil {
+		runner.Labels = map[string]string{}
+	}
+	for k, v := range runnerLabels(pool, garmRunner) {
+		runner.Labels[k] = v
+	}
+
+	if err := r.setPoolOwner(runner, pool); err != nil {
+		return err
+	}
+
+	if reflect.DeepEqual(runner.Labels, initialRunner.Labels) && reflect.DeepEqual(runner.OwnerReferences, initialRunner.OwnerReferences) {
+		return nil
+	}
+
+	return r.Update(ctx, runner)
+}
+
+// setPoolOwner makes the pool the owner of the RunnerCR, so that the RunnerCR is garbage collected with the pool.
+// Owner references across namespaces aren't allowed, so RunnerCRs in another namespace than their pool aren't owned by it.
+func (r *RunnerReconciler) setPoolOwner(runner *garmoperatorv1beta1.Runner, pool *garmoperatorv1beta1.Pool) error {
+	if runner.Namespace != pool.Namespace {
+		return nil
+	}
+	return controllerutil.SetOwnerReference(pool, runner, r.Client.Scheme())
+}
+
+// runnerLabels returns the labels which make a RunnerCR selectable by its pool, scope, os and status
+func runnerLabels(pool *garmoperatorv1beta1.Pool, garmRunner *params.Instance) map[string]string {
+	return map[string]string{
+		key.PoolLabel:         pool.Name,
+		key.ScopeKindLabel:    pool.Spec.GitHubScopeRef.Kind,
+		key.ScopeNameLabel:    pool.Spec.GitHubScopeRef.Name,
+		key.OSTypeLabel:       string(garmRunner.OSType),
+		key.OSArchLabel:       string(garmRunner.OSArch),
+		key.RunnerStatusLabel: string(garmRunner.RunnerStatus),
+	}
+}
+
+func (r *RunnerReconciler) getPoolByID(ctx context.Context, poolID string) *garmoperatorv1beta1.Pool {
 	pools := &garmoperatorv1beta1.PoolList{}
-	if err := r.List(ctx, pools); err == nil {
-		filteredPools := filter.Match(pools.Items, garmoperatorv1beta1.MatchesID(garmRunner.PoolID))
+	if err := r.List(ctx, pools); err != nil {
+		return nil
+	}
 
-		if len(filteredPools) > 0 {
-			poolName = filteredPools[0].Name
-		}
+	filteredPools := filter.Match(pools.Items, garmoperatorv1beta1.MatchesID(poolID))
+	if len(filteredPools) == 0 {
+		return nil
+	}
+
+	return &filteredPools[0]
+}
+
+func (r *RunnerReconciler) updateRunnerStatus(ctx context.Context, runner *garmoperatorv1beta1.Runner, garmRunner *params.Instance) error {
+	if garmRunner == nil {
+		return nil
+	}
+
+	if r.Jobs != nil {
+		r.Jobs.Observe(*garmRunner)
+	}
+
+	pool := r.getPoolByID(ctx, garmRunner.PoolID)
+	if pool != nil {
+		observeRunnerTransition(pool, runner.Status, garmRunner)
+	}
+
+	runner.Status.PoolRef = nil
+	runner.Status.Labels = nil
+	if pool != nil {
+		runner.Status.PoolRef = &corev1.LocalObjectReference{Name: pool.Name}
+		// runners get registered on GitHub with the tags of their pool
+		runner.Status.Labels = append(append([]string{}, pool.Spec.Tags...), garmRunner.AditionalLabels...)
 	}
 
 	runner.Status.ID = garmRunner.ID
@@ -214,13 +442,103 @@ func (r *RunnerReconciler) updateRunnerStatus(ctx context.Context, runner *garmo
 	runner.Status.Addresses = garmRunner.Addresses
 	runner.Status.Status = garmRunner.RunnerStatus
 	runner.Status.InstanceStatus = garmRunner.Status
-	runner.Status.PoolID = poolName
+	runner.Status.PoolID = garmRunner.PoolID
 	runner.Status.ProviderFault = string(garmRunner.ProviderFault)
 	runner.Status.GitHubRunnerGroup = garmRunner.GitHubRunnerGroup
+	runner.Status.StatusMessages = runnerStatusMessages(garmRunner.StatusMessages)
+	runner.Status.UpdatedAt = runnerTime(garmRunner.UpdatedAt)
+	runner.Status.CreatedAt = nil
+	if createdAt, ok := runnerUtil.CreatedAt(*garmRunner); ok {
+		runner.Status.CreatedAt = runnerTime(createdAt)
+	}
+	runner.Status.Job = runnerJob(garmRunner.Job)
 
 	return nil
 }
 
+// observeRunnerTransition records the bootstrap and idle duration of a runner, when it changes its state from the last known status
+func observeRunnerTransition(pool *garmoperatorv1beta1.Pool, lastStatus garmoperatorv1beta1.RunnerStatus, garmRunner *params.Instance) {
+	wasIdle := lastStatus.Status == params.RunnerIdle && lastStatus.InstanceStatus == commonParams.InstanceRunning
+	isIdle := garmRunner.RunnerStatus == params.RunnerIdle && garmRunner.Status == commonParams.InstanceRunning
+
+	switch {
+	// runner finished its bootstrap
+	case !wasIdle && isIdle && (lastStatus.Status == params.RunnerPending || lastStatus.Status == params.RunnerInstalling):
+		createdAt, ok := runnerUtil.CreatedAt(*garmRunner)
+		if !ok {
+			return
+		}
+		idleSince := garmRunner.UpdatedAt
+		if idleSince.IsZero() {
+			idleSince = time.Now()
+		}
+		metrics.RunnerBootstrapDuration.WithLabelValues(pool.Namespace, pool.Name, pool.Spec.ProviderName).Observe(idleSince.Sub(createdAt).Seconds())
+	// runner picked up a job or is being deleted
+	case wasIdle && !isIdle && lastStatus.UpdatedAt != nil:
+		metrics.RunnerIdleDuration.WithLabelValues(pool.Namespace, pool.Name, pool.Spec.ProviderName).Observe(time.Since(lastStatus.UpdatedAt.Time).Seconds())
+	}
+}
+
+// maxRunnerStatusMessages is the number of the most recent status messages which are kept in the RunnerCR status
+const maxRunnerStatusMessages = 10
+
+func runnerStatusMessages(statusMessages []params.StatusMessage) []garmoperatorv1beta1.RunnerStatusMessage {
+	if len(statusMessages) == 0 {
+		return nil
+	}
+
+	messages := make([]garmoperatorv1beta1.RunnerStatusMessage, 0, len(statusMessages))
+	for _, msg := range statusMessages {
+		createdAt := metav1.Time{}
+		if t := runnerTime(msg.CreatedAt); t != nil {
+			createdAt = *t
+		}
+		messages = append(messages, garmoperatorv1beta1.RunnerStatusMessage{
+			CreatedAt:  createdAt,
+			Message:    msg.Message,
+			EventType:  string(msg.EventType),
+			EventLevel: string(msg.EventLevel),
+		})
+	}
+
+	sort.SliceStable(messages, func(i, j int) bool {
+		return messages[i].CreatedAt.Before(&messages[j].CreatedAt)
+	})
+
+	if len(messages) > maxRunnerStatusMessages {
+		messages = messages[len(messages)-maxRunnerStatusMessages:]
+	}
+	return messages
+}
+
+func runnerJob(job *params.Job) *garmoperatorv1beta1.RunnerJob {
+	if job == nil || job.ID == 0 {
+		return nil
+	}
+
+	runnerJob := &garmoperatorv1beta1.RunnerJob{
+		ID:     job.ID,
+		RunID:  job.RunID,
+		Name:   job.Name,
+		Status: job.Status,
+	}
+	if job.RepositoryName != "" {
+		runnerJob.Repository = job.RepositoryOwner + "/" + job.RepositoryName
+	}
+	if !job.StartedAt.IsZero() {
+		runnerJob.StartedAt = runnerTime(job.StartedAt)
+	}
+	return runnerJob
+}
+
+// runnerTime returns the given time as it is stored in the RunnerCR, so it doesn't differ after reading it back
+func runnerTime(t time.Time) *metav1.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &metav1.Time{Time: t.Truncate(time.Second).Local()}
+}
+
 // SetupWithManager sets up the controller with the Manager.
 func (r *RunnerReconciler) SetupWithManager(mgr ctrl.Manager, options controller.Options) error {
 	return ctrl.NewControllerManagedBy(mgr).
@@ -240,8 +558,7 @@ func (r *RunnerReconciler) PollRunnerInstances(ctx context.Context) {
 			close(r.ReconcileChan)
 			return
 		case <-ticker.C:
-			instanceClient := garmClient.NewInstanceClient()
-			err := r.EnqueueRunnerInstances(ctx, instanceClient)
+			err := r.EnqueueRunnerInstances(ctx, instanceClients())
 			if err != nil {
 				log.Error(err, "Failed polling runner instances")
 			}
@@ -249,14 +566,97 @@ func (r *RunnerReconciler) PollRunnerInstances(ctx context.Context) {
 	}
 }
 
-func (r *RunnerReconciler) EnqueueRunnerInstances(ctx context.Context, instanceClient garmClient.InstanceClient) error {
+// WatchRunnerInstances enqueues reconcile events for runners which are received from the GARM event stream.
+// While the event stream is disconnected, runner instances are polled every SyncRunnersInterval.
+// After every (re)connect, runner instances are polled once to catch up on events which might have been missed.
+func (r *RunnerReconciler) WatchRunnerInstances(ctx context.Context, stream *garmClient.EventStream) {
+	log := log.FromContext(ctx)
+	ticker := time.NewTicker(config.Config.Operator.SyncRunnersInterval)
+	defer ticker.Stop()
+
+	go stream.Run(ctx)
+	events := stream.Events()
+
+	var syncedConnection uint64
+	for {
+		select {
+		case <-ctx.Done():
+			log.Info("Closing event channel for runners...")
+			close(r.ReconcileChan)
+			return
+		case <-ticker.C:
+			connection, connected := stream.Connection()
+
+			instanceClients := polledInstanceClients(connected && connection == syncedConnection)
+			if len(instanceClients) == 0 {
+				continue
+			}
+
+			if err := r.EnqueueRunnerInstances(ctx, instanceClients); err != nil {
+				log.Error(err, "Failed polling runner instances")
+				continue
+			}
+
+			if connected {
+				syncedConnection = connection
+			}
+		case e, ok := <-events:
+			if !ok {
+				// the stream only stops once the context is done
+				events = nil
+				continue
+			}
+
+			if err := r.enqueueRunnerEvent(ctx, e); err != nil {
+				log.Error(err, "Failed handling event from GARM event stream", "entityType", e.EntityType, "operation", e.Operation)
+			}
+		}
+	}
+}
+
+func (r *RunnerReconciler) enqueueRunnerEvent(ctx context.Context, e garmClient.ChangeEvent) error {
+	switch e.EntityType {
+	case garmClient.InstanceEntityType:
+		instance := params.Instance{}
+		if err := json.Unmarshal(e.Payload, &instance); err != nil {
+			return err
+		}
+
+		// not every event carries the whole instance, so sync all runners to be on the safe side
+		if instance.Name == "" {
+			return r.EnqueueRunnerInstances(ctx, defaultInstanceClients())
+		}
+
+		pools, err := r.fetchPools(ctx)
+		if err != nil {
+			return err
+		}
+
+		// only enqueue runners belonging to known pools, in the namespace of their pool
+		for _, p := range pools.Items {
+			if p.Status.ID != "" && p.Status.ID == instance.PoolID {
+				r.enqeueRunnerEvents([]types.NamespacedName{{Namespace: p.Namespace, Name: instance.Name}})
+				return nil
+			}
+		}
+	case garmClient.PoolEntityType:
+		// all runners of a deleted pool are gone as well
+		return r.EnqueueRunnerInstances(ctx, defaultInstanceClients())
+	}
+
+	return nil
+}
+
+// EnqueueRunnerInstances enqueues reconcile events for all runners of pools which are managed by one of the given GARM servers.
+// instanceClients are keyed by the name of the GARM server.
+func (r *RunnerReconciler) EnqueueRunnerInstances(ctx context.Context, instanceClients map[string]garmClient.InstanceClient) error {
 	pools, err := r.fetchPools(ctx)
 	if err != nil {
 		return err
 	}
 
 	// fetching runners by pools to ensure only runners belonging to pools in same namespace are being shown
-	garmRunnerInstances, err := r.fetchRunnerInstancesByNamespacedPools(instanceClient, pools)
+	garmRunnerInstances, skippedPools, err := r.fetchRunnerInstancesByNamespacedPools(instanceClients, pools)
 	if err != nil {
 		return err
 	}
@@ -267,14 +667,26 @@ func (r *RunnerReconciler) EnqueueRunnerInstances(ctx context.Context, instanceC
 		return err
 	}
 
-	var runnerCRNameList []string
+	var runnerCRNameList []types.NamespacedName
 	for _, runner := range runnerCRList.Items {
-		runnerCRNameList = append(runnerCRNameList, runner.Name)
+		// runners of pools on other GARM servers haven't been fetched, so they must not be deleted
+		if skippedPools[types.NamespacedName{Namespace: runner.Namespace, Name: runner.Labels[key.PoolLabel]}] {
+			continue
+		}
+		runnerCRNameList = append(runnerCRNameList, types.NamespacedName{Namespace: runner.Namespace, Name: runner.Name})
 	}
 
-	var runnerInstanceNameList []string
+	poolNamespaces := map[string]string{}
+	for _, p := range pools.Items {
+		if p.Status.ID != "" {
+			poolNamespaces[p.Status.ID] = p.Namespace
+		}
+	}
+
+	// runners are mapped to the namespace of their pool
+	var runnerInstanceNameList []types.NamespacedName
 	for _, runner := range garmRunnerInstances {
-		runnerInstanceNameList = append(runnerInstanceNameList, strings.ToLower(runner.Name))
+		runnerInstanceNameList = append(runnerInstanceNameList, types.NamespacedName{Namespace: poolNamespaces[runner.PoolID], Name: strings.ToLower(runner.Name)})
 	}
 
 	runnersToDelete := getRunnerDiff(runnerCRNameList, runnerInstanceNameList)
@@ -285,12 +697,12 @@ func (r *RunnerReconciler) EnqueueRunnerInstances(ctx context.Context, instanceC
 	return nil
 }
 
-func (r *RunnerReconciler) enqeueRunnerEvents(runners []string) {
+func (r *RunnerReconciler) enqeueRunnerEvents(runners []types.NamespacedName) {
 	for _, runner := range runners {
 		runnerObj := garmoperatorv1beta1.Runner{
 			ObjectMeta: metav1.ObjectMeta{
-				Name:      strings.ToLower(runner),
-				Namespace: config.Config.Operator.WatchNamespace,
+				Name:      strings.ToLower(runner.Name),
+				Namespace: runner.Namespace,
 			},
 		}
 
@@ -311,24 +723,67 @@ func (r *RunnerReconciler) fetchPools(ctx context.Context) (*garmoperatorv1beta1
 	return pools, nil
 }
 
-func (r *RunnerReconciler) fetchRunnerInstancesByNamespacedPools(instanceClient garmClient.InstanceClient, pools *garmoperatorv1beta1.PoolList) (params.Instances, error) {
+// fetchRunnerInstancesByNamespacedPools returns the runners of all pools and the pools
+// which have been skipped because their GARM server isn't part of instanceClients.
+func (r *RunnerReconciler) fetchRunnerInstancesByNamespacedPools(instanceClients map[string]garmClient.InstanceClient, pools *garmoperatorv1beta1.PoolList) (params.Instances, map[types.NamespacedName]bool, error) {
 	garmRunnerInstances := params.Instances{}
+	skippedPools := map[types.NamespacedName]bool{}
 	for _, p := range pools.Items {
+		instanceClient, ok := instanceClients[poolGarmServerName(&p)]
+		if !ok {
+			skippedPools[types.NamespacedName{Namespace: p.Namespace, Name: p.Name}] = true
+			continue
+		}
 		if p.Status.ID == "" {
 			continue
 		}
 		poolRunners, err := instanceClient.ListPoolInstances(instances.NewListPoolInstancesParams().WithPoolID(p.Status.ID))
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 		garmRunnerInstances = append(garmRunnerInstances, poolRunners.Payload...)
 	}
-	return garmRunnerInstances, nil
+	return garmRunnerInstances, skippedPools, nil
+}
+
+// instanceClients returns an instance client for the default and all registered GARM servers, keyed by the server name
+func instanceClients() map[string]garmClient.InstanceClient {
+	clients := map[string]garmClient.InstanceClient{}
+	for _, server := range garmClient.Servers() {
+		clients[server.Name()] = garmClient.NewInstanceClient(server)
+	}
+	return clients
+}
+
+// polledInstanceClients returns an instance client for every GARM server whose runners have to be polled, keyed by the server name.
+// The event stream is only consumed from the default GARM server, so runners of additional GARM servers are always polled.
+// The default GARM server is polled as well, unless its runners have been synced since the event stream (re)connected.
+func polledInstanceClients(streamSynced bool) map[string]garmClient.InstanceClient {
+	clients := instanceClients()
+	if streamSynced {
+		delete(clients, garmClient.DefaultServerName)
+	}
+	return clients
+}
+
+// defaultInstanceClients returns an instance client for the default GARM server, which serves the event stream
+func defaultInstanceClients() map[string]garmClient.InstanceClient {
+	return map[string]garmClient.InstanceClient{
+		garmClient.DefaultServerName: garmClient.NewInstanceClient(garmClient.Client),
+	}
+}
+
+// poolGarmServerName returns the name of the GARM server which manages the pool
+func poolGarmServerName(pool *garmoperatorv1beta1.Pool) string {
+	if pool.Spec.GarmServerRef == nil || pool.Spec.GarmServerRef.Name == "" {
+		return garmClient.DefaultServerName
+	}
+	return garmServerName(pool.Namespace, pool.Spec.GarmServerRef.Name)
 }
 
-func getRunnerDiff(runnerCRs, garmRunners []string) []string {
-	cache := make(map[string]struct{})
-	var diff []string
+func getRunnerDiff(runnerCRs, garmRunners []types.NamespacedName) []types.NamespacedName {
+	cache := make(map[types.NamespacedName]struct{})
+	var diff []types.NamespacedName
 
 	for _, runner := range garmRunners {
 		cache[runner] = struct{}{}
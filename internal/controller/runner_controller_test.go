@@ -3,6 +3,7 @@ package controller
 
 import (
 	"context"
+	"errors"
 	"reflect"
 	"strings"
 	"testing"
@@ -11,6 +12,8 @@ import (
 	commonParams "github.com/cloudbase/garm-provider-common/params"
 	"github.com/cloudbase/garm/client/instances"
 	"github.com/cloudbase/garm/params"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
 	corev1 "k8s.io/api/core/v1"
@@ -25,15 +28,23 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/event"
 
 	garmoperatorv1beta1 "github.com/mercedes-benz/garm-operator/api/v1beta1"
+	garmClient "github.com/mercedes-benz/garm-operator/pkg/client"
 	"github.com/mercedes-benz/garm-operator/pkg/client/key"
 	"github.com/mercedes-benz/garm-operator/pkg/client/mock"
+	"github.com/mercedes-benz/garm-operator/pkg/conditions"
 	"github.com/mercedes-benz/garm-operator/pkg/config"
+	"github.com/mercedes-benz/garm-operator/pkg/garmfake"
+	"github.com/mercedes-benz/garm-operator/pkg/metrics"
 )
 
 func TestRunnerReconciler_reconcileCreate(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	defer mockCtrl.Finish()
 
+	createdAt := time.Date(2024, time.March, 4, 10, 0, 0, 0, time.UTC)
+	updatedAt := createdAt.Add(5 * time.Minute)
+	jobStartedAt := createdAt.Add(3 * time.Minute)
+
 	tests := []struct {
 		name              string
 		req               ctrl.Request
@@ -58,7 +69,8 @@ func TestRunnerReconciler_reconcileCreate(t *testing.T) {
 					},
 					ObjectMeta: metav1.ObjectMeta{
 						Name:      "my-enterprise-pool",
-						Namespace: "test-namespace",
+						Namespace: "runner",
+						UID:       "0b5f3a3e-8d5c-4c1e-9f0e-6e2b7c9d1a2f",
 					},
 					Spec: garmoperatorv1beta1.PoolSpec{
 						GitHubScopeRef: corev1.TypedLocalObjectReference{
@@ -95,7 +107,21 @@ func TestRunnerReconciler_reconcileCreate(t *testing.T) {
 						PoolID:       "a46553c6-ad87-454b-b5f5-a1c468d78c1e",
 						ProviderID:   "kubernetes_external",
 						Status:       commonParams.InstanceRunning,
-						RunnerStatus: params.RunnerIdle,
+						RunnerStatus: params.RunnerActive,
+						UpdatedAt:    updatedAt,
+						StatusMessages: []params.StatusMessage{
+							{CreatedAt: updatedAt, Message: "runner successfully installed", EventType: params.StatusEvent, EventLevel: params.EventInfo},
+							{CreatedAt: createdAt, Message: "installing runner", EventType: params.StatusEvent, EventLevel: params.EventInfo},
+						},
+						Job: &params.Job{
+							ID:              42,
+							RunID:           4711,
+							Name:            "build",
+							Status:          "in_progress",
+							RepositoryOwner: "my-org",
+							RepositoryName:  "my-repo",
+							StartedAt:       jobStartedAt,
+						},
 					},
 				}
 
@@ -107,6 +133,22 @@ func TestRunnerReconciler_reconcileCreate(t *testing.T) {
 				ObjectMeta: metav1.ObjectMeta{
 					Name:      "road-runner-k8s-fy5snjcv5dzn",
 					Namespace: "runner",
+					Labels: map[string]string{
+						key.PoolLabel:         "my-enterprise-pool",
+						key.ScopeKindLabel:    "Enterprise",
+						key.ScopeNameLabel:    "my-enterprise",
+						key.OSTypeLabel:       "linux",
+						key.OSArchLabel:       "amd64",
+						key.RunnerStatusLabel: "active",
+					},
+					OwnerReferences: []metav1.OwnerReference{
+						{
+							APIVersion: garmoperatorv1beta1.GroupVersion.String(),
+							Kind:       "Pool",
+							Name:       "my-enterprise-pool",
+							UID:        "0b5f3a3e-8d5c-4c1e-9f0e-6e2b7c9d1a2f",
+						},
+					},
 					Finalizers: []string{
 						key.RunnerFinalizerName,
 					},
@@ -118,10 +160,26 @@ func TestRunnerReconciler_reconcileCreate(t *testing.T) {
 					ID:             "8215f6c6-486e-4893-84df-3231b185a148",
 					OSArch:         "amd64",
 					OSType:         "linux",
-					PoolID:         "my-enterprise-pool",
+					PoolID:         "a46553c6-ad87-454b-b5f5-a1c468d78c1e",
+					PoolRef:        &corev1.LocalObjectReference{Name: "my-enterprise-pool"},
 					ProviderID:     "kubernetes_external",
 					InstanceStatus: commonParams.InstanceRunning,
-					Status:         params.RunnerIdle,
+					Status:         params.RunnerActive,
+					StatusMessages: []garmoperatorv1beta1.RunnerStatusMessage{
+						{CreatedAt: metav1.NewTime(createdAt.Local()), Message: "installing runner", EventType: "status", EventLevel: "info"},
+						{CreatedAt: metav1.NewTime(updatedAt.Local()), Message: "runner successfully installed", EventType: "status", EventLevel: "info"},
+					},
+					CreatedAt: &metav1.Time{Time: createdAt.Local()},
+					UpdatedAt: &metav1.Time{Time: updatedAt.Local()},
+					Job: &garmoperatorv1beta1.RunnerJob{
+						ID:         42,
+						RunID:      4711,
+						Name:       "build",
+						Status:     "in_progress",
+						Repository: "my-org/my-repo",
+						StartedAt:  &metav1.Time{Time: jobStartedAt.Local()},
+					},
+					Labels: []string{"kubernetes", "linux", "arm64", "ubuntu"},
 				},
 			},
 		},
@@ -276,6 +334,48 @@ func TestRunnerReconciler_reconcileDeleteGarmRunner(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Remove Runner CR outside of the namespace of its pool, keep Runner in Garm DB",
+			req: ctrl.Request{
+				NamespacedName: types.NamespacedName{
+					Name:      "road-runner-k8s-fy5snjcv5dzn",
+					Namespace: "runner",
+				},
+			},
+			runtimeObjects: []runtime.Object{
+				&garmoperatorv1beta1.Runner{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "road-runner-k8s-fy5snjcv5dzn",
+						Namespace: "runner",
+						Finalizers: []string{
+							key.RunnerFinalizerName,
+						},
+					},
+				},
+				&garmoperatorv1beta1.Pool{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "my-enterprise-pool",
+						Namespace: "test-namespace",
+					},
+					Status: garmoperatorv1beta1.PoolStatus{
+						ID: "a46553c6-ad87-454b-b5f5-a1c468d78c1e",
+					},
+				},
+			},
+			expectGarmRequest: func(m *mock.MockInstanceClientMockRecorder) {
+				response := params.Instances{
+					params.Instance{
+						Name:         "road-runner-k8s-FY5snJcv5dzn",
+						PoolID:       "a46553c6-ad87-454b-b5f5-a1c468d78c1e",
+						Status:       commonParams.InstanceRunning,
+						RunnerStatus: params.RunnerIdle,
+					},
+				}
+
+				m.ListInstances(instances.NewListInstancesParams()).Return(&instances.ListInstancesOK{Payload: response}, nil)
+			},
+			wantErr: false,
+		},
 	}
 
 	for _, tt := range tests {
@@ -504,7 +604,7 @@ func TestRunnerReconciler_reconcileDeleteCR(t *testing.T) {
 			config.Config.Operator.WatchNamespace = "test-namespace"
 
 			go func() {
-				err = reconciler.EnqueueRunnerInstances(context.Background(), mockInstanceClient)
+				err = reconciler.EnqueueRunnerInstances(context.Background(), map[string]garmClient.InstanceClient{garmClient.DefaultServerName: mockInstanceClient})
 				if (err != nil) != tt.wantErr {
 					t.Errorf("RunnerReconciler.EnqueueRunnerInstances() error = %v, wantErr %v", err, tt.wantErr)
 					return
@@ -532,3 +632,331 @@ func TestRunnerReconciler_reconcileDeleteCR(t *testing.T) {
 		})
 	}
 }
+
+func TestRunnerReconciler_enqueueRunnerEvent(t *testing.T) {
+	pool := &garmoperatorv1beta1.Pool{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-enterprise-pool",
+			Namespace: "test-namespace",
+		},
+		Status: garmoperatorv1beta1.PoolStatus{
+			ID: "a46553c6-ad87-454b-b5f5-a1c468d78c1e",
+		},
+	}
+
+	tests := []struct {
+		name            string
+		event           garmClient.ChangeEvent
+		expectedRunners []types.NamespacedName
+	}{
+		{
+			name: "instance of pool in namespace",
+			event: garmClient.ChangeEvent{
+				EntityType: garmClient.InstanceEntityType,
+				Operation:  garmClient.UpdateOperation,
+				Payload:    []byte(`{"name":"road-runner-k8s-FY5snJcv5dzn","pool_id":"a46553c6-ad87-454b-b5f5-a1c468d78c1e"}`),
+			},
+			expectedRunners: []types.NamespacedName{{Namespace: "test-namespace", Name: "road-runner-k8s-fy5snjcv5dzn"}},
+		},
+		{
+			name: "instance of unknown pool",
+			event: garmClient.ChangeEvent{
+				EntityType: garmClient.InstanceEntityType,
+				Operation:  garmClient.CreateOperation,
+				Payload:    []byte(`{"name":"road-runner-k8s-n6KQ2Mt3k4qr","pool_id":"0f0e1c5a-1234-4e3f-9d0b-5c5f3f0b2a11"}`),
+			},
+		},
+		{
+			name: "other entity type",
+			event: garmClient.ChangeEvent{
+				EntityType: "job",
+				Operation:  garmClient.UpdateOperation,
+				Payload:    []byte(`{}`),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schemeBuilder := runtime.SchemeBuilder{
+				garmoperatorv1beta1.AddToScheme,
+			}
+
+			err := schemeBuilder.AddToScheme(scheme.Scheme)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// runners are enqueued in the namespace of their pool, also when watching all namespaces
+			config.Config.Operator.WatchNamespace = ""
+
+			client := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithRuntimeObjects(pool).Build()
+
+			reconciler := &RunnerReconciler{
+				Client:        client,
+				Scheme:        scheme.Scheme,
+				ReconcileChan: make(chan event.GenericEvent, 10),
+			}
+
+			if err := reconciler.enqueueRunnerEvent(context.Background(), tt.event); err != nil {
+				t.Fatal(err)
+			}
+			close(reconciler.ReconcileChan)
+
+			var runners []types.NamespacedName
+			for e := range reconciler.ReconcileChan {
+				runners = append(runners, types.NamespacedName{Namespace: e.Object.GetNamespace(), Name: e.Object.GetName()})
+			}
+
+			assert.Equal(t, tt.expectedRunners, runners)
+		})
+	}
+}
+
+func TestRunnerReconciler_reconcileActions(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	forceRemove := true
+	noForceRemove := false
+
+	tests := []struct {
+		name              string
+		annotations       map[string]string
+		garmRunner        *params.Instance
+		expectGarmRequest func(m *mock.MockInstanceClientMockRecorder)
+		wantErr           bool
+		wantConditions    map[conditions.ConditionType]conditions.ConditionReason
+	}{
+		{
+			name:        "force delete runner",
+			annotations: map[string]string{key.ForceDeleteAnnotation: "true"},
+			garmRunner: &params.Instance{
+				Name:         "road-runner-k8s-FY5snJcv5dzn",
+				Status:       commonParams.InstanceRunning,
+				RunnerStatus: params.RunnerActive,
+			},
+			expectGarmRequest: func(m *mock.MockInstanceClientMockRecorder) {
+				m.DeleteInstance(instances.NewDeleteInstanceParams().WithInstanceName("road-runner-k8s-FY5snJcv5dzn").WithForceRemove(&forceRemove)).Return(nil)
+			},
+			wantConditions: map[conditions.ConditionType]conditions.ConditionReason{
+				conditions.RunnerAction: conditions.ForceDeleteRequestedReason,
+			},
+		},
+		{
+			name:        "force delete runner which is already being deleted",
+			annotations: map[string]string{key.ForceDeleteAnnotation: "true"},
+			garmRunner: &params.Instance{
+				Name:   "road-runner-k8s-FY5snJcv5dzn",
+				Status: commonParams.InstancePendingForceDelete,
+			},
+			expectGarmRequest: func(_ *mock.MockInstanceClientMockRecorder) {},
+			wantConditions:    map[conditions.ConditionType]conditions.ConditionReason{},
+		},
+		{
+			name:              "force delete runner which is already gone in garm",
+			annotations:       map[string]string{key.ForceDeleteAnnotation: "true"},
+			garmRunner:        nil,
+			expectGarmRequest: func(_ *mock.MockInstanceClientMockRecorder) {},
+			wantConditions:    map[conditions.ConditionType]conditions.ConditionReason{},
+		},
+		{
+			name:        "recycle idle runner",
+			annotations: map[string]string{key.RecycleAnnotation: "true"},
+			garmRunner: &params.Instance{
+				Name:         "road-runner-k8s-FY5snJcv5dzn",
+				Status:       commonParams.InstanceRunning,
+				RunnerStatus: params.RunnerIdle,
+			},
+			expectGarmRequest: func(m *mock.MockInstanceClientMockRecorder) {
+				m.DeleteInstance(instances.NewDeleteInstanceParams().WithInstanceName("road-runner-k8s-FY5snJcv5dzn").WithForceRemove(&noForceRemove)).Return(nil)
+			},
+			wantConditions: map[conditions.ConditionType]conditions.ConditionReason{
+				conditions.RunnerAction: conditions.RecycleRequestedReason,
+			},
+		},
+		{
+			name:        "recycle active runner after its job",
+			annotations: map[string]string{key.RecycleAnnotation: "true"},
+			garmRunner: &params.Instance{
+				Name:         "road-runner-k8s-FY5snJcv5dzn",
+				Status:       commonParams.InstanceRunning,
+				RunnerStatus: params.RunnerActive,
+			},
+			expectGarmRequest: func(_ *mock.MockInstanceClientMockRecorder) {},
+			wantConditions: map[conditions.ConditionType]conditions.ConditionReason{
+				conditions.RunnerAction: conditions.RecycleScheduledReason,
+			},
+		},
+		{
+			name:              "recycle runner which is already gone in garm",
+			annotations:       map[string]string{key.RecycleAnnotation: "true"},
+			garmRunner:        nil,
+			expectGarmRequest: func(_ *mock.MockInstanceClientMockRecorder) {},
+			wantConditions:    map[conditions.ConditionType]conditions.ConditionReason{},
+		},
+		{
+			name:        "recycle runner fails",
+			annotations: map[string]string{key.RecycleAnnotation: "true"},
+			garmRunner: &params.Instance{
+				Name:         "road-runner-k8s-FY5snJcv5dzn",
+				Status:       commonParams.InstanceRunning,
+				RunnerStatus: params.RunnerIdle,
+			},
+			expectGarmRequest: func(m *mock.MockInstanceClientMockRecorder) {
+				m.DeleteInstance(instances.NewDeleteInstanceParams().WithInstanceName("road-runner-k8s-FY5snJcv5dzn").WithForceRemove(&noForceRemove)).Return(errors.New("garm unavailable"))
+			},
+			wantErr: true,
+			wantConditions: map[conditions.ConditionType]conditions.ConditionReason{
+				conditions.RunnerAction: conditions.ActionFailedReason,
+			},
+		},
+		{
+			name:        "protect runner from reaping",
+			annotations: map[string]string{key.DoNotReapAnnotation: "true"},
+			garmRunner: &params.Instance{
+				Name:         "road-runner-k8s-FY5snJcv5dzn",
+				Status:       commonParams.InstanceRunning,
+				RunnerStatus: params.RunnerIdle,
+			},
+			expectGarmRequest: func(_ *mock.MockInstanceClientMockRecorder) {},
+			wantConditions: map[conditions.ConditionType]conditions.ConditionReason{
+				conditions.ReapProtection: conditions.DoNotReapReason,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reconciler := &RunnerReconciler{
+				Recorder: record.NewFakeRecorder(3),
+			}
+
+			runner := &garmoperatorv1beta1.Runner{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "road-runner-k8s-fy5snjcv5dzn",
+					Namespace:   "runner",
+					Annotations: tt.annotations,
+				},
+			}
+
+			mockInstanceClient := mock.NewMockInstanceClient(mockCtrl)
+			tt.expectGarmRequest(mockInstanceClient.EXPECT())
+
+			err := reconciler.reconcileActions(context.Background(), mockInstanceClient, runner, tt.garmRunner)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("RunnerReconciler.reconcileActions() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			gotConditions := map[conditions.ConditionType]conditions.ConditionReason{}
+			for _, condition := range runner.Status.Conditions {
+				gotConditions[conditions.ConditionType(condition.Type)] = conditions.ConditionReason(condition.Reason)
+			}
+			assert.Equal(t, tt.wantConditions, gotConditions)
+		})
+	}
+}
+
+func TestObserveRunnerTransition(t *testing.T) {
+	pool := &garmoperatorv1beta1.Pool{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-transition-pool",
+			Namespace: "test-namespace",
+		},
+		Spec: garmoperatorv1beta1.PoolSpec{
+			ProviderName: "kubernetes_external",
+		},
+	}
+	lastUpdate := metav1.NewTime(time.Now().Add(-10 * time.Minute))
+
+	bootstrapped := &params.Instance{
+		Status:       commonParams.InstanceRunning,
+		RunnerStatus: params.RunnerIdle,
+		UpdatedAt:    time.Now(),
+		StatusMessages: []params.StatusMessage{
+			{CreatedAt: time.Now().Add(-5 * time.Minute)},
+		},
+	}
+	busy := &params.Instance{
+		Status:       commonParams.InstanceRunning,
+		RunnerStatus: params.RunnerActive,
+	}
+
+	// runner which is still idle doesn't get observed
+	observeRunnerTransition(pool, garmoperatorv1beta1.RunnerStatus{Status: params.RunnerIdle, InstanceStatus: commonParams.InstanceRunning, UpdatedAt: &lastUpdate}, bootstrapped)
+	// runner finished its bootstrap
+	observeRunnerTransition(pool, garmoperatorv1beta1.RunnerStatus{Status: params.RunnerInstalling, InstanceStatus: commonParams.InstanceRunning}, bootstrapped)
+	// runner picked up a job
+	observeRunnerTransition(pool, garmoperatorv1beta1.RunnerStatus{Status: params.RunnerIdle, InstanceStatus: commonParams.InstanceRunning, UpdatedAt: &lastUpdate}, busy)
+
+	bootstrapDuration := metrics.RunnerBootstrapDuration.WithLabelValues(pool.Namespace, pool.Name, pool.Spec.ProviderName).(prometheus.Histogram)
+	idleDuration := metrics.RunnerIdleDuration.WithLabelValues(pool.Namespace, pool.Name, pool.Spec.ProviderName).(prometheus.Histogram)
+
+	assert.Equal(t, uint64(1), histogramSampleCount(t, bootstrapDuration))
+	assert.Equal(t, uint64(1), histogramSampleCount(t, idleDuration))
+}
+
+func histogramSampleCount(t *testing.T, histogram prometheus.Histogram) uint64 {
+	t.Helper()
+
+	m := &dto.Metric{}
+	if err := histogram.Write(m); err != nil {
+		t.Fatal(err)
+	}
+	return m.GetHistogram().GetSampleCount()
+}
+
+func TestPolledInstanceClients(t *testing.T) {
+	defaultServer := garmfake.NewServer(garmfake.WithInitialized())
+	defer defaultServer.Close()
+	additionalServer := garmfake.NewServer(garmfake.WithInitialized())
+	defer additionalServer.Close()
+
+	previousClient := garmClient.Client
+	defer func() { garmClient.Client = previousClient }()
+
+	if err := garmClient.CreateInstance(garmClient.GarmScopeParams{
+		BaseURL:  defaultServer.URL,
+		Username: garmfake.DefaultUsername,
+		Password: garmfake.DefaultPassword,
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	additionalServerName := garmServerName("test-namespace", "garm")
+	if _, err := garmClient.RegisterServer(additionalServerName, garmClient.GarmScopeParams{
+		BaseURL:  additionalServer.URL,
+		Username: garmfake.DefaultUsername,
+		Password: garmfake.DefaultPassword,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	defer garmClient.UnregisterServer(additionalServerName)
+
+	tests := []struct {
+		name         string
+		streamSynced bool
+		wantServers  []string
+	}{
+		{
+			name:         "event stream not synced - expect all servers to be polled",
+			streamSynced: false,
+			wantServers:  []string{garmClient.DefaultServerName, additionalServerName},
+		},
+		{
+			name:         "event stream synced - expect additional servers to be polled",
+			streamSynced: true,
+			wantServers:  []string{additionalServerName},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clients := polledInstanceClients(tt.streamSynced)
+
+			servers := make([]string, 0, len(clients))
+			for name := range clients {
+				servers = append(servers, name)
+			}
+			assert.ElementsMatch(t, tt.wantServers, servers)
+		})
+	}
+}
@@ -0,0 +1,578 @@
+// SPDX-License-Identifier: MIT
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/cloudbase/garm/client/pools"
+	"github.com/cloudbase/garm/params"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	garmoperatorv1beta1 "github.com/mercedes-benz/garm-operator/api/v1beta1"
+	"github.com/mercedes-benz/garm-operator/pkg/annotations"
+	garmClient "github.com/mercedes-benz/garm-operator/pkg/client"
+	"github.com/mercedes-benz/garm-operator/pkg/client/key"
+	"github.com/mercedes-benz/garm-operator/pkg/conditions"
+	"github.com/mercedes-benz/garm-operator/pkg/event"
+	"github.com/mercedes-benz/garm-operator/pkg/filter"
+	"github.com/mercedes-benz/garm-operator/pkg/finalizers"
+	poolUtil "github.com/mercedes-benz/garm-operator/pkg/pools"
+	"github.com/mercedes-benz/garm-operator/pkg/secret"
+)
+
+// blockedByPoolsRequeueInterval is the interval in which the deletion of a scope is retried,
+// as long as garm refuses to delete it because of its pools.
+const blockedByPoolsRequeueInterval = time.Minute
+
+// scopeObject is an enterprise, organization or repository.
+type scopeObject interface {
+	client.Object
+	conditions.ConditionStatusObject
+	garmoperatorv1beta1.GitHubScope
+	SetID(id string)
+	GetGarmServerRef() *corev1.LocalObjectReference
+	GetWebhookSecretRef() *garmoperatorv1beta1.SecretRef
+	GetWebhookSecretSpec() *garmoperatorv1beta1.WebhookSecretSpec
+	GetPoolBalancerType() params.PoolBalancerType
+	GetDeletionPolicy() garmoperatorv1beta1.DeletionPolicy
+}
+
+// ownedScope is a scope which belongs to an owner on GitHub, i.e. a repository.
+type ownedScope interface {
+	GetOwner() string
+}
+
+// webhookScope is a scope whose GitHub webhook can be installed through garm, i.e. an organization or repository.
+type webhookScope interface {
+	GetWebhookSpec() *garmoperatorv1beta1.WebhookSpec
+	GetWebhookStatus() *garmoperatorv1beta1.WebhookStatus
+	SetWebhookStatus(webhook *garmoperatorv1beta1.WebhookStatus)
+}
+
+// scopeReconciler reconciles enterprises, organizations or repositories. The EnterpriseReconciler,
+// OrganizationReconciler and RepositoryReconciler configure it for their kind, so that all scopes
+// share the same reconciliation logic.
+type scopeReconciler[T scopeObject] struct {
+	client.Client
+	Recorder record.EventRecorder
+
+	kind        garmoperatorv1beta1.GitHubScopeKind
+	finalizer   string
+	deletingMsg string
+
+	// newObject and newList return an empty object and list of the kind
+	newObject func() T
+	newList   func() client.ObjectList
+	// status returns the status of the object, which gets updated whenever it changed during the reconciliation
+	status func(T) any
+	// newClient returns the client which manages scopes of the kind in garm
+	newClient func(garmClient.GarmClient) garmClient.ScopeClient
+}
+
+// name returns the lower case kind of the reconciled scopes for logs and events.
+func (s *scopeReconciler[T]) name() string {
+	return strings.ToLower(string(s.kind))
+}
+
+func (s *scopeReconciler[T]) Reconcile(ctx context.Context, req ctrl.Request) (res ctrl.Result, retErr error) {
+	log := log.FromContext(ctx)
+
+	scope := s.newObject()
+	err := s.Get(ctx, req.NamespacedName, scope)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			log.Info("object was not found", "name", req.Name, "namespace", req.Namespace, "kind", s.kind)
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, err
+	}
+
+	initialStatus := s.status(scope.DeepCopyObject().(T))
+
+	// Ignore objects that are paused
+	if annotations.IsPaused(scope) {
+		log.Info("Reconciliation is paused for this object")
+		return ctrl.Result{}, nil
+	}
+
+	// ensure the finalizer
+	if finalizerAdded, err := finalizers.EnsureFinalizer(ctx, s.Client, scope, s.finalizer); err != nil || finalizerAdded {
+		return ctrl.Result{}, err
+	}
+
+	// Initialize conditions to unknown if not set already
+	scope.InitializeConditions()
+
+	// always update the status
+	defer func() {
+		if !reflect.DeepEqual(s.status(scope), initialStatus) {
+			if err := s.Status().Update(ctx, scope); err != nil {
+				log.Error(err, "failed to update status")
+				res = ctrl.Result{}
+				retErr = err
+			}
+		}
+	}()
+
+	garmServer, err := garmServerClient(ctx, s.Client, scope.GetNamespace(), scope.GetGarmServerRef())
+	if err != nil {
+		event.Error(s.Recorder, scope, err.Error())
+		conditions.MarkFalse(scope, conditions.ReadyCondition, conditions.GarmServerRefNotReadyReason, err.Error())
+		return ctrl.Result{}, err
+	}
+
+	if result, unavailable := requeueIfGarmUnavailable(ctx, scope, garmServer); unavailable {
+		return result, nil
+	}
+
+	scopeClient := s.newClient(garmServer)
+
+	// Handle deleted scopes
+	if !scope.GetDeletionTimestamp().IsZero() {
+		res, err = s.reconcileDelete(ctx, scopeClient, garmClient.NewPoolClient(garmServer), scope)
+		return handleGarmUnavailable(ctx, scope, res, err)
+	}
+
+	res, err = s.reconcileNormal(ctx, scopeClient, scope)
+	return handleGarmUnavailable(ctx, scope, res, err)
+}
+
+func (s *scopeReconciler[T]) reconcileNormal(ctx context.Context, scopeClient garmClient.ScopeClient, scope T) (ctrl.Result, error) {
+	log := log.FromContext(ctx)
+	log.WithValues(s.name(), scope.GetName())
+
+	webhookSecret, err := secret.FetchWebhookSecret(ctx, s.Client, scope, scope.GetWebhookSecretRef(), scope.GetWebhookSecretSpec())
+	if err != nil {
+		event.Error(s.Recorder, scope, err.Error())
+		conditions.MarkFalse(scope, conditions.ReadyCondition, conditions.FetchingWebhookSecretRefFailedReason, err.Error())
+		conditions.MarkFalse(scope, conditions.WebhookSecretReference, conditions.FetchingWebhookSecretRefFailedReason, err.Error())
+		return ctrl.Result{}, err
+	}
+	conditions.MarkTrue(scope, conditions.WebhookSecretReference, conditions.FetchingWebhookSecretRefSuccessReason, "")
+
+	if webhookSecret.Rotated {
+		event.Info(s.Recorder, scope, "generated new webhook secret")
+		// the webhook on GitHub gets reinstalled with the new webhook secret
+		if hooks, ok := any(scope).(webhookScope); ok && hooks.GetWebhookStatus() != nil {
+			hooks.GetWebhookStatus().SecretOutdated = true
+		}
+		if err := annotations.Remove(ctx, s.Client, scope, key.RotateWebhookSecretAnnotation); err != nil {
+			event.Error(s.Recorder, scope, err.Error())
+			conditions.MarkFalse(scope, conditions.ReadyCondition, conditions.ReconcileErrorReason, err.Error())
+			return ctrl.Result{}, err
+		}
+	}
+
+	credentials, err := s.getCredentialsRef(ctx, scope)
+	if err != nil {
+		event.Error(s.Recorder, scope, err.Error())
+		conditions.MarkFalse(scope, conditions.ReadyCondition, conditions.FetchingGithubCredentialsRefFailedReason, err.Error())
+		conditions.MarkFalse(scope, conditions.GithubCredentialsReference, conditions.FetchingGithubCredentialsRefFailedReason, err.Error())
+		return ctrl.Result{}, err
+	}
+	conditions.MarkTrue(scope, conditions.GithubCredentialsReference, conditions.FetchingGithubCredentialsRefSuccessReason, "")
+
+	garmScope, err := s.getExistingGarmScope(ctx, scopeClient, scope)
+	if err != nil {
+		event.Error(s.Recorder, scope, err.Error())
+		conditions.MarkFalse(scope, conditions.ReadyCondition, conditions.GarmAPIErrorReason, err.Error())
+		return ctrl.Result{}, err
+	}
+
+	// create scope on garm side if it does not exist
+	if reflect.ValueOf(garmScope).IsZero() {
+		garmScope, err = s.createScope(ctx, scopeClient, scope, webhookSecret.Value)
+		if err != nil {
+			event.Error(s.Recorder, scope, err.Error())
+			conditions.MarkFalse(scope, conditions.ReadyCondition, conditions.GarmAPIErrorReason, err.Error())
+			return ctrl.Result{}, err
+		}
+	}
+
+	// update scope anytime
+	garmScope, err = s.updateScope(ctx, scopeClient, garmScope.ID, params.UpdateEntityParams{
+		CredentialsName:  credentials.Name,
+		WebhookSecret:    webhookSecret.Value,
+		PoolBalancerType: scope.GetPoolBalancerType(),
+	})
+	if err != nil {
+		event.Error(s.Recorder, scope, err.Error())
+		conditions.MarkFalse(scope, conditions.ReadyCondition, conditions.GarmAPIErrorReason, err.Error())
+		return ctrl.Result{}, err
+	}
+
+	// set and update scope status
+	scope.SetID(garmScope.ID)
+	conditions.MarkTrue(scope, conditions.ReadyCondition, conditions.SuccessfulReconcileReason, "")
+	poolManagerRetry := reconcilePoolManagerStatus(s.Recorder, scope, s.kind, garmScope.PoolManagerStatus)
+
+	if err := s.reconcileWebhook(ctx, scopeClient, scope); err != nil {
+		event.Error(s.Recorder, scope, err.Error())
+		conditions.MarkFalse(scope, conditions.Webhook, conditions.WebhookInstallFailedReason, err.Error())
+		return ctrl.Result{}, err
+	}
+
+	log.Info(fmt.Sprintf("reconciling %s successfully done", s.name()))
+
+	return ctrl.Result{RequeueAfter: nextRequeue(poolManagerRetry, webhookSecret.RotateAfter)}, nil
+}
+
+func (s *scopeReconciler[T]) createScope(ctx context.Context, scopeClient garmClient.ScopeClient, scope T, webhookSecret string) (garmClient.Scope, error) {
+	log := log.FromContext(ctx)
+	log.WithValues(s.name(), scope.GetName())
+
+	log.Info(fmt.Sprintf("%s doesn't exist on garm side. Creating new %s in garm.", s.kind, s.name()))
+	event.Creating(s.Recorder, scope, fmt.Sprintf("%s doesn't exist on garm side", s.name()))
+
+	createParams := garmClient.CreateScopeParams{
+		Name:             scope.GetName(),
+		CredentialsName:  scope.GetCredentialsName(),
+		WebhookSecret:    webhookSecret, // gh hook secret
+		PoolBalancerType: scope.GetPoolBalancerType(),
+	}
+	if owned, ok := any(scope).(ownedScope); ok {
+		createParams.Owner = owned.GetOwner()
+	}
+
+	retValue, err := scopeClient.CreateScope(createParams)
+	if err != nil {
+		log.V(1).Info(fmt.Sprintf("client.CreateScope error: %s", err))
+		return garmClient.Scope{}, err
+	}
+
+	log.V(1).Info(fmt.Sprintf("%s %s created - return Value %v", s.name(), scope.GetName(), retValue))
+
+	log.Info(fmt.Sprintf("creating %s in garm succeeded", s.name()))
+	event.Info(s.Recorder, scope, fmt.Sprintf("creating %s in garm succeeded", s.name()))
+
+	return retValue, nil
+}
+
+func (s *scopeReconciler[T]) updateScope(ctx context.Context, scopeClient garmClient.ScopeClient, statusID string, updateParams params.UpdateEntityParams) (garmClient.Scope, error) {
+	log := log.FromContext(ctx)
+	log.V(1).Info(fmt.Sprintf("update credentials and webhook secret in garm %s", s.name()))
+
+	// update credentials and webhook secret
+	retValue, err := scopeClient.UpdateScope(statusID, updateParams)
+	if err != nil {
+		log.V(1).Info(fmt.Sprintf("client.UpdateScope error: %s", err))
+		return garmClient.Scope{}, err
+	}
+
+	return retValue, nil
+}
+
+// reconcileWebhook installs the GitHub webhook of the scope through garm if spec.webhook.install is set
+// and removes a previously installed webhook if it isn't anymore.
+// Scopes which don't support webhooks are left untouched.
+func (s *scopeReconciler[T]) reconcileWebhook(ctx context.Context, scopeClient garmClient.ScopeClient, scope T) error {
+	log := log.FromContext(ctx)
+
+	hooks, ok := any(scope).(webhookScope)
+	if !ok {
+		return nil
+	}
+	hookClient, ok := scopeClient.(garmClient.ScopeWebhookClient)
+	if !ok {
+		return nil
+	}
+
+	webhook := hooks.GetWebhookSpec()
+	if webhook == nil || !webhook.Install {
+		if hooks.GetWebhookStatus() != nil {
+			if err := s.uninstallWebhook(ctx, hookClient, scope); err != nil {
+				return err
+			}
+		}
+		conditions.Remove(scope, conditions.Webhook)
+		return nil
+	}
+
+	hookInfo, err := hookClient.GetScopeWebhookInfo(scope.GetID())
+	if err != nil {
+		log.V(1).Info(fmt.Sprintf("client.GetScopeWebhookInfo error: %s", err))
+		// only a missing webhook gets installed, otherwise a transient error would install it another time
+		if !garmClient.IsNotFoundError(err) {
+			return fmt.Errorf("fetching webhook info: %w", err)
+		}
+		hookInfo = params.HookInfo{}
+	}
+
+	// the TLS verification and the secret of an existing webhook can only be changed by reinstalling it
+	secretOutdated := hooks.GetWebhookStatus() != nil && hooks.GetWebhookStatus().SecretOutdated
+	if hookInfo.ID != 0 && (hookInfo.InsecureSSL != webhook.InsecureSSL || secretOutdated) {
+		if err := s.uninstallWebhook(ctx, hookClient, scope); err != nil {
+			return err
+		}
+		hookInfo = params.HookInfo{}
+	}
+
+	if hookInfo.ID == 0 {
+		log.Info("installing webhook on GitHub")
+		hookInfo, err = hookClient.InstallScopeWebhook(scope.GetID(), params.InstallWebhookParams{
+			WebhookEndpointType: params.WebhookEndpointDirect,
+			InsecureSSL:         webhook.InsecureSSL,
+		})
+		if err != nil {
+			log.V(1).Info(fmt.Sprintf("client.InstallScopeWebhook error: %s", err))
+			return fmt.Errorf("installing webhook: %w", err)
+		}
+		event.Info(s.Recorder, scope, "webhook installed on GitHub")
+	}
+
+	hooks.SetWebhookStatus(&garmoperatorv1beta1.WebhookStatus{
+		ID:          hookInfo.ID,
+		URL:         hookInfo.URL,
+		Active:      hookInfo.Active,
+		InsecureSSL: hookInfo.InsecureSSL,
+	})
+	conditions.MarkTrue(scope, conditions.Webhook, conditions.WebhookInstalledReason, "")
+
+	return nil
+}
+
+func (s *scopeReconciler[T]) uninstallWebhook(ctx context.Context, hookClient garmClient.ScopeWebhookClient, scope T) error {
+	log := log.FromContext(ctx)
+	log.Info("uninstalling webhook from GitHub")
+
+	err := hookClient.UninstallScopeWebhook(scope.GetID())
+	if err != nil {
+		log.V(1).Info(fmt.Sprintf("client.UninstallScopeWebhook error: %s", err))
+		return fmt.Errorf("uninstalling webhook: %w", err)
+	}
+
+	if hooks, ok := any(scope).(webhookScope); ok {
+		hooks.SetWebhookStatus(nil)
+	}
+	event.Info(s.Recorder, scope, "webhook uninstalled from GitHub")
+
+	return nil
+}
+
+func (s *scopeReconciler[T]) getExistingGarmScope(ctx context.Context, scopeClient garmClient.ScopeClient, scope T) (garmClient.Scope, error) {
+	log := log.FromContext(ctx)
+	log.WithValues(s.name(), scope.GetName())
+
+	log.Info(fmt.Sprintf("checking if %s already exists on garm side", s.name()))
+	garmScopes, err := scopeClient.ListScopes()
+	if err != nil {
+		return garmClient.Scope{}, fmt.Errorf("getExistingGarmScope: %w", err)
+	}
+
+	log.Info(fmt.Sprintf("%d %s scopes discovered", len(garmScopes), s.name()))
+	log.V(1).Info(fmt.Sprintf("%s scopes on garm side: %#v", s.name(), garmScopes))
+
+	for _, garmScope := range garmScopes {
+		if strings.EqualFold(garmScope.Name, scope.GetName()) {
+			return garmScope, nil
+		}
+	}
+	return garmClient.Scope{}, nil
+}
+
+func (s *scopeReconciler[T]) reconcileDelete(ctx context.Context, scopeClient garmClient.ScopeClient, poolClient garmClient.PoolClient, scope T) (ctrl.Result, error) {
+	log := log.FromContext(ctx)
+	log.WithValues(s.name(), scope.GetName())
+
+	log.Info(fmt.Sprintf("starting %s deletion", s.name()))
+	event.Deleting(s.Recorder, scope, fmt.Sprintf("starting %s deletion", s.name()))
+	conditions.MarkFalse(scope, conditions.ReadyCondition, conditions.DeletingReason, s.deletingMsg)
+
+	// pools have to be deleted before the scope they belong to
+	pools, err := garmoperatorv1beta1.PoolsReferencingScope(ctx, s.Client, scope.GetNamespace(), s.kind, scope.GetName())
+	if err != nil {
+		event.Error(s.Recorder, scope, err.Error())
+		conditions.MarkFalse(scope, conditions.ReadyCondition, conditions.ReconcileErrorReason, err.Error())
+		return ctrl.Result{}, err
+	}
+	if len(pools) > 0 {
+		s.markBlockedByPools(scope, conditions.PoolsReferenceScopeReason, fmt.Sprintf("%s is still referenced by pools: %s", s.name(), strings.Join(pools, ", ")))
+		// the scope gets requeued as soon as one of its pools is gone
+		return ctrl.Result{}, nil
+	}
+
+	if scope.GetDeletionPolicy() == garmoperatorv1beta1.DeletionPolicyOrphan {
+		log.Info(fmt.Sprintf("leaving %s in garm due to deletion policy %s", s.name(), garmoperatorv1beta1.DeletionPolicyOrphan))
+		event.Info(s.Recorder, scope, fmt.Sprintf("%s is left in garm", s.name()))
+	} else {
+		if err := s.deleteGarmScope(ctx, scopeClient, scope); err != nil {
+			// garm refuses to delete the scope as long as it still has pools, which aren't managed by a Pool
+			if garmClient.IsConflictError(err) {
+				garmPools, listErr := s.listGarmPools(poolClient, scope)
+				if listErr != nil {
+					log.Error(listErr, "failed to list pools in garm")
+					garmPools = []string{err.Error()}
+				}
+				s.markBlockedByPools(scope, conditions.GarmPoolsExistReason, fmt.Sprintf("%s still has pools in garm: %s", s.name(), strings.Join(garmPools, ", ")))
+				return ctrl.Result{RequeueAfter: blockedByPoolsRequeueInterval}, nil
+			}
+			event.Error(s.Recorder, scope, err.Error())
+			conditions.MarkFalse(scope, conditions.ReadyCondition, conditions.GarmAPIErrorReason, err.Error())
+			return ctrl.Result{}, err
+		}
+	}
+	conditions.Remove(scope, conditions.BlockedByPools)
+
+	if controllerutil.ContainsFinalizer(scope, s.finalizer) {
+		controllerutil.RemoveFinalizer(scope, s.finalizer)
+
+		// update immediately
+		if err := s.Update(ctx, scope); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	deletePoolManagerMetric(scope, s.kind)
+
+	log.Info(fmt.Sprintf("%s deletion done", s.name()))
+
+	return ctrl.Result{}, nil
+}
+
+// deleteGarmScope deletes the scope and its webhook in garm.
+func (s *scopeReconciler[T]) deleteGarmScope(ctx context.Context, scopeClient garmClient.ScopeClient, scope T) error {
+	log := log.FromContext(ctx)
+
+	// garm doesn't remove the webhook on its own when the scope gets deleted
+	hooks, supportsWebhook := any(scope).(webhookScope)
+	hookClient, clientSupportsWebhook := scopeClient.(garmClient.ScopeWebhookClient)
+	if supportsWebhook && clientSupportsWebhook && hooks.GetWebhookStatus() != nil {
+		if err := s.uninstallWebhook(ctx, hookClient, scope); err != nil {
+			return err
+		}
+	}
+
+	err := scopeClient.DeleteScope(scope.GetID())
+	if err != nil {
+		log.V(1).Info(fmt.Sprintf("client.DeleteScope error: %s", err))
+		return err
+	}
+	return nil
+}
+
+// listGarmPools returns the IDs of the pools of the scope in garm.
+func (s *scopeReconciler[T]) listGarmPools(poolClient garmClient.PoolClient, scope T) ([]string, error) {
+	garmPools, err := poolClient.ListAllPools(pools.NewListPoolsParams())
+	if err != nil {
+		return nil, err
+	}
+
+	ids := []string{}
+	for _, pool := range filter.Match(garmPools.Payload, poolUtil.MatchesGitHubScope(s.kind, scope.GetID())) {
+		ids = append(ids, pool.ID)
+	}
+	return ids, nil
+}
+
+// markBlockedByPools reports that the deletion of scope waits for its pools to be deleted.
+func (s *scopeReconciler[T]) markBlockedByPools(scope T, reason conditions.ConditionReason, msg string) {
+	if condition := conditions.Get(scope, conditions.BlockedByPools); condition == nil || condition.Message != msg {
+		event.BlockedByPools(s.Recorder, scope, msg)
+	}
+	conditions.MarkTrue(scope, conditions.BlockedByPools, reason, msg)
+}
+
+func (s *scopeReconciler[T]) getCredentialsRef(ctx context.Context, scope T) (*garmoperatorv1beta1.GitHubCredential, error) {
+	creds := &garmoperatorv1beta1.GitHubCredential{}
+	err := s.Get(ctx, types.NamespacedName{
+		Namespace: scope.GetNamespace(),
+		Name:      scope.GetCredentialsName(),
+	}, creds)
+	if err != nil {
+		return creds, err
+	}
+	return creds, nil
+}
+
+// findScopesForCredentials maps GitHubCredentials to the scopes using them.
+func (s *scopeReconciler[T]) findScopesForCredentials(ctx context.Context, obj client.Object) []reconcile.Request {
+	credentials, ok := obj.(*garmoperatorv1beta1.GitHubCredential)
+	if !ok {
+		return nil
+	}
+
+	list := s.newList()
+	if err := s.List(ctx, list); err != nil {
+		return nil
+	}
+
+	items, err := meta.ExtractList(list)
+	if err != nil {
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, item := range items {
+		scope, ok := item.(T)
+		if !ok {
+			continue
+		}
+		if scope.GetCredentialsName() == credentials.Name {
+			requests = append(requests, reconcile.Request{
+				NamespacedName: types.NamespacedName{
+					Namespace: scope.GetNamespace(),
+					Name:      scope.GetName(),
+				},
+			})
+		}
+	}
+
+	return requests
+}
+
+// findDeletingScopeForPool maps a Pool to the scope it references, if the deletion of the scope
+// waits for its pools to be deleted.
+func (s *scopeReconciler[T]) findDeletingScopeForPool(ctx context.Context, obj client.Object) []reconcile.Request {
+	pool, ok := obj.(*garmoperatorv1beta1.Pool)
+	if !ok || pool.Spec.GitHubScopeRef.Kind != string(s.kind) {
+		return nil
+	}
+
+	scope := s.newObject()
+	name := types.NamespacedName{
+		Namespace: pool.Namespace,
+		Name:      pool.Spec.GitHubScopeRef.Name,
+	}
+	if err := s.Get(ctx, name, scope); err != nil || scope.GetDeletionTimestamp().IsZero() {
+		return nil
+	}
+
+	return []reconcile.Request{{NamespacedName: name}}
+}
+
+// SetupWithManager sets up the controller for the kind with the Manager.
+func (s *scopeReconciler[T]) SetupWithManager(mgr ctrl.Manager, options controller.Options) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(s.newObject()).
+		Owns(&corev1.Secret{}).
+		Watches(
+			&garmoperatorv1beta1.GitHubCredential{},
+			handler.EnqueueRequestsFromMapFunc(s.findScopesForCredentials),
+			builder.WithPredicates(predicate.ResourceVersionChangedPredicate{}),
+		).
+		Watches(
+			&garmoperatorv1beta1.Pool{},
+			handler.EnqueueRequestsFromMapFunc(s.findDeletingScopeForPool),
+			builder.WithPredicates(predicate.ResourceVersionChangedPredicate{}),
+		).
+		WithOptions(options).
+		Complete(s)
+}
@@ -0,0 +1,388 @@
+// SPDX-License-Identifier: MIT
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/cloudbase/garm/client/organizations"
+	"github.com/cloudbase/garm/client/pools"
+	"github.com/cloudbase/garm/params"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	garmoperatorv1beta1 "github.com/mercedes-benz/garm-operator/api/v1beta1"
+	garmClient "github.com/mercedes-benz/garm-operator/pkg/client"
+	"github.com/mercedes-benz/garm-operator/pkg/client/mock"
+	"github.com/mercedes-benz/garm-operator/pkg/conditions"
+)
+
+var (
+	scopeTestObjectMeta = metav1.ObjectMeta{
+		Name:      "my-scope",
+		Namespace: "default",
+	}
+	scopeTestCredentialsRef = corev1.TypedLocalObjectReference{
+		APIGroup: &garmoperatorv1beta1.GroupVersion.Group,
+		Kind:     "GitHubCredential",
+		Name:     "github-creds",
+	}
+	scopeTestWebhookSecretRef = garmoperatorv1beta1.SecretRef{
+		Name: "my-webhook-secret",
+		Key:  "webhookSecret",
+	}
+)
+
+// fakeScopeClient is a garmClient.ScopeClient which keeps the scopes in memory.
+type fakeScopeClient struct {
+	scopes    []garmClient.Scope
+	created   []garmClient.CreateScopeParams
+	deleteErr error
+}
+
+func (f *fakeScopeClient) ListScopes() ([]garmClient.Scope, error) {
+	return f.scopes, nil
+}
+
+func (f *fakeScopeClient) CreateScope(param garmClient.CreateScopeParams) (garmClient.Scope, error) {
+	f.created = append(f.created, param)
+	scope := garmClient.Scope{
+		ID:   "9e0da3cb-130b-428d-aa8a-e314d955060e",
+		Name: param.Name,
+	}
+	f.scopes = append(f.scopes, scope)
+	return scope, nil
+}
+
+func (f *fakeScopeClient) UpdateScope(id string, _ params.UpdateEntityParams) (garmClient.Scope, error) {
+	for i := range f.scopes {
+		if f.scopes[i].ID == id {
+			f.scopes[i].PoolManagerStatus.IsRunning = true
+			return f.scopes[i], nil
+		}
+	}
+	return garmClient.Scope{}, fmt.Errorf("scope %s not found", id)
+}
+
+func (f *fakeScopeClient) DeleteScope(id string) error {
+	if f.deleteErr != nil {
+		return f.deleteErr
+	}
+	for i := range f.scopes {
+		if f.scopes[i].ID == id {
+			f.scopes = append(f.scopes[:i], f.scopes[i+1:]...)
+			return nil
+		}
+	}
+	return fmt.Errorf("scope %s not found", id)
+}
+
+// scopeTestFixture configures the scope reconciler tests for one kind of scope.
+type scopeTestFixture[T scopeObject] struct {
+	newReconciler func(client.Client, record.EventRecorder) *scopeReconciler[T]
+	// newObject returns the scope "my-scope" with the given deletion policy
+	newObject func(deletionPolicy garmoperatorv1beta1.DeletionPolicy) T
+	wantOwner string
+}
+
+func TestScopeReconciler(t *testing.T) {
+	t.Run("Enterprise", func(t *testing.T) {
+		testScopeReconciler(t, scopeTestFixture[*garmoperatorv1beta1.Enterprise]{
+			newReconciler: func(c client.Client, recorder record.EventRecorder) *scopeReconciler[*garmoperatorv1beta1.Enterprise] {
+				return (&EnterpriseReconciler{Client: c, Recorder: recorder}).scopeReconciler()
+			},
+			newObject: func(deletionPolicy garmoperatorv1beta1.DeletionPolicy) *garmoperatorv1beta1.Enterprise {
+				return &garmoperatorv1beta1.Enterprise{
+					ObjectMeta: *scopeTestObjectMeta.DeepCopy(),
+					Spec: garmoperatorv1beta1.EnterpriseSpec{
+						CredentialsRef:   scopeTestCredentialsRef,
+						WebhookSecretRef: scopeTestWebhookSecretRef,
+						DeletionPolicy:   deletionPolicy,
+					},
+				}
+			},
+		})
+	})
+
+	t.Run("Organization", func(t *testing.T) {
+		testScopeReconciler(t, scopeTestFixture[*garmoperatorv1beta1.Organization]{
+			newReconciler: func(c client.Client, recorder record.EventRecorder) *scopeReconciler[*garmoperatorv1beta1.Organization] {
+				return (&OrganizationReconciler{Client: c, Recorder: recorder}).scopeReconciler()
+			},
+			newObject: func(deletionPolicy garmoperatorv1beta1.DeletionPolicy) *garmoperatorv1beta1.Organization {
+				return &garmoperatorv1beta1.Organization{
+					ObjectMeta: *scopeTestObjectMeta.DeepCopy(),
+					Spec: garmoperatorv1beta1.OrganizationSpec{
+						CredentialsRef:   scopeTestCredentialsRef,
+						WebhookSecretRef: scopeTestWebhookSecretRef,
+						DeletionPolicy:   deletionPolicy,
+					},
+				}
+			},
+		})
+	})
+
+	t.Run("Repository", func(t *testing.T) {
+		testScopeReconciler(t, scopeTestFixture[*garmoperatorv1beta1.Repository]{
+			newReconciler: func(c client.Client, recorder record.EventRecorder) *scopeReconciler[*garmoperatorv1beta1.Repository] {
+				return (&RepositoryReconciler{Client: c, Recorder: recorder}).scopeReconciler()
+			},
+			newObject: func(deletionPolicy garmoperatorv1beta1.DeletionPolicy) *garmoperatorv1beta1.Repository {
+				return &garmoperatorv1beta1.Repository{
+					ObjectMeta: *scopeTestObjectMeta.DeepCopy(),
+					Spec: garmoperatorv1beta1.RepositorySpec{
+						CredentialsRef:   scopeTestCredentialsRef,
+						WebhookSecretRef: scopeTestWebhookSecretRef,
+						Owner:            "my-owner",
+						DeletionPolicy:   deletionPolicy,
+					},
+				}
+			},
+			wantOwner: "my-owner",
+		})
+	})
+}
+
+// testScopeReconciler runs the tests of the scope reconciler which apply to every kind of scope.
+func testScopeReconciler[T scopeObject](t *testing.T, fixture scopeTestFixture[T]) {
+	t.Helper()
+
+	kind := fixture.newReconciler(nil, nil).kind
+	finalizer := fixture.newReconciler(nil, nil).finalizer
+
+	newClient := func(t *testing.T, objects ...runtime.Object) client.Client {
+		scheme := runtime.NewScheme()
+		require.NoError(t, corev1.AddToScheme(scheme))
+		require.NoError(t, garmoperatorv1beta1.AddToScheme(scheme))
+
+		objects = append(objects,
+			&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "my-webhook-secret",
+					Namespace: "default",
+				},
+				Data: map[string][]byte{
+					"webhookSecret": []byte("foobar"),
+				},
+			},
+			&garmoperatorv1beta1.GitHubCredential{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "github-creds",
+					Namespace: "default",
+				},
+			},
+		)
+		return fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(objects...).WithStatusSubresource(fixture.newObject("")).Build()
+	}
+
+	newPool := func(name string, deleting bool) *garmoperatorv1beta1.Pool {
+		pool := &garmoperatorv1beta1.Pool{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "default",
+			},
+			Spec: garmoperatorv1beta1.PoolSpec{
+				GitHubScopeRef: corev1.TypedLocalObjectReference{
+					APIGroup: &garmoperatorv1beta1.GroupVersion.Group,
+					Kind:     string(kind),
+					Name:     "my-scope",
+				},
+			},
+		}
+		if deleting {
+			pool.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+			pool.Finalizers = []string{"garm-operator.mercedes-benz.com/pool"}
+		}
+		return pool
+	}
+
+	t.Run("create scope in garm", func(t *testing.T) {
+		scope := fixture.newObject("")
+		s := fixture.newReconciler(newClient(t, scope.DeepCopyObject()), record.NewFakeRecorder(3))
+
+		scopeClient := &fakeScopeClient{
+			scopes: []garmClient.Scope{
+				{
+					ID:   "e1dbf9a6-a9f6-4594-a5ac-12345",
+					Name: "another-non-operator-managed-scope",
+				},
+			},
+		}
+
+		scope.InitializeConditions()
+		res, err := s.reconcileNormal(context.Background(), scopeClient, scope)
+		require.NoError(t, err)
+		assert.Zero(t, res.RequeueAfter)
+
+		assert.Equal(t, []garmClient.CreateScopeParams{
+			{
+				Owner:           fixture.wantOwner,
+				Name:            "my-scope",
+				CredentialsName: "github-creds",
+				WebhookSecret:   "foobar",
+			},
+		}, scopeClient.created)
+
+		assert.Equal(t, "9e0da3cb-130b-428d-aa8a-e314d955060e", scope.GetID())
+		assert.Equal(t, metav1.ConditionTrue, conditions.Get(scope, conditions.ReadyCondition).Status)
+		assert.Equal(t, metav1.ConditionTrue, conditions.Get(scope, conditions.PoolManager).Status)
+		assert.Nil(t, conditions.Get(scope, conditions.Webhook))
+
+		deletePoolManagerMetric(scope, kind)
+	})
+
+	deleteTests := []struct {
+		name             string
+		deletionPolicy   garmoperatorv1beta1.DeletionPolicy
+		pools            []runtime.Object
+		deleteErr        error
+		garmPools        params.Pools
+		wantGarmScopes   int
+		wantRequeueAfter time.Duration
+		wantBlocked      conditions.ConditionReason
+		wantMessage      string
+	}{
+		{
+			name:           "delete scope in garm",
+			wantGarmScopes: 0,
+		},
+		{
+			name:           "delete scope in garm - pools in deletion don't block",
+			pools:          []runtime.Object{newPool("my-pool", true)},
+			wantGarmScopes: 0,
+		},
+		{
+			name:           "orphan scope in garm",
+			deletionPolicy: garmoperatorv1beta1.DeletionPolicyOrphan,
+			wantGarmScopes: 1,
+		},
+		{
+			name:           "deletion is blocked by pools",
+			pools:          []runtime.Object{newPool("my-pool", false), newPool("my-other-pool", false)},
+			wantGarmScopes: 1,
+			wantBlocked:    conditions.PoolsReferenceScopeReason,
+		},
+		{
+			name:           "orphaning is blocked by pools",
+			deletionPolicy: garmoperatorv1beta1.DeletionPolicyOrphan,
+			pools:          []runtime.Object{newPool("my-pool", false)},
+			wantGarmScopes: 1,
+			wantBlocked:    conditions.PoolsReferenceScopeReason,
+		},
+		{
+			name:      "deletion is blocked by pools in garm",
+			deleteErr: organizations.NewDeleteOrgDefault(409),
+			garmPools: params.Pools{
+				{
+					ID:           "fb2bceeb-f74d-435d-9648-626c75cb23ce",
+					EnterpriseID: "9e0da3cb-130b-428d-aa8a-e314d955060e",
+					OrgID:        "9e0da3cb-130b-428d-aa8a-e314d955060e",
+					RepoID:       "9e0da3cb-130b-428d-aa8a-e314d955060e",
+				},
+				{
+					ID:           "0a7a5bde-5d4c-4d6e-8a4a-7e5b0f7d2c11",
+					EnterpriseID: "93068607-2d0d-4b76-a950-0e40d31955b8",
+					OrgID:        "93068607-2d0d-4b76-a950-0e40d31955b8",
+					RepoID:       "93068607-2d0d-4b76-a950-0e40d31955b8",
+				},
+			},
+			wantGarmScopes:   1,
+			wantRequeueAfter: blockedByPoolsRequeueInterval,
+			wantBlocked:      conditions.GarmPoolsExistReason,
+			wantMessage:      "still has pools in garm: fb2bceeb-f74d-435d-9648-626c75cb23ce",
+		},
+	}
+	for _, tt := range deleteTests {
+		t.Run(tt.name, func(t *testing.T) {
+			scope := fixture.newObject(tt.deletionPolicy)
+			scope.SetID("9e0da3cb-130b-428d-aa8a-e314d955060e")
+			controllerutil.AddFinalizer(scope, finalizer)
+			c := newClient(t, append([]runtime.Object{scope}, tt.pools...)...)
+			s := fixture.newReconciler(c, record.NewFakeRecorder(3))
+
+			scopeClient := &fakeScopeClient{
+				scopes: []garmClient.Scope{
+					{
+						ID:   "9e0da3cb-130b-428d-aa8a-e314d955060e",
+						Name: "my-scope",
+					},
+				},
+				deleteErr: tt.deleteErr,
+			}
+
+			poolClient := mock.NewMockPoolClient(gomock.NewController(t))
+			if tt.garmPools != nil {
+				poolClient.EXPECT().ListAllPools(pools.NewListPoolsParams()).Return(&pools.ListPoolsOK{Payload: tt.garmPools}, nil)
+			}
+
+			scope.InitializeConditions()
+			res, err := s.reconcileDelete(context.Background(), scopeClient, poolClient, scope)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantRequeueAfter, res.RequeueAfter)
+			assert.Len(t, scopeClient.scopes, tt.wantGarmScopes)
+
+			stored := fixture.newObject("")
+			require.NoError(t, c.Get(context.Background(), client.ObjectKeyFromObject(scope), stored))
+
+			if tt.wantBlocked == "" {
+				assert.Empty(t, stored.GetFinalizers())
+				return
+			}
+
+			assert.Equal(t, []string{finalizer}, stored.GetFinalizers())
+			blocked := conditions.Get(scope, conditions.BlockedByPools)
+			require.NotNil(t, blocked)
+			assert.Equal(t, metav1.ConditionTrue, blocked.Status)
+			assert.Equal(t, string(tt.wantBlocked), blocked.Reason)
+			assert.Contains(t, blocked.Message, tt.wantMessage)
+		})
+	}
+
+	t.Run("find deleting scope for pool", func(t *testing.T) {
+		scope := fixture.newObject("")
+		controllerutil.AddFinalizer(scope, finalizer)
+		scope.SetDeletionTimestamp(&metav1.Time{Time: time.Now()})
+		s := fixture.newReconciler(newClient(t, scope), record.NewFakeRecorder(3))
+
+		requests := s.findDeletingScopeForPool(context.Background(), newPool("my-pool", true))
+		assert.Equal(t, []reconcile.Request{{NamespacedName: types.NamespacedName{Namespace: "default", Name: "my-scope"}}}, requests)
+
+		// scopes which aren't being deleted don't care about their pools
+		s = fixture.newReconciler(newClient(t, fixture.newObject("")), record.NewFakeRecorder(3))
+		assert.Empty(t, s.findDeletingScopeForPool(context.Background(), newPool("my-pool", true)))
+	})
+
+	t.Run("find scopes for credentials", func(t *testing.T) {
+		s := fixture.newReconciler(newClient(t, fixture.newObject("")), record.NewFakeRecorder(3))
+
+		requests := s.findScopesForCredentials(context.Background(), &garmoperatorv1beta1.GitHubCredential{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "github-creds",
+				Namespace: "default",
+			},
+		})
+		assert.Len(t, requests, 1)
+		assert.Equal(t, types.NamespacedName{Namespace: "default", Name: "my-scope"}, requests[0].NamespacedName)
+
+		requests = s.findScopesForCredentials(context.Background(), &garmoperatorv1beta1.GitHubCredential{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "other-creds",
+				Namespace: "default",
+			},
+		})
+		assert.Empty(t, requests)
+	})
+}
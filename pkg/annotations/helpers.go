@@ -3,7 +3,11 @@
 package annotations
 
 import (
+	"context"
+	"fmt"
+
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/mercedes-benz/garm-operator/pkg/client/key"
 )
@@ -13,6 +17,20 @@ func IsPaused(o metav1.Object) bool {
 	return HasAnnotation(o, key.PausedAnnotation)
 }
 
+// AdoptPool returns true if the object has the `adopt` annotation and the ID of the GARM pool to adopt.
+// The ID is empty if the GARM pool should be found by the spec of the object.
+func AdoptPool(o metav1.Object) (string, bool) {
+	if !HasAnnotation(o, key.AdoptAnnotation) {
+		return "", false
+	}
+
+	poolID := o.GetAnnotations()[key.AdoptAnnotation]
+	if poolID == "true" {
+		poolID = ""
+	}
+	return poolID, true
+}
+
 // HasAnnotation returns true if the object has the specified annotation.
 func HasAnnotation(o metav1.Object, annotation string) bool {
 	annotations := o.GetAnnotations()
@@ -22,3 +40,30 @@ func HasAnnotation(o metav1.Object, annotation string) bool {
 	_, ok := annotations[annotation]
 	return ok
 }
+
+// Remove removes the specified annotation from the object in the cluster. Only the metadata of o
+// gets updated, so pending changes to the status of o are kept.
+func Remove(ctx context.Context, c client.Client, o client.Object, annotation string) error {
+	if !HasAnnotation(o, annotation) {
+		return nil
+	}
+
+	obj, ok := o.DeepCopyObject().(client.Object)
+	if !ok {
+		return fmt.Errorf("can not remove annotation %s from %s", annotation, o.GetName())
+	}
+	patch := client.MergeFrom(obj.DeepCopyObject().(client.Object))
+
+	annotations := obj.GetAnnotations()
+	delete(annotations, annotation)
+	obj.SetAnnotations(annotations)
+
+	if err := c.Patch(ctx, obj, patch); err != nil {
+		return err
+	}
+
+	o.SetAnnotations(obj.GetAnnotations())
+	o.SetResourceVersion(obj.GetResourceVersion())
+
+	return nil
+}
@@ -4,11 +4,16 @@ package client
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
+	"reflect"
+	"sort"
 	"strings"
+	"sync"
 	"time"
 
 	garm "github.com/cloudbase/garm/client"
@@ -17,109 +22,244 @@ import (
 	"github.com/cloudbase/garm/params"
 	"github.com/go-openapi/runtime"
 	openapiRuntimeClient "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
 	"github.com/golang-jwt/jwt/v4"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
 	"github.com/mercedes-benz/garm-operator/pkg/metrics"
 )
 
+// DefaultServerName is the name of the GARM server which is configured in the operator config
+const DefaultServerName = "default"
+
+// Client is the client of the default GARM server
 var Client GarmClient
 
+var (
+	serversMux sync.RWMutex
+	servers    = map[string]*garmClient{}
+)
+
 type GarmScopeParams struct {
-	BaseURL  string
-	Username string
-	Password string
-	Debug    bool
-	Email    string
+	BaseURL      string
+	Username     string
+	Password     string
+	Debug        bool
+	Email        string
+	CACertBundle []byte
+	Transport    TransportConfig
 }
 
 type GarmClient interface {
 	GarmAPI() *garm.GarmAPI
 	Token() runtime.ClientAuthInfoWriter
+	BearerToken() string
+	BaseURL() string
+	Name() string
 	Login() error
+	RefreshToken(staleToken string) error
 	Init() error
+	CircuitOpen() (time.Duration, bool)
 }
 
 type garmClient struct {
+	name       string
 	client     *garm.GarmAPI
-	token      runtime.ClientAuthInfoWriter
 	garmParams GarmScopeParams
+	tokens     *tokenManager
+	transport  *transport
 }
 
 func (s *garmClient) GarmAPI() *garm.GarmAPI {
 	return s.client
 }
 
+// Token returns the authentication of the requests, the JWT is resolved per request
+// so a refreshed token is used right away
 func (s *garmClient) Token() runtime.ClientAuthInfoWriter {
-	return s.token
+	return runtime.ClientAuthInfoWriterFunc(func(r runtime.ClientRequest, _ strfmt.Registry) error {
+		return r.SetHeaderParam(runtime.HeaderAuthorization, "Bearer "+s.BearerToken())
+	})
 }
 
+// BearerToken returns the raw JWT which is used to authenticate against GARM
+func (s *garmClient) BearerToken() string {
+	return s.tokens.Token(s.name)
+}
+
+// BaseURL returns the URL of the GARM server
+func (s *garmClient) BaseURL() string {
+	return s.garmParams.BaseURL
+}
+
+// Name returns the name of the GARM server, which is used to label the metrics of the client
+func (s *garmClient) Name() string {
+	return s.name
+}
+
+// Login obtains a new JWT from GARM
 func (s *garmClient) Login() error {
-	metrics.TotalGarmCalls.WithLabelValues("Login").Inc()
-	authenticatedClient, authInfoWriter, err := newGarmClient(s.garmParams)
-	if err != nil {
-		metrics.GarmCallErrors.WithLabelValues("Login").Inc()
+	metrics.TotalGarmCalls.WithLabelValues(s.name, "Login").Inc()
+	if err := s.tokens.Login(s.name); err != nil {
+		metrics.GarmCallErrors.WithLabelValues(s.name, "Login").Inc()
 		return err
 	}
-	s.client = authenticatedClient
-	s.token = authInfoWriter
-
 	return nil
 }
 
+// RefreshToken obtains a new JWT from GARM after staleToken has been rejected,
+// unless the token has already been refreshed by another caller
+func (s *garmClient) RefreshToken(staleToken string) error {
+	return s.tokens.Refresh(s.name, staleToken)
+}
+
+// CircuitOpen returns true and the time until requests are sent to GARM again,
+// if the circuit breaker of the GARM server is open
+func (s *garmClient) CircuitOpen() (time.Duration, bool) {
+	return s.transport.CircuitOpen()
+}
+
 func (s *garmClient) Init() error {
 	ctx := context.Background()
-	metrics.TotalGarmCalls.WithLabelValues("Init").Inc()
-	err := initializeGarm(ctx, s.garmParams)
+	metrics.TotalGarmCalls.WithLabelValues(s.name, "Init").Inc()
+	err := initializeGarm(ctx, s.name, s.garmParams)
 	if err != nil {
-		metrics.GarmCallErrors.WithLabelValues("Init").Inc()
+		metrics.GarmCallErrors.WithLabelValues(s.name, "Init").Inc()
 		return err
 	}
+	s.tokens.SetInitialized()
 
 	return nil
 }
 
 func CreateInstance(garmParams GarmScopeParams) error {
-	Client = &garmClient{
-		garmParams: garmParams,
+	client, err := newAuthenticatedClient(DefaultServerName, garmParams)
+	if err != nil {
+		return err
+	}
+	Client = client
+	return nil
+}
+
+// RegisterServer creates a client for an additional GARM server and makes it available via Server.
+// An already registered client is reused as long as the parameters of the server didn't change.
+func RegisterServer(name string, garmParams GarmScopeParams) (GarmClient, error) {
+	serversMux.Lock()
+	defer serversMux.Unlock()
+
+	if client, ok := servers[name]; ok && reflect.DeepEqual(client.garmParams, garmParams) {
+		return client, nil
 	}
-	if err := Client.Init(); err != nil {
-		return fmt.Errorf("failed to initialize GARM: %w", err)
+
+	client, err := newAuthenticatedClient(name, garmParams)
+	if err != nil {
+		return nil, err
 	}
-	if err := Client.Login(); err != nil {
-		return fmt.Errorf("failed to login to garm client: %w", err)
+	servers[name] = client
+	return client, nil
+}
+
+// UnregisterServer removes the client of an additional GARM server
+func UnregisterServer(name string) {
+	serversMux.Lock()
+	defer serversMux.Unlock()
+
+	delete(servers, name)
+	removeTransport(name)
+	metrics.GarmJwtExpiresAt.DeleteLabelValues(name)
+}
+
+// Server returns the client of the default or a registered GARM server
+func Server(name string) (GarmClient, bool) {
+	if name == DefaultServerName {
+		return Client, Client != nil
 	}
-	return nil
+
+	serversMux.RLock()
+	defer serversMux.RUnlock()
+
+	client, ok := servers[name]
+	if !ok {
+		return nil, false
+	}
+	return client, true
+}
+
+// Servers returns the clients of the default and all registered GARM servers
+func Servers() []GarmClient {
+	serversMux.RLock()
+	defer serversMux.RUnlock()
+
+	names := make([]string, 0, len(servers))
+	for name := range servers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	clients := make([]GarmClient, 0, len(servers)+1)
+	if Client != nil {
+		clients = append(clients, Client)
+	}
+	for _, name := range names {
+		clients = append(clients, servers[name])
+	}
+	return clients
+}
+
+func newAuthenticatedClient(name string, garmParams GarmScopeParams) (*garmClient, error) {
+	if err := validateGarmParams(garmParams); err != nil {
+		return nil, err
+	}
+
+	transport, err := sharedTransport(name, garmParams)
+	if err != nil {
+		return nil, err
+	}
+
+	apiCli, err := newAPIClient(garmParams, transport)
+	if err != nil {
+		return nil, err
+	}
+
+	client := &garmClient{
+		name:       name,
+		client:     apiCli,
+		garmParams: garmParams,
+		tokens:     sharedTokenManager(garmParams),
+		transport:  transport,
+	}
+	if err := client.tokens.EnsureToken(name); err != nil {
+		return nil, fmt.Errorf("failed to login to garm client: %w", err)
+	}
+	return client, nil
 }
 
-func newGarmClient(garmParams GarmScopeParams) (*garm.GarmAPI, runtime.ClientAuthInfoWriter, error) {
+func validateGarmParams(garmParams GarmScopeParams) error {
 	if garmParams.BaseURL == "" {
-		return nil, nil, errors.New("baseURL is mandatory to create a garm client")
+		return errors.New("baseURL is mandatory to create a garm client")
 	}
 
 	if garmParams.Username == "" {
-		return nil, nil, errors.New("username is mandatory to create a garm client")
+		return errors.New("username is mandatory to create a garm client")
 	}
 
 	if garmParams.Password == "" {
-		return nil, nil, errors.New("password is mandator")
+		return errors.New("password is mandator")
 	}
 
-	baseURLParsed, err := url.Parse(garmParams.BaseURL)
+	return nil
+}
+
+// loginGarm logs in to GARM and returns a new JWT
+func loginGarm(name string, garmParams GarmScopeParams) (string, error) {
+	transport, err := sharedTransport(name, garmParams)
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to parse base url %s: %s", garmParams.BaseURL, err)
+		return "", err
 	}
-
-	apiPath, err := url.JoinPath(baseURLParsed.Path, garm.DefaultBasePath)
+	apiCli, err := newAPIClient(garmParams, transport)
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to join base url path %s with %s: %s", baseURLParsed.Path, garm.DefaultBasePath, err)
+		return "", err
 	}
-
-	transportCfg := garm.DefaultTransportConfig().
-		WithHost(baseURLParsed.Host).
-		WithBasePath(apiPath).
-		WithSchemes([]string{baseURLParsed.Scheme})
-	apiCli := garm.NewHTTPClientWithConfig(nil, transportCfg)
 	authToken := openapiRuntimeClient.BearerToken("")
 
 	newLoginParamsReq := apiClientLogin.NewLoginParams()
@@ -131,20 +271,50 @@ func newGarmClient(garmParams GarmScopeParams) (*garm.GarmAPI, runtime.ClientAut
 	// login with empty token and login params
 	// this will return a new token in response
 	resp, err := apiCli.Login.Login(newLoginParamsReq, authToken)
-	metrics.TotalGarmCalls.WithLabelValues("client.Login").Inc()
+	metrics.TotalGarmCalls.WithLabelValues(name, "client.Login").Inc()
+	if err != nil {
+		metrics.GarmCallErrors.WithLabelValues(name, "client.Login").Inc()
+		return "", err
+	}
+
+	return resp.Payload.Token, nil
+}
+
+// newAPIClient returns a client for the GARM API which sends all requests via the given transport
+func newAPIClient(garmParams GarmScopeParams, transport http.RoundTripper) (*garm.GarmAPI, error) {
+	baseURLParsed, err := url.Parse(garmParams.BaseURL)
 	if err != nil {
-		metrics.GarmCallErrors.WithLabelValues("client.Login").Inc()
-		return nil, nil, err
+		return nil, fmt.Errorf("failed to parse base url %s: %s", garmParams.BaseURL, err)
 	}
 
-	// update token from login response
-	extractJWTTokenExp(context.TODO(), resp.Payload.Token)
-	authToken = openapiRuntimeClient.BearerToken(resp.Payload.Token)
+	apiPath, err := url.JoinPath(baseURLParsed.Path, garm.DefaultBasePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to join base url path %s with %s: %s", baseURLParsed.Path, garm.DefaultBasePath, err)
+	}
 
-	return apiCli, authToken, nil
+	httpClient := &http.Client{
+		Transport: transport,
+	}
+	runtimeClient := openapiRuntimeClient.NewWithClient(baseURLParsed.Host, apiPath, []string{baseURLParsed.Scheme}, httpClient)
+	return garm.New(runtimeClient, nil), nil
+}
+
+// newTLSConfig returns a TLS config which trusts the given PEM encoded CA certificates in addition to the system ones
+func newTLSConfig(caCertBundle []byte) (*tls.Config, error) {
+	rootCAs, err := x509.SystemCertPool()
+	if err != nil {
+		rootCAs = x509.NewCertPool()
+	}
+	if !rootCAs.AppendCertsFromPEM(caCertBundle) {
+		return nil, errors.New("failed to parse CA cert bundle")
+	}
+	return &tls.Config{
+		RootCAs:    rootCAs,
+		MinVersion: tls.VersionTLS12,
+	}, nil
 }
 
-func initializeGarm(ctx context.Context, garmParams GarmScopeParams) error {
+func initializeGarm(ctx context.Context, name string, garmParams GarmScopeParams) error {
 	log := log.FromContext(ctx)
 
 	newUserReq := apiClientFirstRun.NewFirstRunParams()
@@ -154,21 +324,14 @@ func initializeGarm(ctx context.Context, garmParams GarmScopeParams) error {
 		Email:    garmParams.Email,
 	}
 
-	baseURLParsed, err := url.Parse(garmParams.BaseURL)
+	transport, err := sharedTransport(name, garmParams)
 	if err != nil {
-		return fmt.Errorf("failed to parse base url %s: %s", garmParams.BaseURL, err)
+		return err
 	}
-
-	apiPath, err := url.JoinPath(baseURLParsed.Path, garm.DefaultBasePath)
+	apiCli, err := newAPIClient(garmParams, transport)
 	if err != nil {
-		return fmt.Errorf("failed to join base url path %s with %s: %s", baseURLParsed.Path, garm.DefaultBasePath, err)
+		return err
 	}
-
-	transportCfg := garm.DefaultTransportConfig().
-		WithHost(baseURLParsed.Host).
-		WithBasePath(apiPath).
-		WithSchemes([]string{baseURLParsed.Scheme})
-	apiCli := garm.NewHTTPClientWithConfig(nil, transportCfg)
 	authToken := openapiRuntimeClient.BearerToken("")
 
 	resp, err := apiCli.FirstRun.FirstRun(newUserReq, authToken)
@@ -211,17 +374,15 @@ func IsConflictError(err interface{}) bool {
 
 type Func[T interface{}] func() (T, error)
 
-func EnsureAuth[T interface{}](f Func[T]) (T, error) {
+// EnsureAuth calls f and retries it once after the token of the GARM server of client
+// has been refreshed, if the call failed because the token is no longer valid.
+func EnsureAuth[T interface{}](client GarmClient, f Func[T]) (T, error) {
+	staleToken := client.BearerToken()
 	result, err := f()
 	if err != nil && IsUnauthenticatedError(err) {
-		metrics.GarmCallErrors.WithLabelValues("client.Unauthenticated").Inc()
+		metrics.GarmCallErrors.WithLabelValues(client.Name(), "client.Unauthenticated").Inc()
 
-		err = Client.Init()
-		if err != nil {
-			return result, err
-		}
-
-		err = Client.Login()
+		err = client.RefreshToken(staleToken)
 		if err != nil {
 			return result, err
 		}
@@ -231,21 +392,26 @@ func EnsureAuth[T interface{}](f Func[T]) (T, error) {
 	return result, err
 }
 
-func extractJWTTokenExp(ctx context.Context, tokenString string) {
+// extractJWTTokenExp returns the issue and expiry date of the JWT, which are zero if the token doesn't contain them
+func extractJWTTokenExp(ctx context.Context, name, tokenString string) (issuedAt, expiresAt time.Time) {
 	log := log.FromContext(ctx)
 
-	log.Info("Extracting expiry date of jwt")
+	log.Info("Extracting expiry date of jwt", "server", name)
 	token, _, err := new(jwt.Parser).ParseUnverified(tokenString, jwt.MapClaims{})
 	if err != nil {
 		log.Error(err, "failed parsing jwt")
-		return
+		return time.Time{}, time.Time{}
 	}
 
 	if claims, ok := token.Claims.(jwt.MapClaims); ok {
+		if iat, ok := claims["iat"].(float64); ok {
+			issuedAt = time.Unix(int64(iat), 0)
+		}
 		if exp, ok := claims["exp"].(float64); ok {
-			expTime := time.Unix(int64(exp), 0)
-			log.Info(fmt.Sprintf("new token expires on %s", expTime.Format(time.UnixDate)))
-			metrics.GarmJwtExpiresAt.Set(exp)
+			expiresAt = time.Unix(int64(exp), 0)
+			log.Info(fmt.Sprintf("new token expires on %s", expiresAt.Format(time.UnixDate)), "server", name)
+			metrics.GarmJwtExpiresAt.WithLabelValues(name).Set(exp)
 		}
 	}
+	return issuedAt, expiresAt
 }
@@ -24,8 +24,9 @@ func TestGarmClient_Reauthenticate(t *testing.T) {
 			WithEnterpriseID("e1dbf9a6-a9f6-4594-a5ac-ae78a8f27a3e"),
 		).Return(nil, instances.NewGetInstanceDefault(401))
 
-		m1.Init().Return(nil)
-		m1.Login().Return(nil)
+		m1.Name().Return(DefaultServerName).AnyTimes()
+		m1.BearerToken().Return("expired-token")
+		m1.RefreshToken("expired-token").Return(nil)
 
 		m.GetEnterprise(
 			enterprises.NewGetEnterpriseParams().
@@ -49,16 +50,15 @@ func TestGarmClient_Reauthenticate(t *testing.T) {
 
 	mockEnterpriseClient := mock.NewMockEnterpriseClient(mockCtrl)
 	mockBaseClient := mock.NewMockGarmClient(mockCtrl)
-	Client = mockBaseClient
 
 	expectGarmRequest(mockEnterpriseClient.EXPECT(), mockBaseClient.EXPECT())
 
-	result, err := EnsureAuth[*enterprises.GetEnterpriseOK](func() (*enterprises.GetEnterpriseOK, error) {
-		metrics.TotalGarmCalls.WithLabelValues("enterprises.Get").Inc()
+	result, err := EnsureAuth[*enterprises.GetEnterpriseOK](mockBaseClient, func() (*enterprises.GetEnterpriseOK, error) {
+		metrics.TotalGarmCalls.WithLabelValues(DefaultServerName, "enterprises.Get").Inc()
 
 		enterprise, err := mockEnterpriseClient.GetEnterprise(enterprises.NewGetEnterpriseParams().WithEnterpriseID("e1dbf9a6-a9f6-4594-a5ac-ae78a8f27a3e"))
 		if err != nil {
-			metrics.GarmCallErrors.WithLabelValues("enterprises.Get").Inc()
+			metrics.GarmCallErrors.WithLabelValues(DefaultServerName, "enterprises.Get").Inc()
 			return nil, err
 		}
 		return enterprise, nil
@@ -19,9 +19,9 @@ type controllerClient struct {
 	GarmClient
 }
 
-func NewControllerClient() ControllerClient {
+func NewControllerClient(client GarmClient) ControllerClient {
 	return &controllerClient{
-		Client,
+		client,
 	}
 }
 
@@ -32,11 +32,11 @@ const (
 )
 
 func (s *controllerClient) GetControllerInfo() (*controller_info.ControllerInfoOK, error) {
-	return EnsureAuth(func() (*controller_info.ControllerInfoOK, error) {
-		metrics.TotalGarmCalls.WithLabelValues("controller.Info").Inc()
+	return EnsureAuth(s, func() (*controller_info.ControllerInfoOK, error) {
+		metrics.TotalGarmCalls.WithLabelValues(s.Name(), "controller.Info").Inc()
 		controllerInfo, err := s.GarmAPI().ControllerInfo.ControllerInfo(&controller_info.ControllerInfoParams{}, s.Token())
 		if err != nil {
-			metrics.GarmCallErrors.WithLabelValues("controller.Info").Inc()
+			metrics.GarmCallErrors.WithLabelValues(s.Name(), "controller.Info").Inc()
 
 			// after the first run, garm needs a configuration for webhook, metadata and callback
 			// to make garm work after the first run, we set some defaults
@@ -59,11 +59,11 @@ func (s *controllerClient) GetControllerInfo() (*controller_info.ControllerInfoO
 }
 
 func (s *controllerClient) UpdateController(param *controller.UpdateControllerParams) (*controller.UpdateControllerOK, error) {
-	return EnsureAuth(func() (*controller.UpdateControllerOK, error) {
-		metrics.TotalGarmCalls.WithLabelValues("controller.Update").Inc()
+	return EnsureAuth(s, func() (*controller.UpdateControllerOK, error) {
+		metrics.TotalGarmCalls.WithLabelValues(s.Name(), "controller.Update").Inc()
 		enterprise, err := s.GarmAPI().Controller.UpdateController(param, s.Token())
 		if err != nil {
-			metrics.GarmCallErrors.WithLabelValues("controller.Update").Inc()
+			metrics.GarmCallErrors.WithLabelValues(s.Name(), "controller.Update").Inc()
 			return nil, err
 		}
 		return enterprise, nil
@@ -20,11 +20,11 @@ type credentialClient struct {
 }
 
 func (e *credentialClient) GetCredentials(params *credentials.GetCredentialsParams) (*credentials.GetCredentialsOK, error) {
-	return EnsureAuth(func() (*credentials.GetCredentialsOK, error) {
-		metrics.TotalGarmCalls.WithLabelValues("credentials.Get").Inc()
+	return EnsureAuth(e, func() (*credentials.GetCredentialsOK, error) {
+		metrics.TotalGarmCalls.WithLabelValues(e.Name(), "credentials.Get").Inc()
 		endpoint, err := e.GarmAPI().Credentials.GetCredentials(params, e.Token())
 		if err != nil {
-			metrics.GarmCallErrors.WithLabelValues("credentials.Get").Inc()
+			metrics.GarmCallErrors.WithLabelValues(e.Name(), "credentials.Get").Inc()
 			return nil, err
 		}
 		return endpoint, nil
@@ -32,11 +32,11 @@ func (e *credentialClient) GetCredentials(params *credentials.GetCredentialsPara
 }
 
 func (e *credentialClient) ListCredentials(params *credentials.ListCredentialsParams) (*credentials.ListCredentialsOK, error) {
-	return EnsureAuth(func() (*credentials.ListCredentialsOK, error) {
-		metrics.TotalGarmCalls.WithLabelValues("credentials.List").Inc()
+	return EnsureAuth(e, func() (*credentials.ListCredentialsOK, error) {
+		metrics.TotalGarmCalls.WithLabelValues(e.Name(), "credentials.List").Inc()
 		credentials, err := e.GarmAPI().Credentials.ListCredentials(params, e.Token())
 		if err != nil {
-			metrics.GarmCallErrors.WithLabelValues("credentials.List").Inc()
+			metrics.GarmCallErrors.WithLabelValues(e.Name(), "credentials.List").Inc()
 			return nil, err
 		}
 		return credentials, nil
@@ -44,11 +44,11 @@ func (e *credentialClient) ListCredentials(params *credentials.ListCredentialsPa
 }
 
 func (e *credentialClient) CreateCredentials(params *credentials.CreateCredentialsParams) (*credentials.CreateCredentialsOK, error) {
-	return EnsureAuth(func() (*credentials.CreateCredentialsOK, error) {
-		metrics.TotalGarmCalls.WithLabelValues("credentials.Create").Inc()
+	return EnsureAuth(e, func() (*credentials.CreateCredentialsOK, error) {
+		metrics.TotalGarmCalls.WithLabelValues(e.Name(), "credentials.Create").Inc()
 		endpoint, err := e.GarmAPI().Credentials.CreateCredentials(params, e.Token())
 		if err != nil {
-			metrics.GarmCallErrors.WithLabelValues("credentials.Create").Inc()
+			metrics.GarmCallErrors.WithLabelValues(e.Name(), "credentials.Create").Inc()
 			return nil, err
 		}
 		return endpoint, nil
@@ -56,11 +56,11 @@ func (e *credentialClient) CreateCredentials(params *credentials.CreateCredentia
 }
 
 func (e *credentialClient) UpdateCredentials(params *credentials.UpdateCredentialsParams) (*credentials.UpdateCredentialsOK, error) {
-	return EnsureAuth(func() (*credentials.UpdateCredentialsOK, error) {
-		metrics.TotalGarmCalls.WithLabelValues("credentials.Update").Inc()
+	return EnsureAuth(e, func() (*credentials.UpdateCredentialsOK, error) {
+		metrics.TotalGarmCalls.WithLabelValues(e.Name(), "credentials.Update").Inc()
 		endpoint, err := e.GarmAPI().Credentials.UpdateCredentials(params, e.Token())
 		if err != nil {
-			metrics.GarmCallErrors.WithLabelValues("credentials.Update").Inc()
+			metrics.GarmCallErrors.WithLabelValues(e.Name(), "credentials.Update").Inc()
 			return nil, err
 		}
 		return endpoint, nil
@@ -68,10 +68,10 @@ func (e *credentialClient) UpdateCredentials(params *credentials.UpdateCredentia
 }
 
 func (e *credentialClient) DeleteCredentials(params *credentials.DeleteCredentialsParams) error {
-	_, err := EnsureAuth(func() (interface{}, error) {
-		metrics.TotalGarmCalls.WithLabelValues("credentials.Delete").Inc()
+	_, err := EnsureAuth(e, func() (interface{}, error) {
+		metrics.TotalGarmCalls.WithLabelValues(e.Name(), "credentials.Delete").Inc()
 		if err := e.GarmAPI().Credentials.DeleteCredentials(params, e.Token()); err != nil {
-			metrics.GarmCallErrors.WithLabelValues("credentials.Delete").Inc()
+			metrics.GarmCallErrors.WithLabelValues(e.Name(), "credentials.Delete").Inc()
 			return nil, err
 		}
 		return nil, nil
@@ -79,8 +79,8 @@ func (e *credentialClient) DeleteCredentials(params *credentials.DeleteCredentia
 	return err
 }
 
-func NewCredentialsClient() CredentialsClient {
+func NewCredentialsClient(client GarmClient) CredentialsClient {
 	return &credentialClient{
-		Client,
+		client,
 	}
 }
@@ -20,11 +20,11 @@ type endpointClient struct {
 }
 
 func (e *endpointClient) GetEndpoint(params *endpoints.GetGithubEndpointParams) (*endpoints.GetGithubEndpointOK, error) {
-	return EnsureAuth(func() (*endpoints.GetGithubEndpointOK, error) {
-		metrics.TotalGarmCalls.WithLabelValues("endpoints.Get").Inc()
+	return EnsureAuth(e, func() (*endpoints.GetGithubEndpointOK, error) {
+		metrics.TotalGarmCalls.WithLabelValues(e.Name(), "endpoints.Get").Inc()
 		endpoint, err := e.GarmAPI().Endpoints.GetGithubEndpoint(params, e.Token())
 		if err != nil {
-			metrics.GarmCallErrors.WithLabelValues("endpoints.Get").Inc()
+			metrics.GarmCallErrors.WithLabelValues(e.Name(), "endpoints.Get").Inc()
 			return nil, err
 		}
 		return endpoint, nil
@@ -32,11 +32,11 @@ func (e *endpointClient) GetEndpoint(params *endpoints.GetGithubEndpointParams)
 }
 
 func (e *endpointClient) ListEndpoints(params *endpoints.ListGithubEndpointsParams) (*endpoints.ListGithubEndpointsOK, error) {
-	return EnsureAuth(func() (*endpoints.ListGithubEndpointsOK, error) {
-		metrics.TotalGarmCalls.WithLabelValues("endpoints.List").Inc()
+	return EnsureAuth(e, func() (*endpoints.ListGithubEndpointsOK, error) {
+		metrics.TotalGarmCalls.WithLabelValues(e.Name(), "endpoints.List").Inc()
 		endpoints, err := e.GarmAPI().Endpoints.ListGithubEndpoints(params, e.Token())
 		if err != nil {
-			metrics.GarmCallErrors.WithLabelValues("endpoints.List").Inc()
+			metrics.GarmCallErrors.WithLabelValues(e.Name(), "endpoints.List").Inc()
 			return nil, err
 		}
 		return endpoints, nil
@@ -44,11 +44,11 @@ func (e *endpointClient) ListEndpoints(params *endpoints.ListGithubEndpointsPara
 }
 
 func (e *endpointClient) CreateEndpoint(params *endpoints.CreateGithubEndpointParams) (*endpoints.CreateGithubEndpointOK, error) {
-	return EnsureAuth(func() (*endpoints.CreateGithubEndpointOK, error) {
-		metrics.TotalGarmCalls.WithLabelValues("endpoints.Create").Inc()
+	return EnsureAuth(e, func() (*endpoints.CreateGithubEndpointOK, error) {
+		metrics.TotalGarmCalls.WithLabelValues(e.Name(), "endpoints.Create").Inc()
 		endpoint, err := e.GarmAPI().Endpoints.CreateGithubEndpoint(params, e.Token())
 		if err != nil {
-			metrics.GarmCallErrors.WithLabelValues("endpoints.Create").Inc()
+			metrics.GarmCallErrors.WithLabelValues(e.Name(), "endpoints.Create").Inc()
 			return nil, err
 		}
 		return endpoint, nil
@@ -56,11 +56,11 @@ func (e *endpointClient) CreateEndpoint(params *endpoints.CreateGithubEndpointPa
 }
 
 func (e *endpointClient) UpdateEndpoint(params *endpoints.UpdateGithubEndpointParams) (*endpoints.UpdateGithubEndpointOK, error) {
-	return EnsureAuth(func() (*endpoints.UpdateGithubEndpointOK, error) {
-		metrics.TotalGarmCalls.WithLabelValues("endpoints.Update").Inc()
+	return EnsureAuth(e, func() (*endpoints.UpdateGithubEndpointOK, error) {
+		metrics.TotalGarmCalls.WithLabelValues(e.Name(), "endpoints.Update").Inc()
 		endpoint, err := e.GarmAPI().Endpoints.UpdateGithubEndpoint(params, e.Token())
 		if err != nil {
-			metrics.GarmCallErrors.WithLabelValues("endpoints.Update").Inc()
+			metrics.GarmCallErrors.WithLabelValues(e.Name(), "endpoints.Update").Inc()
 			return nil, err
 		}
 		return endpoint, nil
@@ -68,10 +68,10 @@ func (e *endpointClient) UpdateEndpoint(params *endpoints.UpdateGithubEndpointPa
 }
 
 func (e *endpointClient) DeleteEndpoint(params *endpoints.DeleteGithubEndpointParams) error {
-	_, err := EnsureAuth(func() (interface{}, error) {
-		metrics.TotalGarmCalls.WithLabelValues("endpoints.Delete").Inc()
+	_, err := EnsureAuth(e, func() (interface{}, error) {
+		metrics.TotalGarmCalls.WithLabelValues(e.Name(), "endpoints.Delete").Inc()
 		if err := e.GarmAPI().Endpoints.DeleteGithubEndpoint(params, e.Token()); err != nil {
-			metrics.GarmCallErrors.WithLabelValues("endpoints.Delete").Inc()
+			metrics.GarmCallErrors.WithLabelValues(e.Name(), "endpoints.Delete").Inc()
 			return nil, err
 		}
 		return nil, nil
@@ -79,8 +79,8 @@ func (e *endpointClient) DeleteEndpoint(params *endpoints.DeleteGithubEndpointPa
 	return err
 }
 
-func NewEndpointClient() EndpointClient {
+func NewEndpointClient(client GarmClient) EndpointClient {
 	return &endpointClient{
-		Client,
+		client,
 	}
 }
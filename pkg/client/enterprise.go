@@ -20,18 +20,18 @@ type enterpriseClient struct {
 	GarmClient
 }
 
-func NewEnterpriseClient() EnterpriseClient {
+func NewEnterpriseClient(client GarmClient) EnterpriseClient {
 	return &enterpriseClient{
-		Client,
+		client,
 	}
 }
 
 func (s *enterpriseClient) ListEnterprises(param *enterprises.ListEnterprisesParams) (*enterprises.ListEnterprisesOK, error) {
-	return EnsureAuth(func() (*enterprises.ListEnterprisesOK, error) {
-		metrics.TotalGarmCalls.WithLabelValues("enterprises.List").Inc()
+	return EnsureAuth(s, func() (*enterprises.ListEnterprisesOK, error) {
+		metrics.TotalGarmCalls.WithLabelValues(s.Name(), "enterprises.List").Inc()
 		enterprises, err := s.GarmAPI().Enterprises.ListEnterprises(param, s.Token())
 		if err != nil {
-			metrics.GarmCallErrors.WithLabelValues("enterprises.List").Inc()
+			metrics.GarmCallErrors.WithLabelValues(s.Name(), "enterprises.List").Inc()
 			return nil, err
 		}
 		return enterprises, nil
@@ -39,11 +39,11 @@ func (s *enterpriseClient) ListEnterprises(param *enterprises.ListEnterprisesPar
 }
 
 func (s *enterpriseClient) CreateEnterprise(param *enterprises.CreateEnterpriseParams) (*enterprises.CreateEnterpriseOK, error) {
-	return EnsureAuth(func() (*enterprises.CreateEnterpriseOK, error) {
-		metrics.TotalGarmCalls.WithLabelValues("enterprises.Create").Inc()
+	return EnsureAuth(s, func() (*enterprises.CreateEnterpriseOK, error) {
+		metrics.TotalGarmCalls.WithLabelValues(s.Name(), "enterprises.Create").Inc()
 		enterprise, err := s.GarmAPI().Enterprises.CreateEnterprise(param, s.Token())
 		if err != nil {
-			metrics.GarmCallErrors.WithLabelValues("enterprises.Create").Inc()
+			metrics.GarmCallErrors.WithLabelValues(s.Name(), "enterprises.Create").Inc()
 			return nil, err
 		}
 		return enterprise, nil
@@ -51,11 +51,11 @@ func (s *enterpriseClient) CreateEnterprise(param *enterprises.CreateEnterpriseP
 }
 
 func (s *enterpriseClient) GetEnterprise(param *enterprises.GetEnterpriseParams) (*enterprises.GetEnterpriseOK, error) {
-	return EnsureAuth(func() (*enterprises.GetEnterpriseOK, error) {
-		metrics.TotalGarmCalls.WithLabelValues("enterprises.Get").Inc()
+	return EnsureAuth(s, func() (*enterprises.GetEnterpriseOK, error) {
+		metrics.TotalGarmCalls.WithLabelValues(s.Name(), "enterprises.Get").Inc()
 		enterprise, err := s.GarmAPI().Enterprises.GetEnterprise(param, s.Token())
 		if err != nil {
-			metrics.GarmCallErrors.WithLabelValues("enterprises.Get").Inc()
+			metrics.GarmCallErrors.WithLabelValues(s.Name(), "enterprises.Get").Inc()
 			return nil, err
 		}
 		return enterprise, nil
@@ -63,11 +63,11 @@ func (s *enterpriseClient) GetEnterprise(param *enterprises.GetEnterpriseParams)
 }
 
 func (s *enterpriseClient) DeleteEnterprise(param *enterprises.DeleteEnterpriseParams) error {
-	_, err := EnsureAuth(func() (interface{}, error) {
-		metrics.TotalGarmCalls.WithLabelValues("enterprises.Delete").Inc()
+	_, err := EnsureAuth(s, func() (interface{}, error) {
+		metrics.TotalGarmCalls.WithLabelValues(s.Name(), "enterprises.Delete").Inc()
 		err := s.GarmAPI().Enterprises.DeleteEnterprise(param, s.Token())
 		if err != nil {
-			metrics.GarmCallErrors.WithLabelValues("enterprises.Delete").Inc()
+			metrics.GarmCallErrors.WithLabelValues(s.Name(), "enterprises.Delete").Inc()
 			return nil, err
 		}
 
@@ -77,11 +77,11 @@ func (s *enterpriseClient) DeleteEnterprise(param *enterprises.DeleteEnterpriseP
 }
 
 func (s *enterpriseClient) UpdateEnterprise(param *enterprises.UpdateEnterpriseParams) (*enterprises.UpdateEnterpriseOK, error) {
-	return EnsureAuth(func() (*enterprises.UpdateEnterpriseOK, error) {
-		metrics.TotalGarmCalls.WithLabelValues("enterprises.Update").Inc()
+	return EnsureAuth(s, func() (*enterprises.UpdateEnterpriseOK, error) {
+		metrics.TotalGarmCalls.WithLabelValues(s.Name(), "enterprises.Update").Inc()
 		enterprise, err := s.GarmAPI().Enterprises.UpdateEnterprise(param, s.Token())
 		if err != nil {
-			metrics.GarmCallErrors.WithLabelValues("enterprises.Update").Inc()
+			metrics.GarmCallErrors.WithLabelValues(s.Name(), "enterprises.Update").Inc()
 			return nil, err
 		}
 		return enterprise, nil
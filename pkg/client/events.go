@@ -0,0 +1,446 @@
+// SPDX-License-Identifier: MIT
+
+package client
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	garm "github.com/cloudbase/garm/client"
+	"golang.org/x/net/websocket"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/mercedes-benz/garm-operator/pkg/metrics"
+)
+
+// entity types and operations of the GARM event stream
+const (
+	InstanceEntityType = "instance"
+	PoolEntityType     = "pool"
+
+	CreateOperation = "create"
+	UpdateOperation = "update"
+	DeleteOperation = "delete"
+)
+
+const (
+	eventStreamPath       = "ws/events"
+	eventStreamMinBackoff = 1 * time.Second
+	eventStreamMaxBackoff = 1 * time.Minute
+)
+
+// EventFilter selects the GARM change events which are sent over the event stream.
+// If no operations are set, all operations of the entity type are sent.
+type EventFilter struct {
+	EntityType string   `json:"entity-type"`
+	Operations []string `json:"operations,omitempty"`
+}
+
+type eventStreamOptions struct {
+	Filters []EventFilter `json:"filters"`
+}
+
+// ChangeEvent is a change of a GARM entity which is received over the event stream
+type ChangeEvent struct {
+	EntityType string          `json:"entity-type"`
+	Operation  string          `json:"operation"`
+	Payload    json.RawMessage `json:"payload"`
+}
+
+// EventStream is a subscription to the GARM websocket event stream,
+// which reconnects with an exponential backoff if the connection gets lost.
+type EventStream struct {
+	client  GarmClient
+	filters []EventFilter
+	events  chan ChangeEvent
+
+	mux        sync.RWMutex
+	connected  bool
+	connection uint64
+}
+
+func NewEventStream(client GarmClient, filters ...EventFilter) *EventStream {
+	return &EventStream{
+		client:  client,
+		filters: filters,
+		events:  make(chan ChangeEvent),
+	}
+}
+
+// Events returns the channel on which the received events are delivered.
+// The channel gets closed once Run returns.
+func (s *EventStream) Events() <-chan ChangeEvent {
+	return s.events
+}
+
+// Connection returns the number of the current connection and if the stream is currently connected.
+// The number gets increased on every successful (re)connect, so callers can detect
+// that events might have been missed in between.
+func (s *EventStream) Connection() (uint64, bool) {
+	s.mux.RLock()
+	defer s.mux.RUnlock()
+	return s.connection, s.connected
+}
+
+func (s *EventStream) setConnected(connected bool) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	s.connected = connected
+	if connected {
+		s.connection++
+		metrics.EventStreamConnected.WithLabelValues(s.client.Name()).Set(1)
+		return
+	}
+	metrics.EventStreamConnected.WithLabelValues(s.client.Name()).Set(0)
+}
+
+// Run connects to the event stream and delivers the received events until ctx is done.
+func (s *EventStream) Run(ctx context.Context) {
+	log := log.FromContext(ctx).WithName("event-stream").WithValues("server", s.client.Name())
+	defer close(s.events)
+
+	backoff := eventStreamMinBackoff
+	for {
+		conn, err := s.connect(ctx)
+		if err != nil {
+			log.Error(err, "failed to connect to GARM event stream", "retryIn", backoff)
+		} else {
+			log.Info("connected to GARM event stream")
+			s.setConnected(true)
+			backoff = eventStreamMinBackoff
+
+			err = s.receive(ctx, conn)
+
+			s.setConnected(false)
+			_ = conn.Close()
+			if ctx.Err() == nil {
+				log.Error(err, "lost connection to GARM event stream", "retryIn", backoff)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(backoff):
+		}
+
+		metrics.EventStreamReconnects.WithLabelValues(s.client.Name()).Inc()
+		backoff = min(2*backoff, eventStreamMaxBackoff)
+	}
+}
+
+func (s *EventStream) connect(ctx context.Context) (*websocket.Conn, error) {
+	staleToken := s.client.BearerToken()
+	conn, err := s.dial(ctx)
+
+	// the token might have expired, so refresh it and retry once.
+	// Any other bad status (e.g. during a GARM outage) is left to the backoff of Run,
+	// otherwise every reconnect would hit the login endpoint as well.
+	var statusErr *handshakeStatusError
+	if errors.As(err, &statusErr) && (statusErr.StatusCode == http.StatusUnauthorized || statusErr.StatusCode == http.StatusForbidden) {
+		metrics.GarmCallErrors.WithLabelValues(s.client.Name(), "client.Unauthenticated").Inc()
+		if err := s.client.RefreshToken(staleToken); err != nil {
+			return nil, err
+		}
+		conn, err = s.dial(ctx)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// GARM doesn't send any events until the filters are set
+	if err := websocket.JSON.Send(conn, eventStreamOptions{Filters: s.filters}); err != nil {
+		_ = conn.Close()
+		return nil, fmt.Errorf("failed to set event stream filters: %w", err)
+	}
+
+	return conn, nil
+}
+
+func (s *EventStream) dial(ctx context.Context) (*websocket.Conn, error) {
+	metrics.TotalGarmCalls.WithLabelValues(s.client.Name(), "events.Stream").Inc()
+
+	config, err := eventStreamConfig(s.client.BaseURL(), s.client.BearerToken())
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := dialEventStream(ctx, config, httpTransport(s.client))
+	if err != nil {
+		metrics.GarmCallErrors.WithLabelValues(s.client.Name(), "events.Stream").Inc()
+		return nil, fmt.Errorf("failed to dial %s: %w", config.Location, err)
+	}
+	return conn, nil
+}
+
+// httpTransport returns the transport of the REST client of the GARM server,
+// so the event stream uses the same proxy and CA certificates
+func httpTransport(client GarmClient) *http.Transport {
+	if c, ok := client.(*garmClient); ok && c.transport != nil {
+		if t, ok := c.transport.next.(*http.Transport); ok {
+			return t
+		}
+	}
+	return http.DefaultTransport.(*http.Transport)
+}
+
+// handshakeStatusError is returned if the event stream handshake gets answered with a status other than 101.
+// In contrast to websocket.ErrBadStatus, it carries the received status code.
+type handshakeStatusError struct {
+	StatusCode int
+}
+
+func (e *handshakeStatusError) Error() string {
+	return fmt.Sprintf("%s: %d", websocket.ErrBadStatus.Error(), e.StatusCode)
+}
+
+func (e *handshakeStatusError) Unwrap() error {
+	return websocket.ErrBadStatus
+}
+
+// dialEventStream does the same as websocket.Config.DialContext, but connects with the proxy, dialer and TLS config
+// of the given transport and records the status line of the handshake response to return a handshakeStatusError.
+func dialEventStream(ctx context.Context, config *websocket.Config, transport *http.Transport) (*websocket.Conn, error) {
+	// the proxy and TLS settings of the transport apply to http(s) URLs
+	target := &url.URL{Scheme: "http", Host: config.Location.Host}
+	if config.Location.Scheme == "wss" {
+		target.Scheme = "https"
+	}
+	if target.Port() == "" {
+		port := "80"
+		if target.Scheme == "https" {
+			port = "443"
+		}
+		target.Host = net.JoinHostPort(target.Hostname(), port)
+	}
+
+	rawConn, err := dialTarget(ctx, transport, target)
+	if err != nil {
+		return nil, err
+	}
+
+	// abort the handshake once the context is done
+	stop := context.AfterFunc(ctx, func() {
+		_ = rawConn.SetDeadline(time.Now())
+	})
+	defer stop()
+
+	var netConn net.Conn = rawConn
+	if target.Scheme == "https" {
+		tlsConn := tls.Client(rawConn, clientTLSConfig(transport, target.Hostname()))
+		if err := tlsConn.HandshakeContext(ctx); err != nil {
+			_ = rawConn.Close()
+			return nil, err
+		}
+		netConn = tlsConn
+	}
+
+	statusConn := &statusLineConn{Conn: netConn}
+	conn, err := websocket.NewClient(config, statusConn)
+	if err != nil {
+		_ = netConn.Close()
+		if errors.Is(err, websocket.ErrBadStatus) {
+			return nil, &handshakeStatusError{StatusCode: statusConn.statusCode()}
+		}
+		return nil, err
+	}
+
+	if !stop() {
+		_ = conn.Close()
+		return nil, ctx.Err()
+	}
+	return conn, nil
+}
+
+// dialTarget opens a TCP connection to the target, through the proxy of the transport if there is one
+func dialTarget(ctx context.Context, transport *http.Transport, target *url.URL) (net.Conn, error) {
+	dial := (&net.Dialer{}).DialContext
+	if transport.DialContext != nil {
+		dial = transport.DialContext
+	}
+
+	var proxyURL *url.URL
+	if transport.Proxy != nil {
+		var err error
+		proxyURL, err = transport.Proxy(&http.Request{Method: http.MethodGet, URL: target, Header: http.Header{}})
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve proxy: %w", err)
+		}
+	}
+	if proxyURL == nil {
+		return dial(ctx, "tcp", target.Host)
+	}
+
+	proxyAddr := proxyURL.Host
+	switch proxyURL.Scheme {
+	case "http":
+		if proxyURL.Port() == "" {
+			proxyAddr = net.JoinHostPort(proxyURL.Hostname(), "80")
+		}
+	case "https":
+		if proxyURL.Port() == "" {
+			proxyAddr = net.JoinHostPort(proxyURL.Hostname(), "443")
+		}
+	default:
+		return nil, fmt.Errorf("unsupported proxy scheme %s", proxyURL.Scheme)
+	}
+
+	conn, err := dial(ctx, "tcp", proxyAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	// abort the proxy handshake once the context is done
+	stop := context.AfterFunc(ctx, func() {
+		_ = conn.SetDeadline(time.Now())
+	})
+	defer stop()
+
+	if proxyURL.Scheme == "https" {
+		tlsConn := tls.Client(conn, clientTLSConfig(transport, proxyURL.Hostname()))
+		if err := tlsConn.HandshakeContext(ctx); err != nil {
+			_ = conn.Close()
+			return nil, err
+		}
+		conn = tlsConn
+	}
+
+	connect := &http.Request{
+		Method: http.MethodConnect,
+		URL:    &url.URL{Opaque: target.Host},
+		Host:   target.Host,
+		Header: transport.ProxyConnectHeader.Clone(),
+	}
+	if connect.Header == nil {
+		connect.Header = http.Header{}
+	}
+	if user := proxyURL.User; user != nil {
+		password, _ := user.Password()
+		connect.Header.Set("Proxy-Authorization", "Basic "+base64.StdEncoding.EncodeToString([]byte(user.Username()+":"+password)))
+	}
+	if err := connect.Write(conn); err != nil {
+		_ = conn.Close()
+		return nil, fmt.Errorf("failed to connect to proxy %s: %w", proxyURL.Host, err)
+	}
+
+	// the target doesn't send anything before the client, so the reader can't consume any bytes of the tunnel
+	resp, err := http.ReadResponse(bufio.NewReader(conn), connect)
+	if err != nil {
+		_ = conn.Close()
+		return nil, fmt.Errorf("failed to connect to proxy %s: %w", proxyURL.Host, err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		_ = conn.Close()
+		return nil, fmt.Errorf("proxy %s refused to connect to %s: %s", proxyURL.Host, target.Host, resp.Status)
+	}
+
+	if !stop() {
+		_ = conn.Close()
+		return nil, ctx.Err()
+	}
+	return conn, nil
+}
+
+// clientTLSConfig returns the TLS config of the transport for the given server
+func clientTLSConfig(transport *http.Transport, serverName string) *tls.Config {
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+	if transport.TLSClientConfig != nil {
+		tlsConfig = transport.TLSClientConfig.Clone()
+	}
+	if tlsConfig.ServerName == "" {
+		tlsConfig.ServerName = serverName
+	}
+	return tlsConfig
+}
+
+// statusLineConn records the first bytes read from the connection, which contain the status line of the handshake response
+type statusLineConn struct {
+	net.Conn
+	head []byte
+}
+
+const statusLineLength = 64
+
+func (c *statusLineConn) Read(p []byte) (int, error) {
+	n, err := c.Conn.Read(p)
+	if missing := statusLineLength - len(c.head); missing > 0 {
+		c.head = append(c.head, p[:min(n, missing)]...)
+	}
+	return n, err
+}
+
+// statusCode parses the status code of a status line like "HTTP/1.1 401 Unauthorized", or returns 0
+func (c *statusLineConn) statusCode() int {
+	line, _, _ := strings.Cut(string(c.head), "\n")
+	fields := strings.Fields(line)
+	if len(fields) < 2 {
+		return 0
+	}
+	code, err := strconv.Atoi(fields[1])
+	if err != nil {
+		return 0
+	}
+	return code
+}
+
+func (s *EventStream) receive(ctx context.Context, conn *websocket.Conn) error {
+	// unblock the receiving of messages once the context is done
+	stop := context.AfterFunc(ctx, func() {
+		_ = conn.Close()
+	})
+	defer stop()
+
+	for {
+		var event ChangeEvent
+		if err := websocket.JSON.Receive(conn, &event); err != nil {
+			return err
+		}
+		metrics.EventStreamEvents.WithLabelValues(s.client.Name(), event.EntityType, event.Operation).Inc()
+
+		select {
+		case s.events <- event:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func eventStreamConfig(baseURL, token string) (*websocket.Config, error) {
+	baseURLParsed, err := url.Parse(baseURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse base url %s: %s", baseURL, err)
+	}
+
+	location := *baseURLParsed
+	switch baseURLParsed.Scheme {
+	case "https":
+		location.Scheme = "wss"
+	default:
+		location.Scheme = "ws"
+	}
+	location.Path, err = url.JoinPath(baseURLParsed.Path, garm.DefaultBasePath, eventStreamPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to join base url path %s with %s: %s", baseURLParsed.Path, eventStreamPath, err)
+	}
+
+	config, err := websocket.NewConfig(location.String(), baseURL)
+	if err != nil {
+		return nil, err
+	}
+	config.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+
+	return config, nil
+}
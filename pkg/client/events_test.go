@@ -0,0 +1,312 @@
+// SPDX-License-Identifier: MIT
+
+package client
+
+import (
+	"context"
+	"encoding/pem"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"golang.org/x/net/websocket"
+
+	"github.com/mercedes-benz/garm-operator/pkg/client/mock"
+)
+
+func TestEventStream_Run(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	filters := []EventFilter{
+		{EntityType: InstanceEntityType},
+		{EntityType: PoolEntityType, Operations: []string{DeleteOperation}},
+	}
+
+	receivedFilters := make(chan eventStreamOptions, 1)
+	wsServer := websocket.Server{
+		Handler: func(conn *websocket.Conn) {
+			var opts eventStreamOptions
+			if err := websocket.JSON.Receive(conn, &opts); err != nil {
+				return
+			}
+			receivedFilters <- opts
+
+			_ = websocket.Message.Send(conn, `{"entity-type":"instance","operation":"create","payload":{"name":"road-runner"}}`)
+
+			// keep the connection open until the client disconnects
+			var msg string
+			_ = websocket.Message.Receive(conn, &msg)
+		},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/ws/events" || r.Header.Get("Authorization") != "Bearer my-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		wsServer.ServeHTTP(w, r)
+	}))
+	defer server.Close()
+
+	mockBaseClient := mock.NewMockGarmClient(mockCtrl)
+	mockBaseClient.EXPECT().BaseURL().Return(server.URL).AnyTimes()
+	mockBaseClient.EXPECT().Name().Return(DefaultServerName).AnyTimes()
+	gomock.InOrder(
+		mockBaseClient.EXPECT().BearerToken().Return("expired-token").Times(2),
+		mockBaseClient.EXPECT().RefreshToken("expired-token").Return(nil),
+		mockBaseClient.EXPECT().BearerToken().Return("my-token").AnyTimes(),
+	)
+
+	stream := NewEventStream(mockBaseClient, filters...)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		stream.Run(ctx)
+		close(done)
+	}()
+
+	select {
+	case e := <-stream.Events():
+		assert.Equal(t, InstanceEntityType, e.EntityType)
+		assert.Equal(t, CreateOperation, e.Operation)
+		assert.JSONEq(t, `{"name":"road-runner"}`, string(e.Payload))
+	case <-time.After(5 * time.Second):
+		t.Fatal("no event received")
+	}
+
+	assert.Equal(t, eventStreamOptions{Filters: filters}, <-receivedFilters)
+
+	connection, connected := stream.Connection()
+	assert.Equal(t, uint64(1), connection)
+	assert.True(t, connected)
+
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("event stream didn't stop")
+	}
+
+	_, connected = stream.Connection()
+	assert.False(t, connected)
+
+	_, open := <-stream.Events()
+	assert.False(t, open)
+}
+
+func TestEventStream_connect(t *testing.T) {
+	tests := []struct {
+		name        string
+		statusCode  int
+		wantRefresh bool
+	}{
+		{
+			name:        "unauthorized - expect re-login",
+			statusCode:  http.StatusUnauthorized,
+			wantRefresh: true,
+		},
+		{
+			name:        "forbidden - expect re-login",
+			statusCode:  http.StatusForbidden,
+			wantRefresh: true,
+		},
+		{
+			name:        "not found - expect no re-login",
+			statusCode:  http.StatusNotFound,
+			wantRefresh: false,
+		},
+		{
+			name:        "service unavailable - expect no re-login",
+			statusCode:  http.StatusServiceUnavailable,
+			wantRefresh: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.statusCode)
+			}))
+			defer server.Close()
+
+			mockBaseClient := mock.NewMockGarmClient(mockCtrl)
+			mockBaseClient.EXPECT().BaseURL().Return(server.URL).AnyTimes()
+			mockBaseClient.EXPECT().Name().Return(DefaultServerName).AnyTimes()
+			mockBaseClient.EXPECT().BearerToken().Return("my-token").AnyTimes()
+			if tt.wantRefresh {
+				mockBaseClient.EXPECT().RefreshToken("my-token").Return(nil)
+			}
+
+			stream := NewEventStream(mockBaseClient)
+			_, err := stream.connect(context.Background())
+
+			var statusErr *handshakeStatusError
+			assert.ErrorAs(t, err, &statusErr)
+			assert.Equal(t, tt.statusCode, statusErr.StatusCode)
+			assert.ErrorIs(t, err, websocket.ErrBadStatus)
+		})
+	}
+}
+
+func TestDialEventStream(t *testing.T) {
+	echo := websocket.Server{
+		Handler: func(conn *websocket.Conn) {
+			var msg string
+			if err := websocket.Message.Receive(conn, &msg); err != nil {
+				return
+			}
+			_ = websocket.Message.Send(conn, msg)
+		},
+	}
+
+	server := httptest.NewServer(echo)
+	defer server.Close()
+
+	tlsServer := httptest.NewTLSServer(echo)
+	defer tlsServer.Close()
+	caCertBundle := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: tlsServer.Certificate().Raw})
+
+	// proxy tunnels CONNECT requests to the requested host
+	var proxied atomic.Bool
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodConnect {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		target, err := net.Dial("tcp", r.Host)
+		if err != nil {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		defer target.Close()
+
+		conn, _, err := http.NewResponseController(w).Hijack()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		proxied.Store(true)
+
+		if _, err := conn.Write([]byte("HTTP/1.1 200 Connection established\r\n\r\n")); err != nil {
+			return
+		}
+
+		go func() { _, _ = io.Copy(target, conn) }()
+		_, _ = io.Copy(conn, target)
+	}))
+	defer proxy.Close()
+	proxyURL, _ := url.Parse(proxy.URL)
+
+	tests := []struct {
+		name        string
+		baseURL     string
+		transport   func(t *testing.T) *http.Transport
+		wantErr     bool
+		wantProxied bool
+	}{
+		{
+			name:    "direct connection",
+			baseURL: server.URL,
+			transport: func(_ *testing.T) *http.Transport {
+				return &http.Transport{}
+			},
+		},
+		{
+			name:    "connection through proxy",
+			baseURL: server.URL,
+			transport: func(_ *testing.T) *http.Transport {
+				return &http.Transport{Proxy: http.ProxyURL(proxyURL)}
+			},
+			wantProxied: true,
+		},
+		{
+			name:    "tls connection with the CA cert bundle of the garm client",
+			baseURL: tlsServer.URL,
+			transport: func(t *testing.T) *http.Transport {
+				transport, err := newTransport("event-stream-test", GarmScopeParams{BaseURL: tlsServer.URL, CACertBundle: caCertBundle})
+				if err != nil {
+					t.Fatal(err)
+				}
+				return httpTransport(&garmClient{transport: transport})
+			},
+		},
+		{
+			name:    "tls connection through proxy",
+			baseURL: tlsServer.URL,
+			transport: func(_ *testing.T) *http.Transport {
+				return &http.Transport{Proxy: http.ProxyURL(proxyURL), TLSClientConfig: tlsServer.Client().Transport.(*http.Transport).TLSClientConfig}
+			},
+			wantProxied: true,
+		},
+		{
+			name:    "tls connection with unknown CA",
+			baseURL: tlsServer.URL,
+			transport: func(_ *testing.T) *http.Transport {
+				return &http.Transport{}
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			proxied.Store(false)
+
+			config, err := eventStreamConfig(tt.baseURL, "my-token")
+			assert.NoError(t, err)
+
+			conn, err := dialEventStream(context.Background(), config, tt.transport(t))
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			if !assert.NoError(t, err) {
+				return
+			}
+			defer conn.Close()
+
+			var msg string
+			assert.NoError(t, websocket.Message.Send(conn, "road-runner"))
+			assert.NoError(t, websocket.Message.Receive(conn, &msg))
+			assert.Equal(t, "road-runner", msg)
+			assert.Equal(t, tt.wantProxied, proxied.Load())
+		})
+	}
+}
+
+func TestEventStreamConfig(t *testing.T) {
+	tests := []struct {
+		name         string
+		baseURL      string
+		wantLocation string
+	}{
+		{
+			name:         "http",
+			baseURL:      "http://garm-server:9997",
+			wantLocation: "ws://garm-server:9997/api/v1/ws/events",
+		},
+		{
+			name:         "https with path",
+			baseURL:      "https://garm.example.com/garm",
+			wantLocation: "wss://garm.example.com/garm/api/v1/ws/events",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config, err := eventStreamConfig(tt.baseURL, "my-token")
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantLocation, config.Location.String())
+			assert.Equal(t, "Bearer my-token", config.Header.Get("Authorization"))
+		})
+	}
+}
@@ -19,18 +19,18 @@ type instanceClient struct {
 	GarmClient
 }
 
-func NewInstanceClient() InstanceClient {
+func NewInstanceClient(client GarmClient) InstanceClient {
 	return &instanceClient{
-		Client,
+		client,
 	}
 }
 
 func (i *instanceClient) GetInstance(params *instances.GetInstanceParams) (*instances.GetInstanceOK, error) {
-	return EnsureAuth(func() (*instances.GetInstanceOK, error) {
-		metrics.TotalGarmCalls.WithLabelValues("instances.Get").Inc()
+	return EnsureAuth(i, func() (*instances.GetInstanceOK, error) {
+		metrics.TotalGarmCalls.WithLabelValues(i.Name(), "instances.Get").Inc()
 		instance, err := i.GarmAPI().Instances.GetInstance(params, i.Token())
 		if err != nil {
-			metrics.GarmCallErrors.WithLabelValues("instances.Get").Inc()
+			metrics.GarmCallErrors.WithLabelValues(i.Name(), "instances.Get").Inc()
 			return nil, err
 		}
 		return instance, nil
@@ -38,11 +38,11 @@ func (i *instanceClient) GetInstance(params *instances.GetInstanceParams) (*inst
 }
 
 func (i *instanceClient) ListInstances(params *instances.ListInstancesParams) (*instances.ListInstancesOK, error) {
-	return EnsureAuth(func() (*instances.ListInstancesOK, error) {
-		metrics.TotalGarmCalls.WithLabelValues("instances.List").Inc()
+	return EnsureAuth(i, func() (*instances.ListInstancesOK, error) {
+		metrics.TotalGarmCalls.WithLabelValues(i.Name(), "instances.List").Inc()
 		instances, err := i.GarmAPI().Instances.ListInstances(params, i.Token())
 		if err != nil {
-			metrics.GarmCallErrors.WithLabelValues("instances.List").Inc()
+			metrics.GarmCallErrors.WithLabelValues(i.Name(), "instances.List").Inc()
 			return nil, err
 		}
 		return instances, nil
@@ -50,11 +50,11 @@ func (i *instanceClient) ListInstances(params *instances.ListInstancesParams) (*
 }
 
 func (i *instanceClient) ListPoolInstances(params *instances.ListPoolInstancesParams) (*instances.ListPoolInstancesOK, error) {
-	return EnsureAuth(func() (*instances.ListPoolInstancesOK, error) {
-		metrics.TotalGarmCalls.WithLabelValues("instances.ListPool").Inc()
+	return EnsureAuth(i, func() (*instances.ListPoolInstancesOK, error) {
+		metrics.TotalGarmCalls.WithLabelValues(i.Name(), "instances.ListPool").Inc()
 		instances, err := i.GarmAPI().Instances.ListPoolInstances(params, i.Token())
 		if err != nil {
-			metrics.GarmCallErrors.WithLabelValues("instances.ListPool").Inc()
+			metrics.GarmCallErrors.WithLabelValues(i.Name(), "instances.ListPool").Inc()
 			return nil, err
 		}
 		return instances, nil
@@ -62,11 +62,11 @@ func (i *instanceClient) ListPoolInstances(params *instances.ListPoolInstancesPa
 }
 
 func (i *instanceClient) DeleteInstance(params *instances.DeleteInstanceParams) error {
-	_, err := EnsureAuth(func() (interface{}, error) {
-		metrics.TotalGarmCalls.WithLabelValues("instances.Delete").Inc()
+	_, err := EnsureAuth(i, func() (interface{}, error) {
+		metrics.TotalGarmCalls.WithLabelValues(i.Name(), "instances.Delete").Inc()
 		err := i.GarmAPI().Instances.DeleteInstance(params, i.Token())
 		if err != nil {
-			metrics.GarmCallErrors.WithLabelValues("instances.ListPool").Inc()
+			metrics.GarmCallErrors.WithLabelValues(i.Name(), "instances.ListPool").Inc()
 			return nil, err
 		}
 		return nil, nil
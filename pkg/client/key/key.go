@@ -3,14 +3,27 @@
 package key
 
 const (
-	groupName                   = "garm-operator.mercedes-benz.com"
-	EnterpriseFinalizerName     = groupName + "/enterprise"
-	OrganizationFinalizerName   = groupName + "/organization"
-	RepositoryFinalizerName     = groupName + "/repository"
-	PoolFinalizerName           = groupName + "/pool"
-	RunnerFinalizerName         = groupName + "/runner"
-	GitHubEndpointFinalizerName = groupName + "/endpoint"
-	CredentialsFinalizerName    = groupName + "/credentials"
-	ServerConfigFinalizerName   = groupName + "/serverconfig"
-	PausedAnnotation            = groupName + "/paused"
+	groupName                     = "garm-operator.mercedes-benz.com"
+	EnterpriseFinalizerName       = groupName + "/enterprise"
+	OrganizationFinalizerName     = groupName + "/organization"
+	RepositoryFinalizerName       = groupName + "/repository"
+	PoolFinalizerName             = groupName + "/pool"
+	RunnerFinalizerName           = groupName + "/runner"
+	GitHubEndpointFinalizerName   = groupName + "/endpoint"
+	CredentialsFinalizerName      = groupName + "/credentials"
+	ServerConfigFinalizerName     = groupName + "/serverconfig"
+	GarmServerFinalizerName       = groupName + "/garmserver"
+	PausedAnnotation              = groupName + "/paused"
+	AdoptAnnotation               = groupName + "/adopt"
+	ForceDeleteAnnotation         = groupName + "/force-delete"
+	RecycleAnnotation             = groupName + "/recycle"
+	DoNotReapAnnotation           = groupName + "/do-not-reap"
+	RotateWebhookSecretAnnotation = groupName + "/rotate-webhook-secret"
+	GeneratedAtAnnotation         = groupName + "/generated-at"
+	PoolLabel                     = groupName + "/pool"
+	ScopeKindLabel                = groupName + "/scope-kind"
+	ScopeNameLabel                = groupName + "/scope-name"
+	OSTypeLabel                   = groupName + "/os-type"
+	OSArchLabel                   = groupName + "/os-arch"
+	RunnerStatusLabel             = groupName + "/runner-status"
 )
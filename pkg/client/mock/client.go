@@ -12,6 +12,7 @@ package mock
 
 import (
 	reflect "reflect"
+	time "time"
 
 	client "github.com/cloudbase/garm/client"
 	runtime "github.com/go-openapi/runtime"
@@ -41,6 +42,49 @@ func (m *MockGarmClient) EXPECT() *MockGarmClientMockRecorder {
 	return m.recorder
 }
 
+// BaseURL mocks base method.
+func (m *MockGarmClient) BaseURL() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BaseURL")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// BaseURL indicates an expected call of BaseURL.
+func (mr *MockGarmClientMockRecorder) BaseURL() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BaseURL", reflect.TypeOf((*MockGarmClient)(nil).BaseURL))
+}
+
+// BearerToken mocks base method.
+func (m *MockGarmClient) BearerToken() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BearerToken")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// BearerToken indicates an expected call of BearerToken.
+func (mr *MockGarmClientMockRecorder) BearerToken() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BearerToken", reflect.TypeOf((*MockGarmClient)(nil).BearerToken))
+}
+
+// CircuitOpen mocks base method.
+func (m *MockGarmClient) CircuitOpen() (time.Duration, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CircuitOpen")
+	ret0, _ := ret[0].(time.Duration)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// CircuitOpen indicates an expected call of CircuitOpen.
+func (mr *MockGarmClientMockRecorder) CircuitOpen() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CircuitOpen", reflect.TypeOf((*MockGarmClient)(nil).CircuitOpen))
+}
+
 // GarmAPI mocks base method.
 func (m *MockGarmClient) GarmAPI() *client.GarmAPI {
 	m.ctrl.T.Helper()
@@ -83,6 +127,34 @@ func (mr *MockGarmClientMockRecorder) Login() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Login", reflect.TypeOf((*MockGarmClient)(nil).Login))
 }
 
+// Name mocks base method.
+func (m *MockGarmClient) Name() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Name")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// Name indicates an expected call of Name.
+func (mr *MockGarmClientMockRecorder) Name() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Name", reflect.TypeOf((*MockGarmClient)(nil).Name))
+}
+
+// RefreshToken mocks base method.
+func (m *MockGarmClient) RefreshToken(staleToken string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefreshToken", staleToken)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RefreshToken indicates an expected call of RefreshToken.
+func (mr *MockGarmClientMockRecorder) RefreshToken(staleToken any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshToken", reflect.TypeOf((*MockGarmClient)(nil).RefreshToken), staleToken)
+}
+
 // Token mocks base method.
 func (m *MockGarmClient) Token() runtime.ClientAuthInfoWriter {
 	m.ctrl.T.Helper()
@@ -21,6 +21,7 @@ import (
 type MockOrganizationClient struct {
 	ctrl     *gomock.Controller
 	recorder *MockOrganizationClientMockRecorder
+	isgomock struct{}
 }
 
 // MockOrganizationClientMockRecorder is the mock recorder for MockOrganizationClient.
@@ -84,6 +85,36 @@ func (mr *MockOrganizationClientMockRecorder) GetOrganization(param any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganization", reflect.TypeOf((*MockOrganizationClient)(nil).GetOrganization), param)
 }
 
+// GetOrganizationWebhookInfo mocks base method.
+func (m *MockOrganizationClient) GetOrganizationWebhookInfo(param *organizations.GetOrgWebhookInfoParams) (*organizations.GetOrgWebhookInfoOK, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrganizationWebhookInfo", param)
+	ret0, _ := ret[0].(*organizations.GetOrgWebhookInfoOK)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrganizationWebhookInfo indicates an expected call of GetOrganizationWebhookInfo.
+func (mr *MockOrganizationClientMockRecorder) GetOrganizationWebhookInfo(param any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationWebhookInfo", reflect.TypeOf((*MockOrganizationClient)(nil).GetOrganizationWebhookInfo), param)
+}
+
+// InstallOrganizationWebhook mocks base method.
+func (m *MockOrganizationClient) InstallOrganizationWebhook(param *organizations.InstallOrgWebhookParams) (*organizations.InstallOrgWebhookOK, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InstallOrganizationWebhook", param)
+	ret0, _ := ret[0].(*organizations.InstallOrgWebhookOK)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InstallOrganizationWebhook indicates an expected call of InstallOrganizationWebhook.
+func (mr *MockOrganizationClientMockRecorder) InstallOrganizationWebhook(param any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstallOrganizationWebhook", reflect.TypeOf((*MockOrganizationClient)(nil).InstallOrganizationWebhook), param)
+}
+
 // ListOrganizations mocks base method.
 func (m *MockOrganizationClient) ListOrganizations(param *organizations.ListOrgsParams) (*organizations.ListOrgsOK, error) {
 	m.ctrl.T.Helper()
@@ -99,6 +130,20 @@ func (mr *MockOrganizationClientMockRecorder) ListOrganizations(param any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOrganizations", reflect.TypeOf((*MockOrganizationClient)(nil).ListOrganizations), param)
 }
 
+// UninstallOrganizationWebhook mocks base method.
+func (m *MockOrganizationClient) UninstallOrganizationWebhook(param *organizations.UninstallOrgWebhookParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UninstallOrganizationWebhook", param)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UninstallOrganizationWebhook indicates an expected call of UninstallOrganizationWebhook.
+func (mr *MockOrganizationClientMockRecorder) UninstallOrganizationWebhook(param any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UninstallOrganizationWebhook", reflect.TypeOf((*MockOrganizationClient)(nil).UninstallOrganizationWebhook), param)
+}
+
 // UpdateOrganization mocks base method.
 func (m *MockOrganizationClient) UpdateOrganization(param *organizations.UpdateOrgParams) (*organizations.UpdateOrgOK, error) {
 	m.ctrl.T.Helper()
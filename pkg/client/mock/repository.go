@@ -21,6 +21,7 @@ import (
 type MockRepositoryClient struct {
 	ctrl     *gomock.Controller
 	recorder *MockRepositoryClientMockRecorder
+	isgomock struct{}
 }
 
 // MockRepositoryClientMockRecorder is the mock recorder for MockRepositoryClient.
@@ -84,6 +85,36 @@ func (mr *MockRepositoryClientMockRecorder) GetRepository(param any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRepository", reflect.TypeOf((*MockRepositoryClient)(nil).GetRepository), param)
 }
 
+// GetRepositoryWebhookInfo mocks base method.
+func (m *MockRepositoryClient) GetRepositoryWebhookInfo(param *repositories.GetRepoWebhookInfoParams) (*repositories.GetRepoWebhookInfoOK, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRepositoryWebhookInfo", param)
+	ret0, _ := ret[0].(*repositories.GetRepoWebhookInfoOK)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRepositoryWebhookInfo indicates an expected call of GetRepositoryWebhookInfo.
+func (mr *MockRepositoryClientMockRecorder) GetRepositoryWebhookInfo(param any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRepositoryWebhookInfo", reflect.TypeOf((*MockRepositoryClient)(nil).GetRepositoryWebhookInfo), param)
+}
+
+// InstallRepositoryWebhook mocks base method.
+func (m *MockRepositoryClient) InstallRepositoryWebhook(param *repositories.InstallRepoWebhookParams) (*repositories.InstallRepoWebhookOK, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InstallRepositoryWebhook", param)
+	ret0, _ := ret[0].(*repositories.InstallRepoWebhookOK)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InstallRepositoryWebhook indicates an expected call of InstallRepositoryWebhook.
+func (mr *MockRepositoryClientMockRecorder) InstallRepositoryWebhook(param any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstallRepositoryWebhook", reflect.TypeOf((*MockRepositoryClient)(nil).InstallRepositoryWebhook), param)
+}
+
 // ListRepositories mocks base method.
 func (m *MockRepositoryClient) ListRepositories(param *repositories.ListReposParams) (*repositories.ListReposOK, error) {
 	m.ctrl.T.Helper()
@@ -99,6 +130,20 @@ func (mr *MockRepositoryClientMockRecorder) ListRepositories(param any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRepositories", reflect.TypeOf((*MockRepositoryClient)(nil).ListRepositories), param)
 }
 
+// UninstallRepositoryWebhook mocks base method.
+func (m *MockRepositoryClient) UninstallRepositoryWebhook(param *repositories.UninstallRepoWebhookParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UninstallRepositoryWebhook", param)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UninstallRepositoryWebhook indicates an expected call of UninstallRepositoryWebhook.
+func (mr *MockRepositoryClientMockRecorder) UninstallRepositoryWebhook(param any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UninstallRepositoryWebhook", reflect.TypeOf((*MockRepositoryClient)(nil).UninstallRepositoryWebhook), param)
+}
+
 // UpdateRepository mocks base method.
 func (m *MockRepositoryClient) UpdateRepository(param *repositories.UpdateRepoParams) (*repositories.UpdateRepoOK, error) {
 	m.ctrl.T.Helper()
@@ -14,24 +14,27 @@ type OrganizationClient interface {
 	GetOrganization(param *organizations.GetOrgParams) (*organizations.GetOrgOK, error)
 	UpdateOrganization(param *organizations.UpdateOrgParams) (*organizations.UpdateOrgOK, error)
 	DeleteOrganization(param *organizations.DeleteOrgParams) error
+	InstallOrganizationWebhook(param *organizations.InstallOrgWebhookParams) (*organizations.InstallOrgWebhookOK, error)
+	UninstallOrganizationWebhook(param *organizations.UninstallOrgWebhookParams) error
+	GetOrganizationWebhookInfo(param *organizations.GetOrgWebhookInfoParams) (*organizations.GetOrgWebhookInfoOK, error)
 }
 
 type organizationClient struct {
 	GarmClient
 }
 
-func NewOrganizationClient() OrganizationClient {
+func NewOrganizationClient(client GarmClient) OrganizationClient {
 	return &organizationClient{
-		Client,
+		client,
 	}
 }
 
 func (s *organizationClient) ListOrganizations(param *organizations.ListOrgsParams) (*organizations.ListOrgsOK, error) {
-	return EnsureAuth(func() (*organizations.ListOrgsOK, error) {
-		metrics.TotalGarmCalls.WithLabelValues("organization.List").Inc()
+	return EnsureAuth(s, func() (*organizations.ListOrgsOK, error) {
+		metrics.TotalGarmCalls.WithLabelValues(s.Name(), "organization.List").Inc()
 		organizations, err := s.GarmAPI().Organizations.ListOrgs(param, s.Token())
 		if err != nil {
-			metrics.GarmCallErrors.WithLabelValues("organization.List").Inc()
+			metrics.GarmCallErrors.WithLabelValues(s.Name(), "organization.List").Inc()
 			return nil, err
 		}
 		return organizations, nil
@@ -39,11 +42,11 @@ func (s *organizationClient) ListOrganizations(param *organizations.ListOrgsPara
 }
 
 func (s *organizationClient) CreateOrganization(param *organizations.CreateOrgParams) (*organizations.CreateOrgOK, error) {
-	return EnsureAuth(func() (*organizations.CreateOrgOK, error) {
-		metrics.TotalGarmCalls.WithLabelValues("organization.Create").Inc()
+	return EnsureAuth(s, func() (*organizations.CreateOrgOK, error) {
+		metrics.TotalGarmCalls.WithLabelValues(s.Name(), "organization.Create").Inc()
 		organization, err := s.GarmAPI().Organizations.CreateOrg(param, s.Token())
 		if err != nil {
-			metrics.GarmCallErrors.WithLabelValues("organization.Create").Inc()
+			metrics.GarmCallErrors.WithLabelValues(s.Name(), "organization.Create").Inc()
 			return nil, err
 		}
 		return organization, nil
@@ -51,11 +54,11 @@ func (s *organizationClient) CreateOrganization(param *organizations.CreateOrgPa
 }
 
 func (s *organizationClient) GetOrganization(param *organizations.GetOrgParams) (*organizations.GetOrgOK, error) {
-	return EnsureAuth(func() (*organizations.GetOrgOK, error) {
-		metrics.TotalGarmCalls.WithLabelValues("organization.Get").Inc()
+	return EnsureAuth(s, func() (*organizations.GetOrgOK, error) {
+		metrics.TotalGarmCalls.WithLabelValues(s.Name(), "organization.Get").Inc()
 		organization, err := s.GarmAPI().Organizations.GetOrg(param, s.Token())
 		if err != nil {
-			metrics.GarmCallErrors.WithLabelValues("organization.Get").Inc()
+			metrics.GarmCallErrors.WithLabelValues(s.Name(), "organization.Get").Inc()
 			return nil, err
 		}
 		return organization, nil
@@ -63,11 +66,11 @@ func (s *organizationClient) GetOrganization(param *organizations.GetOrgParams)
 }
 
 func (s *organizationClient) DeleteOrganization(param *organizations.DeleteOrgParams) error {
-	_, err := EnsureAuth(func() (interface{}, error) {
-		metrics.TotalGarmCalls.WithLabelValues("organization.Delete").Inc()
+	_, err := EnsureAuth(s, func() (interface{}, error) {
+		metrics.TotalGarmCalls.WithLabelValues(s.Name(), "organization.Delete").Inc()
 		err := s.GarmAPI().Organizations.DeleteOrg(param, s.Token())
 		if err != nil {
-			metrics.GarmCallErrors.WithLabelValues("organization.Delete").Inc()
+			metrics.GarmCallErrors.WithLabelValues(s.Name(), "organization.Delete").Inc()
 			return nil, err
 		}
 		return nil, nil
@@ -76,13 +79,50 @@ func (s *organizationClient) DeleteOrganization(param *organizations.DeleteOrgPa
 }
 
 func (s *organizationClient) UpdateOrganization(param *organizations.UpdateOrgParams) (*organizations.UpdateOrgOK, error) {
-	return EnsureAuth(func() (*organizations.UpdateOrgOK, error) {
-		metrics.TotalGarmCalls.WithLabelValues("organization.Update").Inc()
+	return EnsureAuth(s, func() (*organizations.UpdateOrgOK, error) {
+		metrics.TotalGarmCalls.WithLabelValues(s.Name(), "organization.Update").Inc()
 		organization, err := s.GarmAPI().Organizations.UpdateOrg(param, s.Token())
 		if err != nil {
-			metrics.GarmCallErrors.WithLabelValues("organization.Update").Inc()
+			metrics.GarmCallErrors.WithLabelValues(s.Name(), "organization.Update").Inc()
 			return nil, err
 		}
 		return organization, nil
 	})
 }
+
+func (s *organizationClient) InstallOrganizationWebhook(param *organizations.InstallOrgWebhookParams) (*organizations.InstallOrgWebhookOK, error) {
+	return EnsureAuth(s, func() (*organizations.InstallOrgWebhookOK, error) {
+		metrics.TotalGarmCalls.WithLabelValues(s.Name(), "organization.InstallWebhook").Inc()
+		hookInfo, err := s.GarmAPI().Organizations.InstallOrgWebhook(param, s.Token())
+		if err != nil {
+			metrics.GarmCallErrors.WithLabelValues(s.Name(), "organization.InstallWebhook").Inc()
+			return nil, err
+		}
+		return hookInfo, nil
+	})
+}
+
+func (s *organizationClient) UninstallOrganizationWebhook(param *organizations.UninstallOrgWebhookParams) error {
+	_, err := EnsureAuth(s, func() (interface{}, error) {
+		metrics.TotalGarmCalls.WithLabelValues(s.Name(), "organization.UninstallWebhook").Inc()
+		err := s.GarmAPI().Organizations.UninstallOrgWebhook(param, s.Token())
+		if err != nil {
+			metrics.GarmCallErrors.WithLabelValues(s.Name(), "organization.UninstallWebhook").Inc()
+			return nil, err
+		}
+		return nil, nil
+	})
+	return err
+}
+
+func (s *organizationClient) GetOrganizationWebhookInfo(param *organizations.GetOrgWebhookInfoParams) (*organizations.GetOrgWebhookInfoOK, error) {
+	return EnsureAuth(s, func() (*organizations.GetOrgWebhookInfoOK, error) {
+		metrics.TotalGarmCalls.WithLabelValues(s.Name(), "organization.GetWebhookInfo").Inc()
+		hookInfo, err := s.GarmAPI().Organizations.GetOrgWebhookInfo(param, s.Token())
+		if err != nil {
+			metrics.GarmCallErrors.WithLabelValues(s.Name(), "organization.GetWebhookInfo").Inc()
+			return nil, err
+		}
+		return hookInfo, nil
+	})
+}
@@ -28,18 +28,18 @@ type poolClient struct {
 	GarmClient
 }
 
-func NewPoolClient() PoolClient {
+func NewPoolClient(client GarmClient) PoolClient {
 	return &poolClient{
-		Client,
+		client,
 	}
 }
 
 func (p *poolClient) ListAllPools(param *pools.ListPoolsParams) (*pools.ListPoolsOK, error) {
-	return EnsureAuth(func() (*pools.ListPoolsOK, error) {
-		metrics.TotalGarmCalls.WithLabelValues("pool.List").Inc()
+	return EnsureAuth(p, func() (*pools.ListPoolsOK, error) {
+		metrics.TotalGarmCalls.WithLabelValues(p.Name(), "pool.List").Inc()
 		pools, err := p.GarmAPI().Pools.ListPools(param, p.Token())
 		if err != nil {
-			metrics.GarmCallErrors.WithLabelValues("pool.List").Inc()
+			metrics.GarmCallErrors.WithLabelValues(p.Name(), "pool.List").Inc()
 			return nil, err
 		}
 		return pools, nil
@@ -47,11 +47,11 @@ func (p *poolClient) ListAllPools(param *pools.ListPoolsParams) (*pools.ListPool
 }
 
 func (p *poolClient) CreateRepoPool(param *repositories.CreateRepoPoolParams) (*repositories.CreateRepoPoolOK, error) {
-	return EnsureAuth(func() (*repositories.CreateRepoPoolOK, error) {
-		metrics.TotalGarmCalls.WithLabelValues("pool.CreateRepo").Inc()
+	return EnsureAuth(p, func() (*repositories.CreateRepoPoolOK, error) {
+		metrics.TotalGarmCalls.WithLabelValues(p.Name(), "pool.CreateRepo").Inc()
 		pool, err := p.GarmAPI().Repositories.CreateRepoPool(param, p.Token())
 		if err != nil {
-			metrics.GarmCallErrors.WithLabelValues("pool.CreateRepo").Inc()
+			metrics.GarmCallErrors.WithLabelValues(p.Name(), "pool.CreateRepo").Inc()
 			return nil, err
 		}
 		return pool, nil
@@ -59,11 +59,11 @@ func (p *poolClient) CreateRepoPool(param *repositories.CreateRepoPoolParams) (*
 }
 
 func (p *poolClient) CreateOrgPool(param *organizations.CreateOrgPoolParams) (*organizations.CreateOrgPoolOK, error) {
-	return EnsureAuth(func() (*organizations.CreateOrgPoolOK, error) {
-		metrics.TotalGarmCalls.WithLabelValues("pool.CreateOrg").Inc()
+	return EnsureAuth(p, func() (*organizations.CreateOrgPoolOK, error) {
+		metrics.TotalGarmCalls.WithLabelValues(p.Name(), "pool.CreateOrg").Inc()
 		pool, err := p.GarmAPI().Organizations.CreateOrgPool(param, p.Token())
 		if err != nil {
-			metrics.GarmCallErrors.WithLabelValues("pool.CreateOrg").Inc()
+			metrics.GarmCallErrors.WithLabelValues(p.Name(), "pool.CreateOrg").Inc()
 			return nil, err
 		}
 		return pool, nil
@@ -71,11 +71,11 @@ func (p *poolClient) CreateOrgPool(param *organizations.CreateOrgPoolParams) (*o
 }
 
 func (p *poolClient) CreateEnterprisePool(param *enterprises.CreateEnterprisePoolParams) (*enterprises.CreateEnterprisePoolOK, error) {
-	return EnsureAuth(func() (*enterprises.CreateEnterprisePoolOK, error) {
-		metrics.TotalGarmCalls.WithLabelValues("pool.CreateEnterprise").Inc()
+	return EnsureAuth(p, func() (*enterprises.CreateEnterprisePoolOK, error) {
+		metrics.TotalGarmCalls.WithLabelValues(p.Name(), "pool.CreateEnterprise").Inc()
 		pool, err := p.GarmAPI().Enterprises.CreateEnterprisePool(param, p.Token())
 		if err != nil {
-			metrics.GarmCallErrors.WithLabelValues("pool.CreateEnterprise").Inc()
+			metrics.GarmCallErrors.WithLabelValues(p.Name(), "pool.CreateEnterprise").Inc()
 			return nil, err
 		}
 		return pool, nil
@@ -83,11 +83,11 @@ func (p *poolClient) CreateEnterprisePool(param *enterprises.CreateEnterprisePoo
 }
 
 func (p *poolClient) UpdateEnterprisePool(param *enterprises.UpdateEnterprisePoolParams) (*enterprises.UpdateEnterprisePoolOK, error) {
-	return EnsureAuth(func() (*enterprises.UpdateEnterprisePoolOK, error) {
-		metrics.TotalGarmCalls.WithLabelValues("pool.UpdateEnterprise").Inc()
+	return EnsureAuth(p, func() (*enterprises.UpdateEnterprisePoolOK, error) {
+		metrics.TotalGarmCalls.WithLabelValues(p.Name(), "pool.UpdateEnterprise").Inc()
 		pool, err := p.GarmAPI().Enterprises.UpdateEnterprisePool(param, p.Token())
 		if err != nil {
-			metrics.GarmCallErrors.WithLabelValues("pool.UpdateEnterprise").Inc()
+			metrics.GarmCallErrors.WithLabelValues(p.Name(), "pool.UpdateEnterprise").Inc()
 			return nil, err
 		}
 		return pool, nil
@@ -95,11 +95,11 @@ func (p *poolClient) UpdateEnterprisePool(param *enterprises.UpdateEnterprisePoo
 }
 
 func (p *poolClient) UpdatePool(param *pools.UpdatePoolParams) (*pools.UpdatePoolOK, error) {
-	return EnsureAuth(func() (*pools.UpdatePoolOK, error) {
-		metrics.TotalGarmCalls.WithLabelValues("pool.UpdatePool").Inc()
+	return EnsureAuth(p, func() (*pools.UpdatePoolOK, error) {
+		metrics.TotalGarmCalls.WithLabelValues(p.Name(), "pool.UpdatePool").Inc()
 		pool, err := p.GarmAPI().Pools.UpdatePool(param, p.Token())
 		if err != nil {
-			metrics.GarmCallErrors.WithLabelValues("pool.UpdatePool").Inc()
+			metrics.GarmCallErrors.WithLabelValues(p.Name(), "pool.UpdatePool").Inc()
 			return nil, err
 		}
 		return pool, nil
@@ -107,11 +107,11 @@ func (p *poolClient) UpdatePool(param *pools.UpdatePoolParams) (*pools.UpdatePoo
 }
 
 func (p *poolClient) GetEnterprisePool(param *enterprises.GetEnterprisePoolParams) (*enterprises.GetEnterprisePoolOK, error) {
-	return EnsureAuth(func() (*enterprises.GetEnterprisePoolOK, error) {
-		metrics.TotalGarmCalls.WithLabelValues("pool.GetEnterprise").Inc()
+	return EnsureAuth(p, func() (*enterprises.GetEnterprisePoolOK, error) {
+		metrics.TotalGarmCalls.WithLabelValues(p.Name(), "pool.GetEnterprise").Inc()
 		pool, err := p.GarmAPI().Enterprises.GetEnterprisePool(param, p.Token())
 		if err != nil {
-			metrics.GarmCallErrors.WithLabelValues("pool.GetEnterprise").Inc()
+			metrics.GarmCallErrors.WithLabelValues(p.Name(), "pool.GetEnterprise").Inc()
 			return nil, err
 		}
 		return pool, nil
@@ -119,11 +119,11 @@ func (p *poolClient) GetEnterprisePool(param *enterprises.GetEnterprisePoolParam
 }
 
 func (p *poolClient) GetPool(param *pools.GetPoolParams) (*pools.GetPoolOK, error) {
-	return EnsureAuth(func() (*pools.GetPoolOK, error) {
-		metrics.TotalGarmCalls.WithLabelValues("pool.Get").Inc()
+	return EnsureAuth(p, func() (*pools.GetPoolOK, error) {
+		metrics.TotalGarmCalls.WithLabelValues(p.Name(), "pool.Get").Inc()
 		pool, err := p.GarmAPI().Pools.GetPool(param, p.Token())
 		if err != nil {
-			metrics.GarmCallErrors.WithLabelValues("pool.Get").Inc()
+			metrics.GarmCallErrors.WithLabelValues(p.Name(), "pool.Get").Inc()
 			return nil, err
 		}
 		return pool, nil
@@ -131,11 +131,11 @@ func (p *poolClient) GetPool(param *pools.GetPoolParams) (*pools.GetPoolOK, erro
 }
 
 func (p *poolClient) DeletePool(param *pools.DeletePoolParams) error {
-	_, err := EnsureAuth(func() (interface{}, error) {
-		metrics.TotalGarmCalls.WithLabelValues("pool.Delete").Inc()
+	_, err := EnsureAuth(p, func() (interface{}, error) {
+		metrics.TotalGarmCalls.WithLabelValues(p.Name(), "pool.Delete").Inc()
 		err := p.GarmAPI().Pools.DeletePool(param, p.Token())
 		if err != nil {
-			metrics.GarmCallErrors.WithLabelValues("pool.Delete").Inc()
+			metrics.GarmCallErrors.WithLabelValues(p.Name(), "pool.Delete").Inc()
 			return nil, err
 		}
 		return nil, nil
@@ -144,11 +144,11 @@ func (p *poolClient) DeletePool(param *pools.DeletePoolParams) error {
 }
 
 func (p *poolClient) DeleteEnterprisePool(param *enterprises.DeleteEnterprisePoolParams) error {
-	_, err := EnsureAuth(func() (interface{}, error) {
-		metrics.TotalGarmCalls.WithLabelValues("pool.DeleteEnterprise").Inc()
+	_, err := EnsureAuth(p, func() (interface{}, error) {
+		metrics.TotalGarmCalls.WithLabelValues(p.Name(), "pool.DeleteEnterprise").Inc()
 		err := p.GarmAPI().Enterprises.DeleteEnterprisePool(param, p.Token())
 		if err != nil {
-			metrics.GarmCallErrors.WithLabelValues("pool.DeleteEnterprise").Inc()
+			metrics.GarmCallErrors.WithLabelValues(p.Name(), "pool.DeleteEnterprise").Inc()
 			return nil, err
 		}
 		return nil, nil
@@ -14,24 +14,27 @@ type RepositoryClient interface {
 	GetRepository(param *repositories.GetRepoParams) (*repositories.GetRepoOK, error)
 	UpdateRepository(param *repositories.UpdateRepoParams) (*repositories.UpdateRepoOK, error)
 	DeleteRepository(param *repositories.DeleteRepoParams) error
+	InstallRepositoryWebhook(param *repositories.InstallRepoWebhookParams) (*repositories.InstallRepoWebhookOK, error)
+	UninstallRepositoryWebhook(param *repositories.UninstallRepoWebhookParams) error
+	GetRepositoryWebhookInfo(param *repositories.GetRepoWebhookInfoParams) (*repositories.GetRepoWebhookInfoOK, error)
 }
 
 type repositoryClient struct {
 	GarmClient
 }
 
-func NewRepositoryClient() RepositoryClient {
+func NewRepositoryClient(client GarmClient) RepositoryClient {
 	return &repositoryClient{
-		Client,
+		client,
 	}
 }
 
 func (s *repositoryClient) ListRepositories(param *repositories.ListReposParams) (*repositories.ListReposOK, error) {
-	return EnsureAuth(func() (*repositories.ListReposOK, error) {
-		metrics.TotalGarmCalls.WithLabelValues("repository.List").Inc()
+	return EnsureAuth(s, func() (*repositories.ListReposOK, error) {
+		metrics.TotalGarmCalls.WithLabelValues(s.Name(), "repository.List").Inc()
 		repositories, err := s.GarmAPI().Repositories.ListRepos(param, s.Token())
 		if err != nil {
-			metrics.GarmCallErrors.WithLabelValues("repository.List").Inc()
+			metrics.GarmCallErrors.WithLabelValues(s.Name(), "repository.List").Inc()
 			return nil, err
 		}
 		return repositories, nil
@@ -39,11 +42,11 @@ func (s *repositoryClient) ListRepositories(param *repositories.ListReposParams)
 }
 
 func (s *repositoryClient) CreateRepository(param *repositories.CreateRepoParams) (*repositories.CreateRepoOK, error) {
-	return EnsureAuth(func() (*repositories.CreateRepoOK, error) {
-		metrics.TotalGarmCalls.WithLabelValues("repository.Create").Inc()
+	return EnsureAuth(s, func() (*repositories.CreateRepoOK, error) {
+		metrics.TotalGarmCalls.WithLabelValues(s.Name(), "repository.Create").Inc()
 		repository, err := s.GarmAPI().Repositories.CreateRepo(param, s.Token())
 		if err != nil {
-			metrics.GarmCallErrors.WithLabelValues("repository.Create").Inc()
+			metrics.GarmCallErrors.WithLabelValues(s.Name(), "repository.Create").Inc()
 			return nil, err
 		}
 		return repository, nil
@@ -51,11 +54,11 @@ func (s *repositoryClient) CreateRepository(param *repositories.CreateRepoParams
 }
 
 func (s *repositoryClient) GetRepository(param *repositories.GetRepoParams) (*repositories.GetRepoOK, error) {
-	return EnsureAuth(func() (*repositories.GetRepoOK, error) {
-		metrics.TotalGarmCalls.WithLabelValues("repository.Get").Inc()
+	return EnsureAuth(s, func() (*repositories.GetRepoOK, error) {
+		metrics.TotalGarmCalls.WithLabelValues(s.Name(), "repository.Get").Inc()
 		repository, err := s.GarmAPI().Repositories.GetRepo(param, s.Token())
 		if err != nil {
-			metrics.GarmCallErrors.WithLabelValues("repository.Get").Inc()
+			metrics.GarmCallErrors.WithLabelValues(s.Name(), "repository.Get").Inc()
 			return nil, err
 		}
 		return repository, nil
@@ -63,11 +66,11 @@ func (s *repositoryClient) GetRepository(param *repositories.GetRepoParams) (*re
 }
 
 func (s *repositoryClient) DeleteRepository(param *repositories.DeleteRepoParams) error {
-	_, err := EnsureAuth(func() (interface{}, error) {
-		metrics.TotalGarmCalls.WithLabelValues("repository.Delete").Inc()
+	_, err := EnsureAuth(s, func() (interface{}, error) {
+		metrics.TotalGarmCalls.WithLabelValues(s.Name(), "repository.Delete").Inc()
 		err := s.GarmAPI().Repositories.DeleteRepo(param, s.Token())
 		if err != nil {
-			metrics.GarmCallErrors.WithLabelValues("repository.Delete").Inc()
+			metrics.GarmCallErrors.WithLabelValues(s.Name(), "repository.Delete").Inc()
 			return nil, err
 		}
 		return nil, nil
@@ -76,13 +79,50 @@ func (s *repositoryClient) DeleteRepository(param *repositories.DeleteRepoParams
 }
 
 func (s *repositoryClient) UpdateRepository(param *repositories.UpdateRepoParams) (*repositories.UpdateRepoOK, error) {
-	return EnsureAuth(func() (*repositories.UpdateRepoOK, error) {
-		metrics.TotalGarmCalls.WithLabelValues("repository.Update").Inc()
+	return EnsureAuth(s, func() (*repositories.UpdateRepoOK, error) {
+		metrics.TotalGarmCalls.WithLabelValues(s.Name(), "repository.Update").Inc()
 		repository, err := s.GarmAPI().Repositories.UpdateRepo(param, s.Token())
 		if err != nil {
-			metrics.GarmCallErrors.WithLabelValues("repository.Update").Inc()
+			metrics.GarmCallErrors.WithLabelValues(s.Name(), "repository.Update").Inc()
 			return nil, err
 		}
 		return repository, nil
 	})
 }
+
+func (s *repositoryClient) InstallRepositoryWebhook(param *repositories.InstallRepoWebhookParams) (*repositories.InstallRepoWebhookOK, error) {
+	return EnsureAuth(s, func() (*repositories.InstallRepoWebhookOK, error) {
+		metrics.TotalGarmCalls.WithLabelValues(s.Name(), "repository.InstallWebhook").Inc()
+		hookInfo, err := s.GarmAPI().Repositories.InstallRepoWebhook(param, s.Token())
+		if err != nil {
+			metrics.GarmCallErrors.WithLabelValues(s.Name(), "repository.InstallWebhook").Inc()
+			return nil, err
+		}
+		return hookInfo, nil
+	})
+}
+
+func (s *repositoryClient) UninstallRepositoryWebhook(param *repositories.UninstallRepoWebhookParams) error {
+	_, err := EnsureAuth(s, func() (interface{}, error) {
+		metrics.TotalGarmCalls.WithLabelValues(s.Name(), "repository.UninstallWebhook").Inc()
+		err := s.GarmAPI().Repositories.UninstallRepoWebhook(param, s.Token())
+		if err != nil {
+			metrics.GarmCallErrors.WithLabelValues(s.Name(), "repository.UninstallWebhook").Inc()
+			return nil, err
+		}
+		return nil, nil
+	})
+	return err
+}
+
+func (s *repositoryClient) GetRepositoryWebhookInfo(param *repositories.GetRepoWebhookInfoParams) (*repositories.GetRepoWebhookInfoOK, error) {
+	return EnsureAuth(s, func() (*repositories.GetRepoWebhookInfoOK, error) {
+		metrics.TotalGarmCalls.WithLabelValues(s.Name(), "repository.GetWebhookInfo").Inc()
+		hookInfo, err := s.GarmAPI().Repositories.GetRepoWebhookInfo(param, s.Token())
+		if err != nil {
+			metrics.GarmCallErrors.WithLabelValues(s.Name(), "repository.GetWebhookInfo").Inc()
+			return nil, err
+		}
+		return hookInfo, nil
+	})
+}
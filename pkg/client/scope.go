@@ -0,0 +1,291 @@
+// SPDX-License-Identifier: MIT
+
+package client
+
+import (
+	"github.com/cloudbase/garm/client/enterprises"
+	"github.com/cloudbase/garm/client/organizations"
+	"github.com/cloudbase/garm/client/repositories"
+	"github.com/cloudbase/garm/params"
+)
+
+// Scope is an enterprise, organization or repository in garm.
+type Scope struct {
+	ID                string
+	Name              string
+	PoolManagerStatus params.PoolManagerStatus
+}
+
+// CreateScopeParams are the parameters to create an enterprise, organization or repository in garm.
+type CreateScopeParams struct {
+	// Owner is only used for repositories
+	Owner            string
+	Name             string
+	CredentialsName  string
+	WebhookSecret    string
+	PoolBalancerType params.PoolBalancerType
+}
+
+// ScopeClient manages enterprises, organizations or repositories in garm,
+// regardless of which of them it is.
+type ScopeClient interface {
+	ListScopes() ([]Scope, error)
+	CreateScope(param CreateScopeParams) (Scope, error)
+	UpdateScope(id string, param params.UpdateEntityParams) (Scope, error)
+	DeleteScope(id string) error
+}
+
+// ScopeWebhookClient is a ScopeClient for scopes whose GitHub webhook can be installed through garm.
+type ScopeWebhookClient interface {
+	ScopeClient
+	InstallScopeWebhook(id string, param params.InstallWebhookParams) (params.HookInfo, error)
+	UninstallScopeWebhook(id string) error
+	GetScopeWebhookInfo(id string) (params.HookInfo, error)
+}
+
+type enterpriseScopeClient struct {
+	client EnterpriseClient
+}
+
+// NewEnterpriseScopeClient returns a ScopeClient which manages enterprises through client.
+func NewEnterpriseScopeClient(client EnterpriseClient) ScopeClient {
+	return &enterpriseScopeClient{
+		client: client,
+	}
+}
+
+func enterpriseScope(enterprise params.Enterprise) Scope {
+	return Scope{
+		ID:                enterprise.ID,
+		Name:              enterprise.Name,
+		PoolManagerStatus: enterprise.PoolManagerStatus,
+	}
+}
+
+func (s *enterpriseScopeClient) ListScopes() ([]Scope, error) {
+	retValue, err := s.client.ListEnterprises(enterprises.NewListEnterprisesParams())
+	if err != nil {
+		return nil, err
+	}
+
+	scopes := make([]Scope, 0, len(retValue.Payload))
+	for _, enterprise := range retValue.Payload {
+		scopes = append(scopes, enterpriseScope(enterprise))
+	}
+	return scopes, nil
+}
+
+func (s *enterpriseScopeClient) CreateScope(param CreateScopeParams) (Scope, error) {
+	retValue, err := s.client.CreateEnterprise(
+		enterprises.NewCreateEnterpriseParams().
+			WithBody(params.CreateEnterpriseParams{
+				Name:             param.Name,
+				CredentialsName:  param.CredentialsName,
+				WebhookSecret:    param.WebhookSecret,
+				PoolBalancerType: param.PoolBalancerType,
+			}))
+	if err != nil {
+		return Scope{}, err
+	}
+	return enterpriseScope(retValue.Payload), nil
+}
+
+func (s *enterpriseScopeClient) UpdateScope(id string, param params.UpdateEntityParams) (Scope, error) {
+	retValue, err := s.client.UpdateEnterprise(
+		enterprises.NewUpdateEnterpriseParams().
+			WithEnterpriseID(id).
+			WithBody(param))
+	if err != nil {
+		return Scope{}, err
+	}
+	return enterpriseScope(retValue.Payload), nil
+}
+
+func (s *enterpriseScopeClient) DeleteScope(id string) error {
+	return s.client.DeleteEnterprise(
+		enterprises.NewDeleteEnterpriseParams().
+			WithEnterpriseID(id))
+}
+
+type organizationScopeClient struct {
+	client OrganizationClient
+}
+
+// NewOrganizationScopeClient returns a ScopeWebhookClient which manages organizations through client.
+func NewOrganizationScopeClient(client OrganizationClient) ScopeWebhookClient {
+	return &organizationScopeClient{
+		client: client,
+	}
+}
+
+func organizationScope(organization params.Organization) Scope {
+	return Scope{
+		ID:                organization.ID,
+		Name:              organization.Name,
+		PoolManagerStatus: organization.PoolManagerStatus,
+	}
+}
+
+func (s *organizationScopeClient) ListScopes() ([]Scope, error) {
+	retValue, err := s.client.ListOrganizations(organizations.NewListOrgsParams())
+	if err != nil {
+		return nil, err
+	}
+
+	scopes := make([]Scope, 0, len(retValue.Payload))
+	for _, organization := range retValue.Payload {
+		scopes = append(scopes, organizationScope(organization))
+	}
+	return scopes, nil
+}
+
+func (s *organizationScopeClient) CreateScope(param CreateScopeParams) (Scope, error) {
+	retValue, err := s.client.CreateOrganization(
+		organizations.NewCreateOrgParams().
+			WithBody(params.CreateOrgParams{
+				Name:             param.Name,
+				CredentialsName:  param.CredentialsName,
+				WebhookSecret:    param.WebhookSecret,
+				PoolBalancerType: param.PoolBalancerType,
+			}))
+	if err != nil {
+		return Scope{}, err
+	}
+	return organizationScope(retValue.Payload), nil
+}
+
+func (s *organizationScopeClient) UpdateScope(id string, param params.UpdateEntityParams) (Scope, error) {
+	retValue, err := s.client.UpdateOrganization(
+		organizations.NewUpdateOrgParams().
+			WithOrgID(id).
+			WithBody(param))
+	if err != nil {
+		return Scope{}, err
+	}
+	return organizationScope(retValue.Payload), nil
+}
+
+func (s *organizationScopeClient) DeleteScope(id string) error {
+	return s.client.DeleteOrganization(
+		organizations.NewDeleteOrgParams().
+			WithOrgID(id))
+}
+
+func (s *organizationScopeClient) InstallScopeWebhook(id string, param params.InstallWebhookParams) (params.HookInfo, error) {
+	retValue, err := s.client.InstallOrganizationWebhook(
+		organizations.NewInstallOrgWebhookParams().
+			WithOrgID(id).
+			WithBody(param))
+	if err != nil {
+		return params.HookInfo{}, err
+	}
+	return retValue.Payload, nil
+}
+
+func (s *organizationScopeClient) UninstallScopeWebhook(id string) error {
+	return s.client.UninstallOrganizationWebhook(
+		organizations.NewUninstallOrgWebhookParams().
+			WithOrgID(id))
+}
+
+func (s *organizationScopeClient) GetScopeWebhookInfo(id string) (params.HookInfo, error) {
+	retValue, err := s.client.GetOrganizationWebhookInfo(
+		organizations.NewGetOrgWebhookInfoParams().
+			WithOrgID(id))
+	if err != nil {
+		return params.HookInfo{}, err
+	}
+	return retValue.Payload, nil
+}
+
+type repositoryScopeClient struct {
+	client RepositoryClient
+}
+
+// NewRepositoryScopeClient returns a ScopeWebhookClient which manages repositories through client.
+func NewRepositoryScopeClient(client RepositoryClient) ScopeWebhookClient {
+	return &repositoryScopeClient{
+		client: client,
+	}
+}
+
+func repositoryScope(repository params.Repository) Scope {
+	return Scope{
+		ID:                repository.ID,
+		Name:              repository.Name,
+		PoolManagerStatus: repository.PoolManagerStatus,
+	}
+}
+
+func (s *repositoryScopeClient) ListScopes() ([]Scope, error) {
+	retValue, err := s.client.ListRepositories(repositories.NewListReposParams())
+	if err != nil {
+		return nil, err
+	}
+
+	scopes := make([]Scope, 0, len(retValue.Payload))
+	for _, repository := range retValue.Payload {
+		scopes = append(scopes, repositoryScope(repository))
+	}
+	return scopes, nil
+}
+
+func (s *repositoryScopeClient) CreateScope(param CreateScopeParams) (Scope, error) {
+	retValue, err := s.client.CreateRepository(
+		repositories.NewCreateRepoParams().
+			WithBody(params.CreateRepoParams{
+				Owner:            param.Owner,
+				Name:             param.Name,
+				CredentialsName:  param.CredentialsName,
+				WebhookSecret:    param.WebhookSecret,
+				PoolBalancerType: param.PoolBalancerType,
+			}))
+	if err != nil {
+		return Scope{}, err
+	}
+	return repositoryScope(retValue.Payload), nil
+}
+
+func (s *repositoryScopeClient) UpdateScope(id string, param params.UpdateEntityParams) (Scope, error) {
+	retValue, err := s.client.UpdateRepository(
+		repositories.NewUpdateRepoParams().
+			WithRepoID(id).
+			WithBody(param))
+	if err != nil {
+		return Scope{}, err
+	}
+	return repositoryScope(retValue.Payload), nil
+}
+
+func (s *repositoryScopeClient) DeleteScope(id string) error {
+	return s.client.DeleteRepository(
+		repositories.NewDeleteRepoParams().
+			WithRepoID(id))
+}
+
+func (s *repositoryScopeClient) InstallScopeWebhook(id string, param params.InstallWebhookParams) (params.HookInfo, error) {
+	retValue, err := s.client.InstallRepositoryWebhook(
+		repositories.NewInstallRepoWebhookParams().
+			WithRepoID(id).
+			WithBody(param))
+	if err != nil {
+		return params.HookInfo{}, err
+	}
+	return retValue.Payload, nil
+}
+
+func (s *repositoryScopeClient) UninstallScopeWebhook(id string) error {
+	return s.client.UninstallRepositoryWebhook(
+		repositories.NewUninstallRepoWebhookParams().
+			WithRepoID(id))
+}
+
+func (s *repositoryScopeClient) GetScopeWebhookInfo(id string) (params.HookInfo, error) {
+	retValue, err := s.client.GetRepositoryWebhookInfo(
+		repositories.NewGetRepoWebhookInfoParams().
+			WithRepoID(id))
+	if err != nil {
+		return params.HookInfo{}, err
+	}
+	return retValue.Payload, nil
+}
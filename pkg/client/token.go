@@ -0,0 +1,229 @@
+// SPDX-License-Identifier: MIT
+
+package client
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"reflect"
+	"sync"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/mercedes-benz/garm-operator/pkg/metrics"
+)
+
+// tokenRefreshWindow is the time before the expiry of a JWT in which it gets refreshed.
+// For short-lived tokens the window is limited to half of their lifetime.
+const tokenRefreshWindow = 5 * time.Minute
+
+// tokenRefreshRetryInterval is the time after a failed refresh in which a token which is still valid isn't refreshed again
+const tokenRefreshRetryInterval = 10 * time.Second
+
+// reasons why a new JWT is requested, used to label the metrics
+const (
+	tokenRefreshReasonInitial      = "initial"
+	tokenRefreshReasonLogin        = "login"
+	tokenRefreshReasonExpiring     = "expiring"
+	tokenRefreshReasonUnauthorized = "unauthorized"
+)
+
+var (
+	tokenManagersMux sync.Mutex
+	tokenManagers    = map[string]*tokenManager{}
+)
+
+// tokenManager obtains the JWT for a GARM server and refreshes it before it expires.
+// It is shared by all clients with the same parameters, so concurrent re-logins are deduplicated.
+type tokenManager struct {
+	mux sync.Mutex
+
+	garmParams GarmScopeParams
+	// initialized is set once the first-run of the GARM server is known to be done
+	initialized bool
+	token       string
+	issuedAt    time.Time
+	expiresAt   time.Time
+	// refreshing is the login which is currently in progress, if any
+	refreshing *tokenRefresh
+	// lastFailure is the time the last login failed
+	lastFailure time.Time
+}
+
+// tokenRefresh is a login in progress, done is closed once err is set
+type tokenRefresh struct {
+	done chan struct{}
+	err  error
+}
+
+// sharedTokenManager returns the token manager for garmParams. Managers are keyed by a hash of all parameters,
+// so clients which only share the GARM server and user, but e.g. use another password, don't drop each other's token.
+func sharedTokenManager(garmParams GarmScopeParams) *tokenManager {
+	tokenManagersMux.Lock()
+	defer tokenManagersMux.Unlock()
+
+	key := fmt.Sprintf("%x", sha256.Sum256([]byte(fmt.Sprintf("%#v", garmParams))))
+	m, ok := tokenManagers[key]
+	if !ok {
+		m = &tokenManager{}
+		tokenManagers[key] = m
+	}
+	m.setParams(garmParams)
+	return m
+}
+
+// setParams updates the parameters to login, a token obtained with other parameters is dropped
+func (m *tokenManager) setParams(garmParams GarmScopeParams) {
+	m.mux.Lock()
+	defer m.mux.Unlock()
+
+	if reflect.DeepEqual(m.garmParams, garmParams) {
+		return
+	}
+	m.garmParams = garmParams
+	m.initialized = false
+	m.token = ""
+	m.issuedAt = time.Time{}
+	m.expiresAt = time.Time{}
+}
+
+// EnsureToken obtains a token if there is none yet
+func (m *tokenManager) EnsureToken(name string) error {
+	m.mux.Lock()
+	hasToken := m.token != ""
+	m.mux.Unlock()
+
+	if hasToken {
+		return nil
+	}
+	return m.refresh(name, tokenRefreshReasonInitial)
+}
+
+// Token returns the current token. A token which is about to expire gets refreshed in the background
+// and is still returned until the new one is there, only an expired token waits for the refresh.
+func (m *tokenManager) Token(name string) string {
+	m.mux.Lock()
+	token := m.token
+	expiring := token != "" && m.expiring()
+	expired := expiring && !time.Now().Before(m.expiresAt)
+	// a refresh in the background is only started if there is none in progress,
+	// and not right after a failed one, as the current token is still valid for a while
+	retry := m.refreshing == nil && time.Since(m.lastFailure) >= tokenRefreshRetryInterval
+	m.mux.Unlock()
+
+	switch {
+	case expired:
+		if err := m.refresh(name, tokenRefreshReasonExpiring); err != nil {
+			// the request fails with 401 and is retried with a new token
+			log.FromContext(context.TODO()).Error(err, "failed to refresh jwt", "server", name)
+		}
+		m.mux.Lock()
+		defer m.mux.Unlock()
+		return m.token
+	case expiring && retry:
+		go func() {
+			if err := m.refresh(name, tokenRefreshReasonExpiring); err != nil {
+				log.FromContext(context.TODO()).Error(err, "failed to refresh jwt", "server", name)
+			}
+		}()
+	}
+	return token
+}
+
+// Login obtains a new token
+func (m *tokenManager) Login(name string) error {
+	return m.refresh(name, tokenRefreshReasonLogin)
+}
+
+// Refresh obtains a new token after staleToken has been rejected by GARM.
+// If the token has already been refreshed by another caller in the meantime, no new login happens.
+func (m *tokenManager) Refresh(name, staleToken string) error {
+	m.mux.Lock()
+	refreshed := m.token != "" && m.token != staleToken
+	m.mux.Unlock()
+
+	if refreshed {
+		return nil
+	}
+	return m.refresh(name, tokenRefreshReasonUnauthorized)
+}
+
+// SetInitialized marks the first-run of the GARM server as done
+func (m *tokenManager) SetInitialized() {
+	m.mux.Lock()
+	defer m.mux.Unlock()
+
+	m.initialized = true
+}
+
+func (m *tokenManager) expiring() bool {
+	if m.expiresAt.IsZero() {
+		return false
+	}
+	window := tokenRefreshWindow
+	if lifetime := m.expiresAt.Sub(m.issuedAt); !m.issuedAt.IsZero() && lifetime/2 < window {
+		window = lifetime / 2
+	}
+	return time.Until(m.expiresAt) < window
+}
+
+// refresh obtains a new token. Only one login is in progress at a time,
+// concurrent callers wait for it instead of logging in again. The lock must not be held by the caller.
+func (m *tokenManager) refresh(name, reason string) error {
+	m.mux.Lock()
+	if r := m.refreshing; r != nil {
+		m.mux.Unlock()
+		<-r.done
+		return r.err
+	}
+	r := &tokenRefresh{done: make(chan struct{})}
+	m.refreshing = r
+	garmParams := m.garmParams
+	initialized := m.initialized
+	m.mux.Unlock()
+
+	// the login happens without the lock, so callers which only need the current token aren't blocked by it
+	token, initialized, err := login(name, reason, garmParams, initialized)
+
+	m.mux.Lock()
+	defer m.mux.Unlock()
+	// a token obtained with outdated parameters is dropped
+	if reflect.DeepEqual(m.garmParams, garmParams) {
+		m.initialized = initialized
+		if err != nil {
+			m.lastFailure = time.Now()
+		} else {
+			m.token = token
+			m.issuedAt, m.expiresAt = extractJWTTokenExp(context.TODO(), name, token)
+			m.lastFailure = time.Time{}
+		}
+	}
+	m.refreshing = nil
+	r.err = err
+	close(r.done)
+	return err
+}
+
+// login obtains a new token and returns whether the first-run of the GARM server is known to be done
+func login(name, reason string, garmParams GarmScopeParams, initialized bool) (string, bool, error) {
+	metrics.GarmTokenRefreshes.WithLabelValues(name, reason).Inc()
+
+	if !initialized {
+		metrics.TotalGarmCalls.WithLabelValues(name, "Init").Inc()
+		if err := initializeGarm(context.TODO(), name, garmParams); err != nil {
+			metrics.GarmCallErrors.WithLabelValues(name, "Init").Inc()
+			metrics.GarmTokenRefreshErrors.WithLabelValues(name, reason).Inc()
+			return "", false, fmt.Errorf("failed to initialize GARM: %w", err)
+		}
+	}
+
+	token, err := loginGarm(name, garmParams)
+	if err != nil {
+		metrics.GarmTokenRefreshErrors.WithLabelValues(name, reason).Inc()
+		// the GARM server might have been reset, so the first-run is checked again on the next login
+		return "", false, err
+	}
+	return token, true, nil
+}
@@ -0,0 +1,226 @@
+// SPDX-License-Identifier: MIT
+
+package client
+
+import (
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cloudbase/garm/client/organizations"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mercedes-benz/garm-operator/pkg/garmfake"
+)
+
+func newTestClient(t *testing.T, server *garmfake.Server) *garmClient {
+	t.Helper()
+
+	client, err := newAuthenticatedClient(t.Name(), GarmScopeParams{
+		BaseURL:  server.URL,
+		Username: garmfake.DefaultUsername,
+		Password: garmfake.DefaultPassword,
+	})
+	require.NoError(t, err)
+	return client
+}
+
+func TestTokenManager_Refresh(t *testing.T) {
+	server := garmfake.NewServer(garmfake.WithInitialized())
+	defer server.Close()
+
+	client := newTestClient(t, server)
+	organizationClient := NewOrganizationClient(client)
+	assert.Equal(t, 1, server.CountRequests(http.MethodPost, "/first-run"))
+	assert.Equal(t, 1, server.CountRequests(http.MethodPost, "/auth/login"))
+
+	server.ExpireTokens()
+
+	// all concurrent calls fail with 401, but only one of them logs in again
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := organizationClient.ListOrganizations(organizations.NewListOrgsParams())
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, 2, server.CountRequests(http.MethodPost, "/auth/login"))
+	// garm is known to be initialized, so the first-run isn't called again
+	assert.Equal(t, 1, server.CountRequests(http.MethodPost, "/first-run"))
+}
+
+func TestTokenManager_RefreshExpiring(t *testing.T) {
+	server := garmfake.NewServer(garmfake.WithInitialized())
+	defer server.Close()
+
+	client := newTestClient(t, server)
+	assert.Equal(t, 1, server.CountRequests(http.MethodPost, "/auth/login"))
+
+	// let the token expire within the refresh window
+	client.tokens.mux.Lock()
+	client.tokens.issuedAt = time.Now().Add(-time.Hour)
+	client.tokens.expiresAt = time.Now().Add(time.Minute)
+	client.tokens.mux.Unlock()
+
+	_, err := NewOrganizationClient(client).ListOrganizations(organizations.NewListOrgsParams())
+	assert.NoError(t, err)
+
+	// the token gets refreshed in the background and is valid for the whole lifetime again
+	assert.Eventually(t, func() bool {
+		client.tokens.mux.Lock()
+		defer client.tokens.mux.Unlock()
+		return client.tokens.expiresAt.After(time.Now().Add(time.Hour))
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, 2, server.CountRequests(http.MethodPost, "/auth/login"))
+}
+
+func TestTokenManager_RefreshExpiringDoesNotBlock(t *testing.T) {
+	server := garmfake.NewServer(garmfake.WithInitialized())
+	defer server.Close()
+
+	client := newTestClient(t, server)
+	token := client.BearerToken()
+
+	// let the token expire within the refresh window and slow down the login
+	client.tokens.mux.Lock()
+	client.tokens.issuedAt = time.Now().Add(-time.Hour)
+	client.tokens.expiresAt = time.Now().Add(time.Minute)
+	client.tokens.mux.Unlock()
+	server.InjectFault(garmfake.Fault{Method: http.MethodPost, Path: "/auth/login", Latency: time.Second, Times: 1})
+
+	// the still valid token is used while it gets refreshed
+	start := time.Now()
+	for range 10 {
+		assert.Equal(t, token, client.BearerToken())
+	}
+	assert.Less(t, time.Since(start), 500*time.Millisecond)
+
+	assert.Eventually(t, func() bool {
+		client.tokens.mux.Lock()
+		defer client.tokens.mux.Unlock()
+		return client.tokens.expiresAt.After(time.Now().Add(time.Hour))
+	}, 5*time.Second, 10*time.Millisecond)
+	// only a single login happened for all callers
+	assert.Equal(t, 2, server.CountRequests(http.MethodPost, "/auth/login"))
+}
+
+func TestTokenManager_RefreshExpired(t *testing.T) {
+	server := garmfake.NewServer(garmfake.WithInitialized())
+	defer server.Close()
+
+	client := newTestClient(t, server)
+
+	// an expired token can't be used anymore, so all callers wait for the same login
+	client.tokens.mux.Lock()
+	client.tokens.issuedAt = time.Now().Add(-time.Hour)
+	client.tokens.expiresAt = time.Now().Add(-time.Minute)
+	client.tokens.mux.Unlock()
+	server.InjectFault(garmfake.Fault{Method: http.MethodPost, Path: "/auth/login", Latency: 100 * time.Millisecond, Times: 1})
+
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.NotEmpty(t, client.BearerToken())
+		}()
+	}
+	wg.Wait()
+
+	// all callers got the refreshed token
+	client.tokens.mux.Lock()
+	assert.True(t, client.tokens.expiresAt.After(time.Now().Add(time.Hour)))
+	client.tokens.mux.Unlock()
+
+	assert.Equal(t, 2, server.CountRequests(http.MethodPost, "/auth/login"))
+}
+
+func TestTokenManager_Shared(t *testing.T) {
+	server := garmfake.NewServer(garmfake.WithInitialized())
+	defer server.Close()
+
+	client := newTestClient(t, server)
+	otherClient := newTestClient(t, server)
+
+	// clients of the same garm server and user share the token
+	assert.Equal(t, client.tokens, otherClient.tokens)
+	assert.Equal(t, client.BearerToken(), otherClient.BearerToken())
+	assert.Equal(t, 1, server.CountRequests(http.MethodPost, "/auth/login"))
+}
+
+func TestTokenManager_NotSharedWithOtherParams(t *testing.T) {
+	server := garmfake.NewServer(garmfake.WithInitialized())
+	defer server.Close()
+
+	client := newTestClient(t, server)
+	token := client.BearerToken()
+
+	otherClient, err := newAuthenticatedClient(t.Name(), GarmScopeParams{
+		BaseURL:  server.URL,
+		Username: garmfake.DefaultUsername,
+		Password: garmfake.DefaultPassword,
+		Email:    "other@example.com",
+	})
+	require.NoError(t, err)
+
+	// clients of the same garm server and user with other parameters don't drop each other's token
+	assert.NotSame(t, client.tokens, otherClient.tokens)
+	assert.Equal(t, token, client.BearerToken())
+	assert.NotEmpty(t, otherClient.BearerToken())
+	assert.Equal(t, 2, server.CountRequests(http.MethodPost, "/auth/login"))
+}
+
+func TestTokenManager_Expiring(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		name      string
+		issuedAt  time.Time
+		expiresAt time.Time
+		want      bool
+	}{
+		{
+			name: "token without expiry",
+			want: false,
+		},
+		{
+			name:      "token expires later",
+			issuedAt:  now.Add(-time.Hour),
+			expiresAt: now.Add(time.Hour),
+			want:      false,
+		},
+		{
+			name:      "token expires within refresh window",
+			issuedAt:  now.Add(-time.Hour),
+			expiresAt: now.Add(4 * time.Minute),
+			want:      true,
+		},
+		{
+			name:      "short-lived token within first half of lifetime",
+			issuedAt:  now.Add(-time.Minute),
+			expiresAt: now.Add(2 * time.Minute),
+			want:      false,
+		},
+		{
+			name:      "short-lived token within second half of lifetime",
+			issuedAt:  now.Add(-2 * time.Minute),
+			expiresAt: now.Add(time.Minute),
+			want:      true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := &tokenManager{
+				issuedAt:  tt.issuedAt,
+				expiresAt: tt.expiresAt,
+			}
+			assert.Equal(t, tt.want, m.expiring())
+		})
+	}
+}
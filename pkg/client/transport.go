@@ -0,0 +1,380 @@
+// SPDX-License-Identifier: MIT
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"math/rand/v2"
+	"net/http"
+	"reflect"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	"github.com/mercedes-benz/garm-operator/pkg/metrics"
+)
+
+// maxRetryBackoff limits the exponential backoff between two retries of a request
+const maxRetryBackoff = 30 * time.Second
+
+// ErrGarmUnavailable is returned for all requests to a GARM server while its circuit breaker is open
+var ErrGarmUnavailable = errors.New("garm server is unavailable")
+
+// UnavailableError is returned instead of sending a request to a GARM server while its circuit breaker is open
+type UnavailableError struct {
+	// RetryAfter is the time until the circuit breaker lets requests pass again
+	RetryAfter time.Duration
+}
+
+func (e *UnavailableError) Error() string {
+	return fmt.Sprintf("%s: circuit breaker is open, retry in %s", ErrGarmUnavailable, e.RetryAfter.Round(time.Second))
+}
+
+func (e *UnavailableError) Unwrap() error {
+	return ErrGarmUnavailable
+}
+
+// IsGarmUnavailableError returns true if the request hasn't been sent because the circuit breaker of the GARM server is open
+func IsGarmUnavailableError(err error) bool {
+	return errors.Is(err, ErrGarmUnavailable)
+}
+
+// TransportConfig configures how the requests to a GARM server are rate limited, retried and circuit broken
+type TransportConfig struct {
+	// RateLimit is the number of requests per second which are sent to GARM, zero disables the rate limit
+	RateLimit float64
+	// RateLimitBurst is the number of requests which may exceed the rate limit at once
+	RateLimitBurst int
+	// MaxRetries is the number of times an idempotent request is retried on connection errors and 5xx responses
+	MaxRetries int
+	// RetryBackoff is the initial backoff between two retries, it doubles with every retry and gets jittered
+	RetryBackoff time.Duration
+	// CircuitBreakerThreshold is the number of consecutive failed requests after which the circuit breaker opens,
+	// zero disables the circuit breaker
+	CircuitBreakerThreshold int
+	// CircuitBreakerTimeout is the time the circuit breaker stays open before a single request probes GARM again
+	CircuitBreakerTimeout time.Duration
+}
+
+var (
+	transportsMux sync.Mutex
+	transports    = map[string]*transport{}
+)
+
+// sharedTransport returns the transport for the GARM server with the given name, so the rate limit and the
+// circuit breaker apply to all requests of the server. The transport is recreated if the parameters change.
+func sharedTransport(name string, garmParams GarmScopeParams) (*transport, error) {
+	transportsMux.Lock()
+	defer transportsMux.Unlock()
+
+	if t, ok := transports[name]; ok && t.baseURL == garmParams.BaseURL &&
+		reflect.DeepEqual(t.caCertBundle, garmParams.CACertBundle) && t.config == garmParams.Transport {
+		return t, nil
+	}
+
+	t, err := newTransport(name, garmParams)
+	if err != nil {
+		return nil, err
+	}
+	transports[name] = t
+	return t, nil
+}
+
+// removeTransport removes the transport of the GARM server with the given name
+func removeTransport(name string) {
+	transportsMux.Lock()
+	defer transportsMux.Unlock()
+
+	delete(transports, name)
+	metrics.GarmCircuitBreakerOpen.DeleteLabelValues(name)
+}
+
+// transport rate limits the requests to a GARM server, retries idempotent requests with a jittered
+// exponential backoff and stops sending requests for a while after too many consecutive failures.
+type transport struct {
+	name         string
+	baseURL      string
+	caCertBundle []byte
+	config       TransportConfig
+
+	next    http.RoundTripper
+	limiter *rate.Limiter
+	breaker *circuitBreaker
+}
+
+func newTransport(name string, garmParams GarmScopeParams) (*transport, error) {
+	next := http.DefaultTransport
+	if len(garmParams.CACertBundle) > 0 {
+		tlsConfig, err := newTLSConfig(garmParams.CACertBundle)
+		if err != nil {
+			return nil, err
+		}
+		httpTransport := http.DefaultTransport.(*http.Transport).Clone()
+		httpTransport.TLSClientConfig = tlsConfig
+		next = httpTransport
+	}
+
+	t := &transport{
+		name:         name,
+		baseURL:      garmParams.BaseURL,
+		caCertBundle: garmParams.CACertBundle,
+		config:       garmParams.Transport,
+		next:         next,
+	}
+
+	if t.config.RateLimit > 0 {
+		burst := t.config.RateLimitBurst
+		if burst <= 0 {
+			burst = int(math.Ceil(t.config.RateLimit))
+		}
+		t.limiter = rate.NewLimiter(rate.Limit(t.config.RateLimit), burst)
+	}
+
+	if t.config.CircuitBreakerThreshold > 0 {
+		t.breaker = &circuitBreaker{
+			threshold: t.config.CircuitBreakerThreshold,
+			timeout:   t.config.CircuitBreakerTimeout,
+			onChange: func(open bool) {
+				value := 0.0
+				if open {
+					value = 1
+				}
+				metrics.GarmCircuitBreakerOpen.WithLabelValues(name).Set(value)
+			},
+		}
+		metrics.GarmCircuitBreakerOpen.WithLabelValues(name).Set(0)
+	}
+
+	return t, nil
+}
+
+// CircuitOpen returns true and the time until requests are sent again, if the circuit breaker is open
+func (t *transport) CircuitOpen() (time.Duration, bool) {
+	if t.breaker == nil {
+		return 0, false
+	}
+	return t.breaker.isOpen()
+}
+
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	maxRetries := 0
+	if retryable(req) {
+		maxRetries = t.config.MaxRetries
+	}
+	backoff := t.config.RetryBackoff
+
+	if maxRetries > 0 && req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		// buffer the body, so it can be sent again
+		body, err := io.ReadAll(req.Body)
+		_ = req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		req = req.Clone(req.Context())
+		req.Body = io.NopCloser(bytes.NewReader(body))
+		req.GetBody = func() (io.ReadCloser, error) {
+			return io.NopCloser(bytes.NewReader(body)), nil
+		}
+	}
+
+	for attempt := 0; ; attempt++ {
+		resp, err := t.roundTrip(req, attempt)
+		if attempt >= maxRetries || !transientFailure(resp, err) || IsGarmUnavailableError(err) {
+			return resp, err
+		}
+
+		if resp != nil {
+			_, _ = io.Copy(io.Discard, resp.Body)
+			_ = resp.Body.Close()
+		}
+
+		metrics.GarmRequestRetries.WithLabelValues(t.name).Inc()
+		if err := sleep(req.Context(), jitter(backoff)); err != nil {
+			return nil, err
+		}
+		backoff = min(2*backoff, maxRetryBackoff)
+	}
+}
+
+func (t *transport) roundTrip(req *http.Request, attempt int) (*http.Response, error) {
+	if t.breaker != nil {
+		if retryAfter, ok := t.breaker.allow(); !ok {
+			return nil, &UnavailableError{RetryAfter: retryAfter}
+		}
+	}
+
+	if t.limiter != nil {
+		if err := t.limiter.Wait(req.Context()); err != nil {
+			t.release()
+			return nil, err
+		}
+	}
+
+	if attempt > 0 {
+		retryReq := req.Clone(req.Context())
+		if req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				t.release()
+				return nil, err
+			}
+			retryReq.Body = body
+		}
+		req = retryReq
+	}
+
+	resp, err := t.next.RoundTrip(req)
+	if err != nil && req.Context().Err() != nil {
+		// the request has been canceled by the caller, which says nothing about the availability of GARM
+		t.release()
+		return resp, err
+	}
+	if t.breaker != nil {
+		t.breaker.record(!transientFailure(resp, err))
+	}
+	return resp, err
+}
+
+// release gives up a request which hasn't reached GARM without affecting the circuit breaker
+func (t *transport) release() {
+	if t.breaker != nil {
+		t.breaker.release()
+	}
+}
+
+// retryable returns true for idempotent requests
+func retryable(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+// transientFailure returns true if the request failed because GARM is not reachable or not healthy
+func transientFailure(resp *http.Response, err error) bool {
+	if err != nil {
+		return true
+	}
+	return resp.StatusCode >= http.StatusInternalServerError || resp.StatusCode == http.StatusTooManyRequests
+}
+
+// jitter returns a random duration between half of and the full backoff
+func jitter(backoff time.Duration) time.Duration {
+	if backoff <= 0 {
+		return 0
+	}
+	return backoff/2 + rand.N(backoff/2+1) //nolint:gosec // no need for a secure random number
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+type circuitState int
+
+const (
+	circuitClosed circuitState = iota
+	circuitOpen
+	circuitHalfOpen
+)
+
+// circuitBreaker opens after threshold consecutive failures and rejects all requests until timeout has passed.
+// Afterwards a single request probes GARM, which closes the circuit breaker again on success.
+type circuitBreaker struct {
+	mux sync.Mutex
+
+	threshold int
+	timeout   time.Duration
+	onChange  func(open bool)
+
+	state    circuitState
+	failures int
+	openedAt time.Time
+}
+
+// allow returns true if a request may be sent, otherwise the time until requests are allowed again
+func (b *circuitBreaker) allow() (time.Duration, bool) {
+	b.mux.Lock()
+	defer b.mux.Unlock()
+
+	switch b.state {
+	case circuitOpen:
+		if retryAfter := time.Until(b.openedAt.Add(b.timeout)); retryAfter > 0 {
+			return retryAfter, false
+		}
+		// let a single request probe whether GARM is available again
+		b.state = circuitHalfOpen
+		return 0, true
+	case circuitHalfOpen:
+		return b.timeout, false
+	default:
+		return 0, true
+	}
+}
+
+// isOpen returns true and the time until requests are allowed again, if the circuit breaker rejects requests
+func (b *circuitBreaker) isOpen() (time.Duration, bool) {
+	b.mux.Lock()
+	defer b.mux.Unlock()
+
+	switch b.state {
+	case circuitOpen:
+		if retryAfter := time.Until(b.openedAt.Add(b.timeout)); retryAfter > 0 {
+			return retryAfter, true
+		}
+		return 0, false
+	case circuitHalfOpen:
+		return b.timeout, true
+	default:
+		return 0, false
+	}
+}
+
+// release lets the next request probe GARM, if the probing request of the half-open circuit breaker has been given up
+func (b *circuitBreaker) release() {
+	b.mux.Lock()
+	defer b.mux.Unlock()
+
+	if b.state == circuitHalfOpen {
+		b.state = circuitOpen
+	}
+}
+
+func (b *circuitBreaker) record(success bool) {
+	b.mux.Lock()
+	defer b.mux.Unlock()
+
+	if success {
+		if b.state != circuitClosed {
+			b.onChange(false)
+		}
+		b.state = circuitClosed
+		b.failures = 0
+		return
+	}
+
+	b.failures++
+	if b.state == circuitHalfOpen || b.failures >= b.threshold {
+		if b.state == circuitClosed {
+			b.onChange(true)
+		}
+		b.state = circuitOpen
+		b.openedAt = time.Now()
+	}
+}
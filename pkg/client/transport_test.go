@@ -0,0 +1,158 @@
+// SPDX-License-Identifier: MIT
+
+package client
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/cloudbase/garm/client/organizations"
+	"github.com/cloudbase/garm/params"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mercedes-benz/garm-operator/pkg/garmfake"
+)
+
+func newTestTransportClient(t *testing.T, server *garmfake.Server, config TransportConfig) *garmClient {
+	t.Helper()
+
+	client, err := newAuthenticatedClient(t.Name(), GarmScopeParams{
+		BaseURL:   server.URL,
+		Username:  garmfake.DefaultUsername,
+		Password:  garmfake.DefaultPassword,
+		Transport: config,
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		removeTransport(t.Name())
+	})
+	return client
+}
+
+func TestTransport_Retry(t *testing.T) {
+	server := garmfake.NewServer(garmfake.WithInitialized())
+	defer server.Close()
+
+	client := newTestTransportClient(t, server, TransportConfig{
+		MaxRetries:   2,
+		RetryBackoff: time.Millisecond,
+	})
+	organizationClient := NewOrganizationClient(client)
+
+	// idempotent requests are retried
+	server.InjectFault(garmfake.Fault{
+		Method:     http.MethodGet,
+		Path:       "/organizations",
+		StatusCode: http.StatusServiceUnavailable,
+		Times:      2,
+	})
+	_, err := organizationClient.ListOrganizations(organizations.NewListOrgsParams())
+	assert.NoError(t, err)
+	assert.Equal(t, 3, server.CountRequests(http.MethodGet, "/organizations"))
+
+	// the retries are limited
+	server.InjectFault(garmfake.Fault{
+		Method:     http.MethodGet,
+		Path:       "/organizations",
+		StatusCode: http.StatusServiceUnavailable,
+		Times:      3,
+	})
+	_, err = organizationClient.ListOrganizations(organizations.NewListOrgsParams())
+	assert.Error(t, err)
+	assert.Equal(t, 6, server.CountRequests(http.MethodGet, "/organizations"))
+
+	// non-idempotent requests are not retried
+	server.InjectFault(garmfake.Fault{
+		Method:     http.MethodPost,
+		Path:       "/organizations",
+		StatusCode: http.StatusServiceUnavailable,
+		Times:      1,
+	})
+	_, err = organizationClient.CreateOrganization(organizations.NewCreateOrgParams().WithBody(params.CreateOrgParams{
+		Name: "my-org",
+	}))
+	assert.Error(t, err)
+	assert.Equal(t, 1, server.CountRequests(http.MethodPost, "/organizations"))
+
+	// client errors are not retried
+	_, err = organizationClient.GetOrganization(organizations.NewGetOrgParams().WithOrgID("unknown"))
+	assert.True(t, IsNotFoundError(err))
+	assert.Equal(t, 1, server.CountRequests(http.MethodGet, "/organizations/unknown"))
+}
+
+func TestTransport_CircuitBreaker(t *testing.T) {
+	server := garmfake.NewServer(garmfake.WithInitialized())
+	defer server.Close()
+
+	client := newTestTransportClient(t, server, TransportConfig{
+		CircuitBreakerThreshold: 2,
+		CircuitBreakerTimeout:   100 * time.Millisecond,
+	})
+	organizationClient := NewOrganizationClient(client)
+
+	server.InjectFault(garmfake.Fault{
+		Path:       "/organizations",
+		StatusCode: http.StatusInternalServerError,
+	})
+
+	for range 2 {
+		_, err := organizationClient.ListOrganizations(organizations.NewListOrgsParams())
+		assert.Error(t, err)
+		assert.False(t, IsGarmUnavailableError(err))
+	}
+
+	// the circuit breaker is open, so no requests are sent
+	retryAfter, open := client.CircuitOpen()
+	assert.True(t, open)
+	assert.LessOrEqual(t, retryAfter, 100*time.Millisecond)
+
+	_, err := organizationClient.ListOrganizations(organizations.NewListOrgsParams())
+	assert.True(t, IsGarmUnavailableError(err))
+	assert.Equal(t, 2, server.CountRequests(http.MethodGet, "/organizations"))
+
+	// a failed probe opens the circuit breaker again
+	time.Sleep(100 * time.Millisecond)
+	_, err = organizationClient.ListOrganizations(organizations.NewListOrgsParams())
+	assert.False(t, IsGarmUnavailableError(err))
+	assert.Equal(t, 3, server.CountRequests(http.MethodGet, "/organizations"))
+	_, open = client.CircuitOpen()
+	assert.True(t, open)
+
+	// a successful probe closes the circuit breaker
+	server.ClearFaults()
+	time.Sleep(100 * time.Millisecond)
+	_, err = organizationClient.ListOrganizations(organizations.NewListOrgsParams())
+	assert.NoError(t, err)
+	_, open = client.CircuitOpen()
+	assert.False(t, open)
+}
+
+func TestTransport_RateLimit(t *testing.T) {
+	server := garmfake.NewServer(garmfake.WithInitialized())
+	defer server.Close()
+
+	client := newTestTransportClient(t, server, TransportConfig{
+		RateLimit:      20,
+		RateLimitBurst: 1,
+	})
+	organizationClient := NewOrganizationClient(client)
+
+	// the login already used up the burst, so every request waits for 50ms
+	start := time.Now()
+	for range 4 {
+		_, err := organizationClient.ListOrganizations(organizations.NewListOrgsParams())
+		assert.NoError(t, err)
+	}
+	assert.GreaterOrEqual(t, time.Since(start), 150*time.Millisecond)
+}
+
+func TestJitter(t *testing.T) {
+	for range 100 {
+		d := jitter(100 * time.Millisecond)
+		assert.GreaterOrEqual(t, d, 50*time.Millisecond)
+		assert.LessOrEqual(t, d, 100*time.Millisecond)
+	}
+	assert.Zero(t, jitter(0))
+}
@@ -16,6 +16,9 @@ const (
 	DeletionFailedReason      ConditionReason = "DeletionFailed"
 	GarmAPIErrorReason        ConditionReason = "GarmAPIError"
 	UnknownReason             ConditionReason = "UnknownReason"
+
+	GarmUnavailable          ConditionType   = "GarmUnavailable"
+	CircuitBreakerOpenReason ConditionReason = "CircuitBreakerOpen"
 )
 
 // Pool Conditions & Reasons
@@ -29,6 +32,29 @@ const (
 	FetchingScopeRefFailedReason  ConditionReason = "FetchingScopeRefFailed"
 	ScopeRefNotReadyReason        ConditionReason = "ScopeRefNotReady"
 	DuplicatePoolReason           ConditionReason = "DuplicatePoolFound"
+
+	Draining                   ConditionType   = "Draining"
+	DrainingRunnersReason      ConditionReason = "DrainingRunners"
+	DrainTimeoutExceededReason ConditionReason = "DrainTimeoutExceeded"
+	DrainCompletedReason       ConditionReason = "DrainCompleted"
+
+	RunnersDegraded                  ConditionType   = "RunnersDegraded"
+	StuckRunnersReason               ConditionReason = "StuckRunners"
+	ProviderFaultReason              ConditionReason = "ProviderFault"
+	RemediationBudgetExhaustedReason ConditionReason = "RemediationBudgetExhausted"
+	RunnersHealthyReason             ConditionReason = "RunnersHealthy"
+)
+
+// Runner Conditions & Reasons
+const (
+	RunnerAction               ConditionType   = "RunnerAction"
+	ForceDeleteRequestedReason ConditionReason = "ForceDeleteRequested"
+	RecycleRequestedReason     ConditionReason = "RecycleRequested"
+	RecycleScheduledReason     ConditionReason = "RecycleScheduled"
+	ActionFailedReason         ConditionReason = "ActionFailed"
+
+	ReapProtection  ConditionType   = "ReapProtection"
+	DoNotReapReason ConditionReason = "DoNotReap"
 )
 
 // Enterprise, Org & Repo Conditions
@@ -44,6 +70,14 @@ const (
 	GithubCredentialsReference                ConditionType   = "GithubCredentialsReference"  // #nosec G101
 	FetchingGithubCredentialsRefSuccessReason ConditionReason = "GithubCredentialsRefSuccess" // #nosec G101
 	FetchingGithubCredentialsRefFailedReason  ConditionReason = "GithubCredentialsRefFailed"  // #nosec G101
+
+	Webhook                    ConditionType   = "Webhook"
+	WebhookInstalledReason     ConditionReason = "WebhookInstalled"
+	WebhookInstallFailedReason ConditionReason = "WebhookInstallFailed"
+
+	BlockedByPools            ConditionType   = "BlockedByPools"
+	PoolsReferenceScopeReason ConditionReason = "PoolsReferenceScope"
+	GarmPoolsExistReason      ConditionReason = "GarmPoolsExist"
 )
 
 // Credential Conditions
@@ -53,6 +87,27 @@ const (
 	FetchingGithubEndpointRefFailedReason  ConditionReason = "FetchingGithubEndpointRefFailed"
 )
 
+// PoolSchedule Conditions & Reasons
+const (
+	PoolReference                ConditionType   = "PoolReference"
+	FetchingPoolRefSuccessReason ConditionReason = "FetchingPoolRefSuccess"
+	FetchingPoolRefFailedReason  ConditionReason = "FetchingPoolRefFailed"
+
+	InvalidScheduleReason ConditionReason = "InvalidSchedule"
+)
+
+// GarmServer Conditions & Reasons
+const (
+	SecretReference                ConditionType   = "SecretReference"
+	FetchingSecretRefSuccessReason ConditionReason = "FetchingSecretRefSuccess"
+	FetchingSecretRefFailedReason  ConditionReason = "FetchingSecretRefFailed"
+
+	LoginFailedReason         ConditionReason = "LoginFailed"
+	IncompatibleVersionReason ConditionReason = "IncompatibleVersion"
+
+	GarmServerRefNotReadyReason ConditionReason = "GarmServerRefNotReady"
+)
+
 const (
 	GarmServerNotReconciledYetMsg     string = "GARM server not reconciled yet"
 	CredentialsNotReconciledYetMsg    string = "GithubCredentialsRef not reconciled yet" // #nosec G101
@@ -64,4 +119,7 @@ const (
 	DeletingPoolMsg                   string = "Deleting pool"
 	DeletingEndpointMsg               string = "Deleting endpoint"
 	DeletingCredentialsMsg            string = "Deleting credentials" // #nosec G101
+	PoolScheduleNotReconciledYetMsg   string = "PoolSchedule not reconciled yet"
+	PoolRefNotReconciledYetMsg        string = "PoolRefs not reconciled yet"
+	SecretRefNotReconciledYetMsg      string = "SecretRefs not reconciled yet" // #nosec G101
 )
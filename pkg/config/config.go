@@ -18,11 +18,17 @@ import (
 )
 
 type GarmConfig struct {
-	Server   string `koanf:"server" validate:"required,url" yaml:"server"`
-	Username string `koanf:"username" validate:"required" yaml:"username"`
-	Password string `koanf:"password" validate:"required" yaml:"password"`
-	Init     bool   `koanf:"init" yaml:"init"`
-	Email    string `koanf:"email" validate:"required_if=Init true" yaml:"email"`
+	Server                  string        `koanf:"server" validate:"required,url" yaml:"server"`
+	Username                string        `koanf:"username" validate:"required" yaml:"username"`
+	Password                string        `koanf:"password" validate:"required" yaml:"password"`
+	Init                    bool          `koanf:"init" yaml:"init"`
+	Email                   string        `koanf:"email" validate:"required_if=Init true" yaml:"email"`
+	RateLimit               float64       `koanf:"rateLimit" validate:"gte=0" yaml:"rateLimit"`
+	RateLimitBurst          int           `koanf:"rateLimitBurst" validate:"gte=0" yaml:"rateLimitBurst"`
+	MaxRetries              int           `koanf:"maxRetries" validate:"gte=0" yaml:"maxRetries"`
+	RetryBackoff            time.Duration `koanf:"retryBackoff" validate:"gte=0" yaml:"retryBackoff"`
+	CircuitBreakerThreshold int           `koanf:"circuitBreakerThreshold" validate:"gte=0" yaml:"circuitBreakerThreshold"`
+	CircuitBreakerTimeout   time.Duration `koanf:"circuitBreakerTimeout" validate:"required_unless=CircuitBreakerThreshold 0" yaml:"circuitBreakerTimeout"`
 }
 
 type OperatorConfig struct {
@@ -33,12 +39,16 @@ type OperatorConfig struct {
 	WatchNamespace          string        `koanf:"watchNamespace" yaml:"watchNamespace"`
 	SyncRunnersInterval     time.Duration `koanf:"syncRunnersInterval" validate:"gte=5s,lte=5m" yaml:"syncRunnersInterval"`
 	MinIdleRunnersAge       time.Duration `koanf:"minIdleRunnersAge" yaml:"minIdleRunnersAge"`
+	PoolDrainTimeout        time.Duration `koanf:"poolDrainTimeout" yaml:"poolDrainTimeout"`
+	ScaleDownStepSize       int           `koanf:"scaleDownStepSize" validate:"gte=0" yaml:"scaleDownStepSize"`
+	ScaleDownCooldown       time.Duration `koanf:"scaleDownCooldown" validate:"gte=0" yaml:"scaleDownCooldown"`
 	RunnerConcurrency       int           `koanf:"runnerConcurrency" validate:"gte=1" yaml:"runnerConcurrency"`
 	RepositoryConcurrency   int           `koanf:"repositoryConcurrency" validate:"gte=1" yaml:"repositoryConcurrency"`
 	OrganizationConcurrency int           `koanf:"organizationConcurrency" validate:"gte=1" yaml:"organizationConcurrency"`
 	EnterpriseConcurrency   int           `koanf:"enterpriseConcurrency" validate:"gte=1" yaml:"enterpriseConcurrency"`
 	PoolConcurrency         int           `koanf:"poolConcurrency" validate:"gte=1" yaml:"poolConcurrency"`
 	RunnerReconciliation    bool          `koanf:"runnerReconciliation" yaml:"runnerReconciliation"`
+	RunnerEventStream       bool          `koanf:"runnerEventStream" yaml:"runnerEventStream"`
 	LogVerbosityLevel       int           `koanf:"logVerbosityLevel" validate:"gte=0,lte=5" yaml:"logVerbosityLevel"`
 }
 
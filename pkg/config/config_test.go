@@ -30,6 +30,7 @@ func TestGenerateConfig(t *testing.T) {
 				"GARM_SERVER":                    "http://localhost:9997",
 				"GARM_USERNAME":                  "admin",
 				"GARM_PASSWORD":                  "password",
+				"GARM_RATE_LIMIT":                "5.5",
 				"OPERATOR_SYNC_RUNNERS_INTERVAL": "20s",
 			},
 			wantCfg: AppConfig{
@@ -41,20 +42,30 @@ func TestGenerateConfig(t *testing.T) {
 					WatchNamespace:          "",
 					SyncRunnersInterval:     20 * time.Second,
 					MinIdleRunnersAge:       2 * time.Hour,
+					PoolDrainTimeout:        1 * time.Hour,
+					ScaleDownStepSize:       0,
+					ScaleDownCooldown:       0,
 					RunnerConcurrency:       50,
 					RepositoryConcurrency:   10,
 					OrganizationConcurrency: 5,
 					EnterpriseConcurrency:   1,
 					PoolConcurrency:         10,
 					RunnerReconciliation:    false,
+					RunnerEventStream:       true,
 					LogVerbosityLevel:       0,
 				},
 				Garm: GarmConfig{
-					Server:   "http://localhost:9997",
-					Username: "admin",
-					Password: "password",
-					Init:     true,
-					Email:    "garm-operator@localhost",
+					Server:                  "http://localhost:9997",
+					Username:                "admin",
+					Password:                "password",
+					Init:                    true,
+					Email:                   "garm-operator@localhost",
+					RateLimit:               5.5,
+					RateLimitBurst:          40,
+					MaxRetries:              3,
+					RetryBackoff:            500 * time.Millisecond,
+					CircuitBreakerThreshold: 5,
+					CircuitBreakerTimeout:   30 * time.Second,
 				},
 			},
 		},
@@ -74,20 +85,30 @@ func TestGenerateConfig(t *testing.T) {
 					WatchNamespace:          "",
 					SyncRunnersInterval:     5 * time.Second,
 					MinIdleRunnersAge:       2 * time.Hour,
+					PoolDrainTimeout:        1 * time.Hour,
+					ScaleDownStepSize:       0,
+					ScaleDownCooldown:       0,
 					RunnerConcurrency:       50,
 					RepositoryConcurrency:   10,
 					OrganizationConcurrency: 5,
 					EnterpriseConcurrency:   1,
 					PoolConcurrency:         10,
 					RunnerReconciliation:    false,
+					RunnerEventStream:       true,
 					LogVerbosityLevel:       0,
 				},
 				Garm: GarmConfig{
-					Server:   "http://localhost:9997",
-					Username: "admin",
-					Password: "password",
-					Init:     true,
-					Email:    "garm-operator@localhost",
+					Server:                  "http://localhost:9997",
+					Username:                "admin",
+					Password:                "password",
+					Init:                    true,
+					Email:                   "garm-operator@localhost",
+					RateLimit:               20,
+					RateLimitBurst:          40,
+					MaxRetries:              3,
+					RetryBackoff:            500 * time.Millisecond,
+					CircuitBreakerThreshold: 5,
+					CircuitBreakerTimeout:   30 * time.Second,
 				},
 			},
 		},
@@ -114,20 +135,30 @@ func TestGenerateConfig(t *testing.T) {
 					WatchNamespace:          "",
 					SyncRunnersInterval:     10 * time.Second,
 					MinIdleRunnersAge:       2 * time.Hour,
+					PoolDrainTimeout:        1 * time.Hour,
+					ScaleDownStepSize:       0,
+					ScaleDownCooldown:       0,
 					RunnerConcurrency:       50,
 					RepositoryConcurrency:   10,
 					OrganizationConcurrency: 5,
 					EnterpriseConcurrency:   1,
 					PoolConcurrency:         10,
 					RunnerReconciliation:    false,
+					RunnerEventStream:       true,
 					LogVerbosityLevel:       0,
 				},
 				Garm: GarmConfig{
-					Server:   "http://localhost:9997",
-					Username: "admin",
-					Password: "password",
-					Init:     true,
-					Email:    "garm-operator@localhost",
+					Server:                  "http://localhost:9997",
+					Username:                "admin",
+					Password:                "password",
+					Init:                    true,
+					Email:                   "garm-operator@localhost",
+					RateLimit:               20,
+					RateLimitBurst:          40,
+					MaxRetries:              3,
+					RetryBackoff:            500 * time.Millisecond,
+					CircuitBreakerThreshold: 5,
+					CircuitBreakerTimeout:   30 * time.Second,
 				},
 			},
 		},
@@ -151,20 +182,30 @@ func TestGenerateConfig(t *testing.T) {
 					WatchNamespace:          "garm-operator-namespace",
 					SyncRunnersInterval:     15 * time.Second,
 					MinIdleRunnersAge:       2 * time.Hour,
+					PoolDrainTimeout:        1 * time.Hour,
+					ScaleDownStepSize:       0,
+					ScaleDownCooldown:       0,
 					RunnerConcurrency:       50,
 					RepositoryConcurrency:   10,
 					OrganizationConcurrency: 5,
 					EnterpriseConcurrency:   1,
 					PoolConcurrency:         10,
 					RunnerReconciliation:    false,
+					RunnerEventStream:       true,
 					LogVerbosityLevel:       0,
 				},
 				Garm: GarmConfig{
-					Server:   "http://garm-server:9997",
-					Username: "garm-username",
-					Password: "garm-password",
-					Init:     true,
-					Email:    "garm-operator@localhost",
+					Server:                  "http://garm-server:9997",
+					Username:                "garm-username",
+					Password:                "garm-password",
+					Init:                    true,
+					Email:                   "garm-operator@localhost",
+					RateLimit:               20,
+					RateLimitBurst:          40,
+					MaxRetries:              3,
+					RetryBackoff:            500 * time.Millisecond,
+					CircuitBreakerThreshold: 5,
+					CircuitBreakerTimeout:   30 * time.Second,
 				},
 			},
 		},
@@ -189,13 +230,22 @@ func TestGenerateConfig(t *testing.T) {
 					WatchNamespace:         "garm-operator-namespace",
 					SyncRunnersInterval:    5 * time.Second,
 					MinIdleRunnersAge:      2 * time.Hour,
+					PoolDrainTimeout:       1 * time.Hour,
+					ScaleDownStepSize:      0,
+					ScaleDownCooldown:      0,
 				},
 				Garm: GarmConfig{
-					Server:   "http://garm-server:9997",
-					Username: "garm-username",
-					Password: "garm-password",
-					Init:     true,
-					Email:    "garm-operator@localhost",
+					Server:                  "http://garm-server:9997",
+					Username:                "garm-username",
+					Password:                "garm-password",
+					Init:                    true,
+					Email:                   "garm-operator@localhost",
+					RateLimit:               20,
+					RateLimitBurst:          40,
+					MaxRetries:              3,
+					RetryBackoff:            500 * time.Millisecond,
+					CircuitBreakerThreshold: 5,
+					CircuitBreakerTimeout:   30 * time.Second,
 				},
 			},
 		},
@@ -220,13 +270,22 @@ func TestGenerateConfig(t *testing.T) {
 					WatchNamespace:         "garm-operator-namespace",
 					SyncRunnersInterval:    5 * time.Second,
 					MinIdleRunnersAge:      2 * time.Hour,
+					PoolDrainTimeout:       1 * time.Hour,
+					ScaleDownStepSize:      0,
+					ScaleDownCooldown:      0,
 				},
 				Garm: GarmConfig{
-					Server:   "http://garm-server:9997",
-					Username: "garm-username",
-					Password: "garm-password",
-					Init:     true,
-					Email:    "garm-operator@localhost",
+					Server:                  "http://garm-server:9997",
+					Username:                "garm-username",
+					Password:                "garm-password",
+					Init:                    true,
+					Email:                   "garm-operator@localhost",
+					RateLimit:               20,
+					RateLimitBurst:          40,
+					MaxRetries:              3,
+					RetryBackoff:            500 * time.Millisecond,
+					CircuitBreakerThreshold: 5,
+					CircuitBreakerTimeout:   30 * time.Second,
 				},
 			},
 		},
@@ -13,10 +13,19 @@ const (
 	DefaultWatchNamespace         = ""
 	DefaultSyncRunnersInterval    = 5 * time.Second
 	DefaultMinIdleRunnersAge      = 2 * time.Hour
+	DefaultPoolDrainTimeout       = 1 * time.Hour
+	DefaultScaleDownStepSize      = 0
+	DefaultScaleDownCooldown      = 0 * time.Second
 
 	// default values for garm configuration
-	DefaultGarmInit  = true
-	DefaultGarmEmail = "garm-operator@localhost"
+	DefaultGarmInit                    = true
+	DefaultGarmEmail                   = "garm-operator@localhost"
+	DefaultGarmRateLimit               = 20.0
+	DefaultGarmRateLimitBurst          = 40
+	DefaultGarmMaxRetries              = 3
+	DefaultGarmRetryBackoff            = 500 * time.Millisecond
+	DefaultGarmCircuitBreakerThreshold = 5
+	DefaultGarmCircuitBreakerTimeout   = 30 * time.Second
 
 	// default values for controller concurrency configuration
 	DefaultRunnerConcurrency       = 50
@@ -27,6 +36,7 @@ const (
 
 	// default values for controller reconciliation configuration
 	DefaultRunnerReconciliation = false
+	DefaultRunnerEventStream    = true
 
 	// default values for controller logging configuration
 	DefaultLogVerbosityLevel = 0
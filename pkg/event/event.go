@@ -9,12 +9,24 @@ import (
 )
 
 const (
-	CreatingEvent = "Creating"
-	UpdatingEvent = "Updating"
-	DeletingEvent = "Deleting"
-	ScalingEvent  = "Scaling"
-	ErrorEvent    = "Error"
-	InfoEvent     = "Info"
+	CreatingEvent   = "Creating"
+	UpdatingEvent   = "Updating"
+	DeletingEvent   = "Deleting"
+	ScalingEvent    = "Scaling"
+	SchedulingEvent = "Scheduling"
+	ErrorEvent      = "Error"
+	InfoEvent       = "Info"
+	DriftEvent      = "Drift"
+	RecyclingEvent  = "Recycling"
+	RemediateEvent  = "Remediate"
+	ActionEvent     = "Action"
+
+	PoolManagerRunningEvent = "PoolManagerRunning"
+	PoolManagerFailureEvent = "PoolManagerFailure"
+
+	BlockedByPoolsEvent = "BlockedByPools"
+
+	ScheduleConflictEvent = "ScheduleConflict"
 )
 
 func Creating(recorder record.EventRecorder, obj client.Object, msg string) {
@@ -33,10 +45,46 @@ func Scaling(recorder record.EventRecorder, obj client.Object, msg string) {
 	recorder.Event(obj, corev1.EventTypeNormal, ScalingEvent, msg)
 }
 
+func Scheduling(recorder record.EventRecorder, obj client.Object, msg string) {
+	recorder.Event(obj, corev1.EventTypeNormal, SchedulingEvent, msg)
+}
+
 func Info(recorder record.EventRecorder, obj client.Object, msg string) {
 	recorder.Event(obj, corev1.EventTypeNormal, InfoEvent, msg)
 }
 
+func Drift(recorder record.EventRecorder, obj client.Object, msg string) {
+	recorder.Event(obj, corev1.EventTypeWarning, DriftEvent, msg)
+}
+
+func Recycling(recorder record.EventRecorder, obj client.Object, msg string) {
+	recorder.Event(obj, corev1.EventTypeNormal, RecyclingEvent, msg)
+}
+
+func Remediate(recorder record.EventRecorder, obj client.Object, msg string) {
+	recorder.Event(obj, corev1.EventTypeWarning, RemediateEvent, msg)
+}
+
+func Action(recorder record.EventRecorder, obj client.Object, msg string) {
+	recorder.Event(obj, corev1.EventTypeNormal, ActionEvent, msg)
+}
+
+func PoolManagerRunning(recorder record.EventRecorder, obj client.Object, msg string) {
+	recorder.Event(obj, corev1.EventTypeNormal, PoolManagerRunningEvent, msg)
+}
+
+func PoolManagerFailure(recorder record.EventRecorder, obj client.Object, msg string) {
+	recorder.Event(obj, corev1.EventTypeWarning, PoolManagerFailureEvent, msg)
+}
+
+func BlockedByPools(recorder record.EventRecorder, obj client.Object, msg string) {
+	recorder.Event(obj, corev1.EventTypeWarning, BlockedByPoolsEvent, msg)
+}
+
+func ScheduleConflict(recorder record.EventRecorder, obj client.Object, msg string) {
+	recorder.Event(obj, corev1.EventTypeWarning, ScheduleConflictEvent, msg)
+}
+
 func Error(recorder record.EventRecorder, obj client.Object, msg string) {
 	recorder.Event(obj, corev1.EventTypeWarning, ErrorEvent, msg)
 }
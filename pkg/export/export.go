@@ -0,0 +1,421 @@
+// SPDX-License-Identifier: MIT
+
+package export
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/cloudbase/garm/client/credentials"
+	"github.com/cloudbase/garm/client/endpoints"
+	"github.com/cloudbase/garm/client/enterprises"
+	"github.com/cloudbase/garm/client/organizations"
+	"github.com/cloudbase/garm/client/pools"
+	"github.com/cloudbase/garm/client/repositories"
+	"github.com/cloudbase/garm/params"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/yaml"
+
+	garmoperatorv1beta1 "github.com/mercedes-benz/garm-operator/api/v1beta1"
+	garmClient "github.com/mercedes-benz/garm-operator/pkg/client"
+	"github.com/mercedes-benz/garm-operator/pkg/client/key"
+	"github.com/mercedes-benz/garm-operator/pkg/tags"
+)
+
+// SecretPlaceholder is the value of all exported secrets which can't be read from GARM
+const SecretPlaceholder = "CHANGE_ME"
+
+const (
+	webhookSecretKey = "webhookSecret"
+	caBundleKey      = "caBundle"
+	patKey           = "token"
+	privateKeyKey    = "privateKey"
+)
+
+var invalidNameChars = regexp.MustCompile(`[^a-z0-9.-]+`)
+
+// Clients are the GARM clients which are used to read the existing resources
+type Clients struct {
+	Endpoint     garmClient.EndpointClient
+	Credentials  garmClient.CredentialsClient
+	Enterprise   garmClient.EnterpriseClient
+	Organization garmClient.OrganizationClient
+	Repository   garmClient.RepositoryClient
+	Pool         garmClient.PoolClient
+}
+
+func NewClients(client garmClient.GarmClient) Clients {
+	return Clients{
+		Endpoint:     garmClient.NewEndpointClient(client),
+		Credentials:  garmClient.NewCredentialsClient(client),
+		Enterprise:   garmClient.NewEnterpriseClient(client),
+		Organization: garmClient.NewOrganizationClient(client),
+		Repository:   garmClient.NewRepositoryClient(client),
+		Pool:         garmClient.NewPoolClient(client),
+	}
+}
+
+// Export reads all endpoints, credentials, enterprises, organizations, repositories and pools from GARM
+// and returns them as resources in the given namespace, which can be applied to let the operator manage them.
+// The images of the pools are deduplicated into Image resources and all pools get the adopt annotation
+// with their GARM pool ID, so the operator binds the pools instead of creating new ones.
+// Secrets which can't be read from GARM contain SecretPlaceholder.
+func Export(ctx context.Context, clients Clients, namespace string) ([]client.Object, error) {
+	log := log.FromContext(ctx)
+
+	objects := []client.Object{}
+
+	garmEndpoints, err := clients.Endpoint.ListEndpoints(endpoints.NewListGithubEndpointsParams())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list endpoints: %w", err)
+	}
+	for _, endpoint := range garmEndpoints.Payload {
+		objects = append(objects, exportEndpoint(endpoint, namespace)...)
+	}
+
+	garmCredentials, err := clients.Credentials.ListCredentials(credentials.NewListCredentialsParams())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list credentials: %w", err)
+	}
+	for _, creds := range garmCredentials.Payload {
+		objects = append(objects, exportCredentials(creds, namespace)...)
+	}
+
+	// the scopes of the pools are referenced by their garm id
+	scopes := map[string]corev1.TypedLocalObjectReference{}
+
+	garmEnterprises, err := clients.Enterprise.ListEnterprises(enterprises.NewListEnterprisesParams())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list enterprises: %w", err)
+	}
+	for _, enterprise := range garmEnterprises.Payload {
+		name := objectName(enterprise.Name)
+		scopes[enterprise.ID] = scopeRef(garmoperatorv1beta1.EnterpriseScope, name)
+		objects = append(objects,
+			&garmoperatorv1beta1.Enterprise{
+				TypeMeta:   typeMeta("Enterprise"),
+				ObjectMeta: objectMeta(name, namespace),
+				Spec: garmoperatorv1beta1.EnterpriseSpec{
+					CredentialsRef:   credentialsRef(enterprise.Credentials, enterprise.CredentialsName),
+					WebhookSecretRef: webhookSecretRef(name),
+					PoolBalancerType: enterprise.PoolBalancerType,
+				},
+			},
+			placeholderSecret(webhookSecretRef(name), namespace),
+		)
+	}
+
+	garmOrganizations, err := clients.Organization.ListOrganizations(organizations.NewListOrgsParams())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list organizations: %w", err)
+	}
+	for _, org := range garmOrganizations.Payload {
+		name := objectName(org.Name)
+		scopes[org.ID] = scopeRef(garmoperatorv1beta1.OrganizationScope, name)
+		objects = append(objects,
+			&garmoperatorv1beta1.Organization{
+				TypeMeta:   typeMeta("Organization"),
+				ObjectMeta: objectMeta(name, namespace),
+				Spec: garmoperatorv1beta1.OrganizationSpec{
+					CredentialsRef:   credentialsRef(org.Credentials, org.CredentialsName),
+					WebhookSecretRef: webhookSecretRef(name),
+					PoolBalancerType: org.PoolBalancerType,
+				},
+			},
+			placeholderSecret(webhookSecretRef(name), namespace),
+		)
+	}
+
+	garmRepositories, err := clients.Repository.ListRepositories(repositories.NewListReposParams())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list repositories: %w", err)
+	}
+	for _, repo := range garmRepositories.Payload {
+		name := objectName(repo.Name)
+		scopes[repo.ID] = scopeRef(garmoperatorv1beta1.RepositoryScope, name)
+		objects = append(objects,
+			&garmoperatorv1beta1.Repository{
+				TypeMeta:   typeMeta("Repository"),
+				ObjectMeta: objectMeta(name, namespace),
+				Spec: garmoperatorv1beta1.RepositorySpec{
+					CredentialsRef:   credentialsRef(repo.Credentials, repo.CredentialsName),
+					Owner:            repo.Owner,
+					WebhookSecretRef: webhookSecretRef(name),
+					PoolBalancerType: repo.PoolBalancerType,
+				},
+			},
+			placeholderSecret(webhookSecretRef(name), namespace),
+		)
+	}
+
+	garmPools, err := clients.Pool.ListAllPools(pools.NewListPoolsParams())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pools: %w", err)
+	}
+
+	// image names by their tag, as different tags might result in the same object name
+	images := map[string]string{}
+	imageNames := map[string]bool{}
+	for _, pool := range garmPools.Payload {
+		scope, ok := scopes[poolScopeID(pool)]
+		if !ok {
+			log.Info("skipping pool with unknown scope", "id", pool.ID)
+			continue
+		}
+
+		imageName, ok := images[pool.Image]
+		if !ok {
+			imageName = uniqueObjectName(pool.Image, imageNames)
+			images[pool.Image] = imageName
+			imageNames[imageName] = true
+			objects = append(objects, &garmoperatorv1beta1.Image{
+				TypeMeta:   typeMeta("Image"),
+				ObjectMeta: objectMeta(imageName, namespace),
+				Spec: garmoperatorv1beta1.ImageSpec{
+					Tag: pool.Image,
+				},
+			})
+		}
+
+		poolObject, err := exportPool(pool, scope, imageName, namespace)
+		if err != nil {
+			return nil, fmt.Errorf("failed to export pool %s: %w", pool.ID, err)
+		}
+		objects = append(objects, poolObject)
+	}
+
+	return objects, nil
+}
+
+// WriteManifests writes the objects as yaml documents without their status
+func WriteManifests(w io.Writer, objects []client.Object) error {
+	for _, obj := range objects {
+		content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+		if err != nil {
+			return err
+		}
+		unstructured.RemoveNestedField(content, "metadata", "creationTimestamp")
+		unstructured.RemoveNestedField(content, "status")
+
+		manifest, err := yaml.Marshal(content)
+		if err != nil {
+			return err
+		}
+
+		if _, err := fmt.Fprintf(w, "---\n%s", manifest); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func exportEndpoint(endpoint params.GithubEndpoint, namespace string) []client.Object {
+	name := objectName(endpoint.Name)
+	githubEndpoint := &garmoperatorv1beta1.GitHubEndpoint{
+		TypeMeta:   typeMeta("GitHubEndpoint"),
+		ObjectMeta: objectMeta(name, namespace),
+		Spec: garmoperatorv1beta1.GitHubEndpointSpec{
+			Description:   endpoint.Description,
+			APIBaseURL:    endpoint.APIBaseURL,
+			UploadBaseURL: endpoint.UploadBaseURL,
+			BaseURL:       endpoint.BaseURL,
+		},
+	}
+
+	if len(endpoint.CACertBundle) == 0 {
+		return []client.Object{githubEndpoint}
+	}
+
+	githubEndpoint.Spec.CACertBundleSecretRef = garmoperatorv1beta1.SecretRef{
+		Name: name + "-ca-bundle",
+		Key:  caBundleKey,
+	}
+	caBundleSecret := &corev1.Secret{
+		TypeMeta:   metav1.TypeMeta{Kind: "Secret", APIVersion: "v1"},
+		ObjectMeta: objectMeta(githubEndpoint.Spec.CACertBundleSecretRef.Name, namespace),
+		Data: map[string][]byte{
+			caBundleKey: endpoint.CACertBundle,
+		},
+	}
+
+	return []client.Object{githubEndpoint, caBundleSecret}
+}
+
+func exportCredentials(creds params.GithubCredentials, namespace string) []client.Object {
+	name := objectName(creds.Name)
+
+	secretRef := garmoperatorv1beta1.SecretRef{
+		Name: name,
+		Key:  patKey,
+	}
+	if creds.AuthType == params.GithubAuthTypeApp {
+		secretRef.Key = privateKeyKey
+	}
+
+	return []client.Object{
+		&garmoperatorv1beta1.GitHubCredential{
+			TypeMeta:   typeMeta("GitHubCredential"),
+			ObjectMeta: objectMeta(name, namespace),
+			Spec: garmoperatorv1beta1.GitHubCredentialSpec{
+				Description: creds.Description,
+				EndpointRef: corev1.TypedLocalObjectReference{
+					APIGroup: &garmoperatorv1beta1.GroupVersion.Group,
+					Kind:     "GitHubEndpoint",
+					Name:     objectName(creds.Endpoint.Name),
+				},
+				AuthType:  creds.AuthType,
+				SecretRef: secretRef,
+			},
+		},
+		placeholderSecret(secretRef, namespace),
+	}
+}
+
+func exportPool(pool params.Pool, scope corev1.TypedLocalObjectReference, imageName, namespace string) (*garmoperatorv1beta1.Pool, error) {
+	poolTags := make([]string, 0, len(pool.Tags))
+	for _, tag := range pool.Tags {
+		poolTags = append(poolTags, tag.Name)
+	}
+
+	// the github default tags are added by the operator itself
+	poolTags, err := tags.RemoveGithubDefaultTags(poolTags, pool.OSArch, pool.OSType)
+	if err != nil {
+		return nil, err
+	}
+
+	extraSpecs := ""
+	if len(pool.ExtraSpecs) > 0 {
+		extraSpecs = string(pool.ExtraSpecs)
+	}
+
+	meta := objectMeta(objectName(fmt.Sprintf("%s-%s", scope.Name, shortID(pool.ID))), namespace)
+	meta.Annotations = map[string]string{
+		key.AdoptAnnotation: pool.ID,
+	}
+
+	return &garmoperatorv1beta1.Pool{
+		TypeMeta:   typeMeta("Pool"),
+		ObjectMeta: meta,
+		Spec: garmoperatorv1beta1.PoolSpec{
+			GitHubScopeRef:         scope,
+			ProviderName:           pool.ProviderName,
+			MaxRunners:             pool.MaxRunners,
+			MinIdleRunners:         pool.MinIdleRunners,
+			Flavor:                 pool.Flavor,
+			OSType:                 pool.OSType,
+			OSArch:                 pool.OSArch,
+			Tags:                   poolTags,
+			Enabled:                pool.Enabled,
+			RunnerBootstrapTimeout: pool.RunnerBootstrapTimeout,
+			ImageName:              imageName,
+			ExtraSpecs:             extraSpecs,
+			GitHubRunnerGroup:      pool.GitHubRunnerGroup,
+			RunnerPrefix:           pool.Prefix,
+		},
+	}, nil
+}
+
+func poolScopeID(pool params.Pool) string {
+	switch {
+	case pool.EnterpriseID != "":
+		return pool.EnterpriseID
+	case pool.OrgID != "":
+		return pool.OrgID
+	default:
+		return pool.RepoID
+	}
+}
+
+func credentialsRef(creds params.GithubCredentials, credentialsName string) corev1.TypedLocalObjectReference {
+	name := creds.Name
+	if name == "" {
+		name = credentialsName
+	}
+
+	return corev1.TypedLocalObjectReference{
+		APIGroup: &garmoperatorv1beta1.GroupVersion.Group,
+		Kind:     "GitHubCredential",
+		Name:     objectName(name),
+	}
+}
+
+func scopeRef(scope garmoperatorv1beta1.GitHubScopeKind, name string) corev1.TypedLocalObjectReference {
+	return corev1.TypedLocalObjectReference{
+		APIGroup: &garmoperatorv1beta1.GroupVersion.Group,
+		Kind:     string(scope),
+		Name:     name,
+	}
+}
+
+func webhookSecretRef(name string) garmoperatorv1beta1.SecretRef {
+	return garmoperatorv1beta1.SecretRef{
+		Name: name + "-webhook-secret",
+		Key:  webhookSecretKey,
+	}
+}
+
+func placeholderSecret(ref garmoperatorv1beta1.SecretRef, namespace string) *corev1.Secret {
+	return &corev1.Secret{
+		TypeMeta:   metav1.TypeMeta{Kind: "Secret", APIVersion: "v1"},
+		ObjectMeta: objectMeta(ref.Name, namespace),
+		StringData: map[string]string{
+			ref.Key: SecretPlaceholder,
+		},
+	}
+}
+
+func typeMeta(kind string) metav1.TypeMeta {
+	return metav1.TypeMeta{
+		Kind:       kind,
+		APIVersion: garmoperatorv1beta1.GroupVersion.String(),
+	}
+}
+
+func objectMeta(name, namespace string) metav1.ObjectMeta {
+	return metav1.ObjectMeta{
+		Name:      name,
+		Namespace: namespace,
+	}
+}
+
+// objectName turns a GARM name into a valid resource name.
+// As the operator finds existing GARM resources by the resource name,
+// changed names (other than lowercasing entity names) won't match the existing GARM resources.
+func objectName(name string) string {
+	name = invalidNameChars.ReplaceAllString(strings.ToLower(name), "-")
+	name = strings.Trim(name, "-.")
+	if len(name) > 253 {
+		name = strings.Trim(name[:253], "-.")
+	}
+	return name
+}
+
+// uniqueObjectName returns the object name of name. If the object name is already used,
+// a short hash of name is appended to tell it apart from the other names which result in the same object name.
+func uniqueObjectName(name string, used map[string]bool) string {
+	objName := objectName(name)
+	if !used[objName] {
+		return objName
+	}
+
+	hash := sha256.Sum256([]byte(name))
+	suffix := hex.EncodeToString(hash[:])[:8]
+	return objectName(objName[:min(len(objName), 252-len(suffix))] + "-" + suffix)
+}
+
+func shortID(id string) string {
+	if len(id) > 8 {
+		return id[:8]
+	}
+	return id
+}
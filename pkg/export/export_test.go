@@ -0,0 +1,395 @@
+// SPDX-License-Identifier: MIT
+
+package export
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/cloudbase/garm/client/credentials"
+	"github.com/cloudbase/garm/client/endpoints"
+	"github.com/cloudbase/garm/client/enterprises"
+	"github.com/cloudbase/garm/client/organizations"
+	"github.com/cloudbase/garm/client/pools"
+	"github.com/cloudbase/garm/client/repositories"
+	"github.com/cloudbase/garm/params"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	garmoperatorv1beta1 "github.com/mercedes-benz/garm-operator/api/v1beta1"
+	"github.com/mercedes-benz/garm-operator/pkg/client/key"
+	"github.com/mercedes-benz/garm-operator/pkg/client/mock"
+)
+
+const namespaceName = "garm-operator-system"
+
+func TestExport(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	orgID := "93068607-2d0d-4b76-a950-0e40d31955b8"
+	repoID := "2fb9b5a4-d2c9-4d1c-9f4a-3a0b6f3a7b3e"
+
+	endpointClient := mock.NewMockEndpointClient(mockCtrl)
+	endpointClient.EXPECT().ListEndpoints(endpoints.NewListGithubEndpointsParams()).Return(&endpoints.ListGithubEndpointsOK{
+		Payload: params.GithubEndpoints{
+			{
+				Name:          "github.com",
+				Description:   "The github.com endpoint",
+				APIBaseURL:    "https://api.github.com/",
+				UploadBaseURL: "https://uploads.github.com/",
+				BaseURL:       "https://github.com",
+			},
+		},
+	}, nil)
+
+	credentialsClient := mock.NewMockCredentialsClient(mockCtrl)
+	credentialsClient.EXPECT().ListCredentials(credentials.NewListCredentialsParams()).Return(&credentials.ListCredentialsOK{
+		Payload: params.Credentials{
+			{
+				Name:        "GitHub-PAT",
+				Description: "credentials for github",
+				AuthType:    params.GithubAuthTypePAT,
+				Endpoint: params.GithubEndpoint{
+					Name: "github.com",
+				},
+			},
+		},
+	}, nil)
+
+	enterpriseClient := mock.NewMockEnterpriseClient(mockCtrl)
+	enterpriseClient.EXPECT().ListEnterprises(enterprises.NewListEnterprisesParams()).Return(&enterprises.ListEnterprisesOK{
+		Payload: params.Enterprises{},
+	}, nil)
+
+	organizationClient := mock.NewMockOrganizationClient(mockCtrl)
+	organizationClient.EXPECT().ListOrganizations(organizations.NewListOrgsParams()).Return(&organizations.ListOrgsOK{
+		Payload: params.Organizations{
+			{
+				ID:               orgID,
+				Name:             "My-Org",
+				CredentialsName:  "GitHub-PAT",
+				PoolBalancerType: params.PoolBalancerTypeRoundRobin,
+			},
+		},
+	}, nil)
+
+	repositoryClient := mock.NewMockRepositoryClient(mockCtrl)
+	repositoryClient.EXPECT().ListRepositories(repositories.NewListReposParams()).Return(&repositories.ListReposOK{
+		Payload: params.Repositories{
+			{
+				ID:    repoID,
+				Owner: "My-Org",
+				Name:  "garm-operator",
+				Credentials: params.GithubCredentials{
+					Name: "GitHub-PAT",
+				},
+			},
+		},
+	}, nil)
+
+	poolClient := mock.NewMockPoolClient(mockCtrl)
+	poolClient.EXPECT().ListAllPools(pools.NewListPoolsParams()).Return(&pools.ListPoolsOK{
+		Payload: params.Pools{
+			{
+				ID:                     "fb2bceeb-f74d-435d-9648-626c75cb23ce",
+				ProviderName:           "kubernetes_external",
+				MaxRunners:             5,
+				MinIdleRunners:         1,
+				Image:                  "localhost:5000/runner:linux-ubuntu-22.04-arm64",
+				Flavor:                 "medium",
+				OSType:                 "linux",
+				OSArch:                 "arm64",
+				Tags:                   []params.Tag{{Name: "self-hosted"}, {Name: "arm64"}, {Name: "Linux"}, {Name: "ubuntu"}},
+				Enabled:                true,
+				OrgID:                  orgID,
+				RunnerBootstrapTimeout: 20,
+			},
+			{
+				ID:                     "0a7a5bde-5d4c-4d6e-8a4a-7e5b0f7d2c11",
+				ProviderName:           "kubernetes_external",
+				MaxRunners:             2,
+				Image:                  "localhost:5000/runner:linux-ubuntu-22.04-arm64",
+				Flavor:                 "small",
+				OSType:                 "linux",
+				OSArch:                 "arm64",
+				Tags:                   []params.Tag{{Name: "self-hosted"}, {Name: "arm64"}, {Name: "Linux"}},
+				RepoID:                 repoID,
+				RunnerBootstrapTimeout: 20,
+				ExtraSpecs:             []byte(`{"foo":"bar"}`),
+			},
+			{
+				ID:           "5d0d3a31-52a0-4b2f-b1f4-9b6e4f6d0a22",
+				ProviderName: "kubernetes_external",
+				Image:        "ubuntu",
+				OSType:       "linux",
+				OSArch:       "amd64",
+				EnterpriseID: "unknown-enterprise",
+			},
+		},
+	}, nil)
+
+	clients := Clients{
+		Endpoint:     endpointClient,
+		Credentials:  credentialsClient,
+		Enterprise:   enterpriseClient,
+		Organization: organizationClient,
+		Repository:   repositoryClient,
+		Pool:         poolClient,
+	}
+
+	got, err := Export(context.Background(), clients, namespaceName)
+	assert.NoError(t, err)
+
+	credentialsRef := corev1.TypedLocalObjectReference{
+		APIGroup: &garmoperatorv1beta1.GroupVersion.Group,
+		Kind:     "GitHubCredential",
+		Name:     "github-pat",
+	}
+
+	want := []client.Object{
+		&garmoperatorv1beta1.GitHubEndpoint{
+			TypeMeta:   typeMeta("GitHubEndpoint"),
+			ObjectMeta: metav1.ObjectMeta{Name: "github.com", Namespace: namespaceName},
+			Spec: garmoperatorv1beta1.GitHubEndpointSpec{
+				Description:   "The github.com endpoint",
+				APIBaseURL:    "https://api.github.com/",
+				UploadBaseURL: "https://uploads.github.com/",
+				BaseURL:       "https://github.com",
+			},
+		},
+		&garmoperatorv1beta1.GitHubCredential{
+			TypeMeta:   typeMeta("GitHubCredential"),
+			ObjectMeta: metav1.ObjectMeta{Name: "github-pat", Namespace: namespaceName},
+			Spec: garmoperatorv1beta1.GitHubCredentialSpec{
+				Description: "credentials for github",
+				EndpointRef: corev1.TypedLocalObjectReference{
+					APIGroup: &garmoperatorv1beta1.GroupVersion.Group,
+					Kind:     "GitHubEndpoint",
+					Name:     "github.com",
+				},
+				AuthType:  params.GithubAuthTypePAT,
+				SecretRef: garmoperatorv1beta1.SecretRef{Name: "github-pat", Key: "token"},
+			},
+		},
+		&corev1.Secret{
+			TypeMeta:   metav1.TypeMeta{Kind: "Secret", APIVersion: "v1"},
+			ObjectMeta: metav1.ObjectMeta{Name: "github-pat", Namespace: namespaceName},
+			StringData: map[string]string{"token": SecretPlaceholder},
+		},
+		&garmoperatorv1beta1.Organization{
+			TypeMeta:   typeMeta("Organization"),
+			ObjectMeta: metav1.ObjectMeta{Name: "my-org", Namespace: namespaceName},
+			Spec: garmoperatorv1beta1.OrganizationSpec{
+				CredentialsRef:   credentialsRef,
+				WebhookSecretRef: garmoperatorv1beta1.SecretRef{Name: "my-org-webhook-secret", Key: "webhookSecret"},
+				PoolBalancerType: params.PoolBalancerTypeRoundRobin,
+			},
+		},
+		&corev1.Secret{
+			TypeMeta:   metav1.TypeMeta{Kind: "Secret", APIVersion: "v1"},
+			ObjectMeta: metav1.ObjectMeta{Name: "my-org-webhook-secret", Namespace: namespaceName},
+			StringData: map[string]string{"webhookSecret": SecretPlaceholder},
+		},
+		&garmoperatorv1beta1.Repository{
+			TypeMeta:   typeMeta("Repository"),
+			ObjectMeta: metav1.ObjectMeta{Name: "garm-operator", Namespace: namespaceName},
+			Spec: garmoperatorv1beta1.RepositorySpec{
+				CredentialsRef:   credentialsRef,
+				Owner:            "My-Org",
+				WebhookSecretRef: garmoperatorv1beta1.SecretRef{Name: "garm-operator-webhook-secret", Key: "webhookSecret"},
+			},
+		},
+		&corev1.Secret{
+			TypeMeta:   metav1.TypeMeta{Kind: "Secret", APIVersion: "v1"},
+			ObjectMeta: metav1.ObjectMeta{Name: "garm-operator-webhook-secret", Namespace: namespaceName},
+			StringData: map[string]string{"webhookSecret": SecretPlaceholder},
+		},
+		&garmoperatorv1beta1.Image{
+			TypeMeta:   typeMeta("Image"),
+			ObjectMeta: metav1.ObjectMeta{Name: "localhost-5000-runner-linux-ubuntu-22.04-arm64", Namespace: namespaceName},
+			Spec: garmoperatorv1beta1.ImageSpec{
+				Tag: "localhost:5000/runner:linux-ubuntu-22.04-arm64",
+			},
+		},
+		&garmoperatorv1beta1.Pool{
+			TypeMeta: typeMeta("Pool"),
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "my-org-fb2bceeb",
+				Namespace:   namespaceName,
+				Annotations: map[string]string{key.AdoptAnnotation: "fb2bceeb-f74d-435d-9648-626c75cb23ce"},
+			},
+			Spec: garmoperatorv1beta1.PoolSpec{
+				GitHubScopeRef: corev1.TypedLocalObjectReference{
+					APIGroup: &garmoperatorv1beta1.GroupVersion.Group,
+					Kind:     "Organization",
+					Name:     "my-org",
+				},
+				ProviderName:           "kubernetes_external",
+				MaxRunners:             5,
+				MinIdleRunners:         1,
+				Flavor:                 "medium",
+				OSType:                 "linux",
+				OSArch:                 "arm64",
+				Tags:                   []string{"ubuntu"},
+				Enabled:                true,
+				RunnerBootstrapTimeout: 20,
+				ImageName:              "localhost-5000-runner-linux-ubuntu-22.04-arm64",
+			},
+		},
+		&garmoperatorv1beta1.Pool{
+			TypeMeta: typeMeta("Pool"),
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "garm-operator-0a7a5bde",
+				Namespace:   namespaceName,
+				Annotations: map[string]string{key.AdoptAnnotation: "0a7a5bde-5d4c-4d6e-8a4a-7e5b0f7d2c11"},
+			},
+			Spec: garmoperatorv1beta1.PoolSpec{
+				GitHubScopeRef: corev1.TypedLocalObjectReference{
+					APIGroup: &garmoperatorv1beta1.GroupVersion.Group,
+					Kind:     "Repository",
+					Name:     "garm-operator",
+				},
+				ProviderName:           "kubernetes_external",
+				MaxRunners:             2,
+				Flavor:                 "small",
+				OSType:                 "linux",
+				OSArch:                 "arm64",
+				Tags:                   []string{},
+				RunnerBootstrapTimeout: 20,
+				ImageName:              "localhost-5000-runner-linux-ubuntu-22.04-arm64",
+				ExtraSpecs:             `{"foo":"bar"}`,
+			},
+		},
+	}
+
+	assert.Equal(t, want, got)
+}
+
+func TestExport_ImageNameCollision(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	orgID := "93068607-2d0d-4b76-a950-0e40d31955b8"
+
+	endpointClient := mock.NewMockEndpointClient(mockCtrl)
+	endpointClient.EXPECT().ListEndpoints(endpoints.NewListGithubEndpointsParams()).Return(&endpoints.ListGithubEndpointsOK{}, nil)
+
+	credentialsClient := mock.NewMockCredentialsClient(mockCtrl)
+	credentialsClient.EXPECT().ListCredentials(credentials.NewListCredentialsParams()).Return(&credentials.ListCredentialsOK{}, nil)
+
+	enterpriseClient := mock.NewMockEnterpriseClient(mockCtrl)
+	enterpriseClient.EXPECT().ListEnterprises(enterprises.NewListEnterprisesParams()).Return(&enterprises.ListEnterprisesOK{}, nil)
+
+	organizationClient := mock.NewMockOrganizationClient(mockCtrl)
+	organizationClient.EXPECT().ListOrganizations(organizations.NewListOrgsParams()).Return(&organizations.ListOrgsOK{
+		Payload: params.Organizations{
+			{
+				ID:              orgID,
+				Name:            "my-org",
+				CredentialsName: "github-pat",
+			},
+		},
+	}, nil)
+
+	repositoryClient := mock.NewMockRepositoryClient(mockCtrl)
+	repositoryClient.EXPECT().ListRepositories(repositories.NewListReposParams()).Return(&repositories.ListReposOK{}, nil)
+
+	// both image tags result in the object name "ubuntu-22.04"
+	poolClient := mock.NewMockPoolClient(mockCtrl)
+	poolClient.EXPECT().ListAllPools(pools.NewListPoolsParams()).Return(&pools.ListPoolsOK{
+		Payload: params.Pools{
+			{
+				ID:     "fb2bceeb-f74d-435d-9648-626c75cb23ce",
+				Image:  "ubuntu:22.04",
+				OSType: "linux",
+				OSArch: "amd64",
+				OrgID:  orgID,
+			},
+			{
+				ID:     "0a7a5bde-5d4c-4d6e-8a4a-7e5b0f7d2c11",
+				Image:  "ubuntu_22.04",
+				OSType: "linux",
+				OSArch: "amd64",
+				OrgID:  orgID,
+			},
+			{
+				ID:     "5d0d3a31-52a0-4b2f-b1f4-9b6e4f6d0a22",
+				Image:  "ubuntu:22.04",
+				OSType: "linux",
+				OSArch: "amd64",
+				OrgID:  orgID,
+			},
+		},
+	}, nil)
+
+	clients := Clients{
+		Endpoint:     endpointClient,
+		Credentials:  credentialsClient,
+		Enterprise:   enterpriseClient,
+		Organization: organizationClient,
+		Repository:   repositoryClient,
+		Pool:         poolClient,
+	}
+
+	got, err := Export(context.Background(), clients, namespaceName)
+	assert.NoError(t, err)
+
+	images := map[string]string{}
+	poolImages := map[string]string{}
+	for _, obj := range got {
+		switch o := obj.(type) {
+		case *garmoperatorv1beta1.Image:
+			images[o.Name] = o.Spec.Tag
+		case *garmoperatorv1beta1.Pool:
+			poolImages[o.Name] = images[o.Spec.ImageName]
+		}
+	}
+
+	assert.Equal(t, map[string]string{
+		"ubuntu-22.04":          "ubuntu:22.04",
+		"ubuntu-22.04-421bf8e7": "ubuntu_22.04",
+	}, images)
+	assert.Equal(t, map[string]string{
+		"my-org-fb2bceeb": "ubuntu:22.04",
+		"my-org-0a7a5bde": "ubuntu_22.04",
+		"my-org-5d0d3a31": "ubuntu:22.04",
+	}, poolImages)
+}
+
+func TestWriteManifests(t *testing.T) {
+	objects := []client.Object{
+		&garmoperatorv1beta1.Image{
+			TypeMeta:   typeMeta("Image"),
+			ObjectMeta: metav1.ObjectMeta{Name: "ubuntu", Namespace: namespaceName},
+			Spec: garmoperatorv1beta1.ImageSpec{
+				Tag: "ubuntu:22.04",
+			},
+		},
+		placeholderSecret(webhookSecretRef("my-org"), namespaceName),
+	}
+
+	var buf bytes.Buffer
+	assert.NoError(t, WriteManifests(&buf, objects))
+	assert.Equal(t, `---
+apiVersion: garm-operator.mercedes-benz.com/v1beta1
+kind: Image
+metadata:
+  name: ubuntu
+  namespace: garm-operator-system
+spec:
+  tag: ubuntu:22.04
+---
+apiVersion: v1
+kind: Secret
+metadata:
+  name: my-org-webhook-secret
+  namespace: garm-operator-system
+stringData:
+  webhookSecret: CHANGE_ME
+`, buf.String())
+}
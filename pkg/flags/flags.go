@@ -28,6 +28,9 @@ func InitiateFlags() *pflag.FlagSet {
 	f.String("operator-watch-namespace", defaults.DefaultWatchNamespace, "Namespace that the controller watches to reconcile garm objects. "+"If unspecified, the controller watches for garm objects across all namespaces.")
 	f.Duration("operator-sync-runners-interval", defaults.DefaultSyncRunnersInterval, "Specifies interval in which runners from garm-api are polled and synced to Runner CustomResource")
 	f.Duration("operator-min-idle-runners-age", defaults.DefaultMinIdleRunnersAge, "The minimum age an idle runner should have to get marked for deletion (e.g. 30m)")
+	f.Duration("operator-pool-drain-timeout", defaults.DefaultPoolDrainTimeout, "The time active runners get to finish their jobs before a deleted pool stops waiting for them, if not set on the pool itself (e.g. 1h)")
+	f.Int("operator-scale-down-step-size", defaults.DefaultScaleDownStepSize, "The maximum number of idle runners deleted at once when a pool scales down, if not set on the pool itself (0 deletes all of them at once)")
+	f.Duration("operator-scale-down-cooldown", defaults.DefaultScaleDownCooldown, "The minimum time between two scale downs of a pool, if not set on the pool itself (e.g. 10m)")
 
 	f.Int("operator-runner-concurrency", defaults.DefaultRunnerConcurrency, "Specifies the maximum number of concurrent runners that can be reconciled simultaneously")
 	f.Int("operator-repository-concurrency", defaults.DefaultRepositoryConcurrency, "Specifies the maximum number of concurrent repositories that can be reconciled simultaneously")
@@ -36,6 +39,7 @@ func InitiateFlags() *pflag.FlagSet {
 	f.Int("operator-pool-concurrency", defaults.DefaultPoolConcurrency, "Specifies the maximum number of concurrent pools that can be reconciled simultaneously")
 
 	f.Bool("operator-runner-reconciliation", defaults.DefaultRunnerReconciliation, "Specifies if runner reconciliation should be enabled")
+	f.Bool("operator-runner-event-stream", defaults.DefaultRunnerEventStream, "Specifies if runners should be synced from the GARM event stream. Falls back to polling every sync-runners-interval while the stream is disconnected")
 
 	f.Int("operator-log-verbosity-level", defaults.DefaultLogVerbosityLevel, "Specifies the log verbosity level (0-5).")
 
@@ -44,6 +48,12 @@ func InitiateFlags() *pflag.FlagSet {
 	f.String("garm-password", "", "The password for the GARM server")
 	f.Bool("garm-init", defaults.DefaultGarmInit, "Enable initialization of new GARM Instance")
 	f.String("garm-email", defaults.DefaultGarmEmail, "The email address for the GARM server (only required if garm-init is set to true)")
+	f.Float64("garm-rate-limit", defaults.DefaultGarmRateLimit, "Maximum number of requests per second sent to a GARM server (0 disables the rate limit)")
+	f.Int("garm-rate-limit-burst", defaults.DefaultGarmRateLimitBurst, "Number of requests which may exceed the rate limit of a GARM server at once")
+	f.Int("garm-max-retries", defaults.DefaultGarmMaxRetries, "Number of retries of idempotent requests to a GARM server after connection errors or 5xx responses")
+	f.Duration("garm-retry-backoff", defaults.DefaultGarmRetryBackoff, "Initial backoff between two retries of a request to a GARM server, it doubles with every retry")
+	f.Int("garm-circuit-breaker-threshold", defaults.DefaultGarmCircuitBreakerThreshold, "Number of consecutive failed requests after which no requests are sent to a GARM server for a while (0 disables the circuit breaker)")
+	f.Duration("garm-circuit-breaker-timeout", defaults.DefaultGarmCircuitBreakerTimeout, "Time no requests are sent to a GARM server after the circuit breaker opened")
 
 	f.Bool("dry-run", false, "If true, only print the object that would be sent, without sending it.")
 
@@ -0,0 +1,884 @@
+// SPDX-License-Identifier: MIT
+
+package garmfake
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/cloudbase/garm/params"
+	"github.com/google/uuid"
+)
+
+func (s *Server) registerRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("POST "+basePath+"/first-run", s.firstRun)
+	mux.HandleFunc("POST "+basePath+"/auth/login", s.login)
+	mux.HandleFunc("GET "+basePath+"/controller-info", s.getControllerInfo)
+	mux.HandleFunc("PUT "+basePath+"/controller", s.updateController)
+
+	mux.HandleFunc("GET "+basePath+"/github/endpoints", s.listEndpoints)
+	mux.HandleFunc("POST "+basePath+"/github/endpoints", s.createEndpoint)
+	mux.HandleFunc("GET "+basePath+"/github/endpoints/{name}", s.getEndpoint)
+	mux.HandleFunc("PUT "+basePath+"/github/endpoints/{name}", s.updateEndpoint)
+	mux.HandleFunc("DELETE "+basePath+"/github/endpoints/{name}", s.deleteEndpoint)
+
+	mux.HandleFunc("GET "+basePath+"/github/credentials", s.listCredentials)
+	mux.HandleFunc("POST "+basePath+"/github/credentials", s.createCredentials)
+	mux.HandleFunc("GET "+basePath+"/github/credentials/{id}", s.getCredentials)
+	mux.HandleFunc("PUT "+basePath+"/github/credentials/{id}", s.updateCredentials)
+	mux.HandleFunc("DELETE "+basePath+"/github/credentials/{id}", s.deleteCredentials)
+
+	s.registerEntityRoutes(mux, "/enterprises", params.GithubEntityTypeEnterprise)
+	s.registerEntityRoutes(mux, "/organizations", params.GithubEntityTypeOrganization)
+	s.registerEntityRoutes(mux, "/repositories", params.GithubEntityTypeRepository)
+
+	mux.HandleFunc("GET "+basePath+"/pools", s.listPools(""))
+	mux.HandleFunc("GET "+basePath+"/pools/{poolID}", s.getPool(""))
+	mux.HandleFunc("PUT "+basePath+"/pools/{poolID}", s.updatePool(""))
+	mux.HandleFunc("DELETE "+basePath+"/pools/{poolID}", s.deletePool(""))
+	mux.HandleFunc("GET "+basePath+"/pools/{poolID}/instances", s.listPoolInstances)
+
+	mux.HandleFunc("GET "+basePath+"/instances", s.listInstances)
+	mux.HandleFunc("GET "+basePath+"/instances/{instanceName}", s.getInstance)
+	mux.HandleFunc("DELETE "+basePath+"/instances/{instanceName}", s.deleteInstance)
+}
+
+func (s *Server) registerEntityRoutes(mux *http.ServeMux, path string, entityType params.GithubEntityType) {
+	mux.HandleFunc("GET "+basePath+path, s.listEntities(entityType))
+	mux.HandleFunc("POST "+basePath+path, s.createEntity(entityType))
+	mux.HandleFunc("GET "+basePath+path+"/{entityID}", s.getEntity(entityType))
+	mux.HandleFunc("PUT "+basePath+path+"/{entityID}", s.updateEntity(entityType))
+	mux.HandleFunc("DELETE "+basePath+path+"/{entityID}", s.deleteEntity(entityType))
+	mux.HandleFunc("GET "+basePath+path+"/{entityID}/pools", s.listPools(entityType))
+	mux.HandleFunc("POST "+basePath+path+"/{entityID}/pools", s.createPool(entityType))
+	mux.HandleFunc("GET "+basePath+path+"/{entityID}/pools/{poolID}", s.getPool(entityType))
+	mux.HandleFunc("PUT "+basePath+path+"/{entityID}/pools/{poolID}", s.updatePool(entityType))
+	mux.HandleFunc("DELETE "+basePath+path+"/{entityID}/pools/{poolID}", s.deletePool(entityType))
+}
+
+func decodeBody(w http.ResponseWriter, r *http.Request, v interface{}) bool {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("failed to decode request body: %s", err))
+		return false
+	}
+	return true
+}
+
+func (s *Server) firstRun(w http.ResponseWriter, r *http.Request) {
+	var newUser params.NewUserParams
+	if !decodeBody(w, r, &newUser) {
+		return
+	}
+
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	if s.initialized {
+		writeError(w, http.StatusConflict, "init already done")
+		return
+	}
+
+	s.initialized = true
+	s.username = newUser.Username
+	s.password = newUser.Password
+
+	writeJSON(w, http.StatusOK, params.User{
+		ID:       uuid.NewString(),
+		Email:    newUser.Email,
+		Username: newUser.Username,
+		FullName: newUser.FullName,
+		Enabled:  true,
+		IsAdmin:  true,
+	})
+}
+
+func (s *Server) login(w http.ResponseWriter, r *http.Request) {
+	var login params.PasswordLoginParams
+	if !decodeBody(w, r, &login) {
+		return
+	}
+
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	if !s.initialized || login.Username != s.username || login.Password != s.password {
+		writeError(w, http.StatusUnauthorized, "Authentication failed")
+		return
+	}
+
+	token, err := s.issueToken()
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	writeJSON(w, http.StatusOK, params.JWTResponse{Token: token})
+}
+
+func (s *Server) getControllerInfo(w http.ResponseWriter, _ *http.Request) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	// garm refuses to work until the urls of the controller are configured
+	if s.controllerInfo.MetadataURL == "" || s.controllerInfo.CallbackURL == "" || s.controllerInfo.WebhookURL == "" {
+		writeError(w, http.StatusConflict, "missing controller urls")
+		return
+	}
+	writeJSON(w, http.StatusOK, s.controllerInfo)
+}
+
+func (s *Server) updateController(w http.ResponseWriter, r *http.Request) {
+	var update params.UpdateControllerParams
+	if !decodeBody(w, r, &update) {
+		return
+	}
+
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	if update.MetadataURL != nil {
+		s.controllerInfo.MetadataURL = *update.MetadataURL
+	}
+	if update.CallbackURL != nil {
+		s.controllerInfo.CallbackURL = *update.CallbackURL
+	}
+	if update.WebhookURL != nil {
+		s.controllerInfo.WebhookURL = *update.WebhookURL
+		s.controllerInfo.ControllerWebhookURL = strings.TrimSuffix(*update.WebhookURL, "/") + "/" + s.controllerInfo.ControllerID.String()
+	}
+	if update.MinimumJobAgeBackoff != nil {
+		s.controllerInfo.MinimumJobAgeBackoff = *update.MinimumJobAgeBackoff
+	}
+	writeJSON(w, http.StatusOK, s.controllerInfo)
+}
+
+func (s *Server) listEndpoints(w http.ResponseWriter, _ *http.Request) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	writeJSON(w, http.StatusOK, sortedValues(s.endpoints, func(e params.GithubEndpoint) string { return e.Name }))
+}
+
+func (s *Server) createEndpoint(w http.ResponseWriter, r *http.Request) {
+	var create params.CreateGithubEndpointParams
+	if !decodeBody(w, r, &create) {
+		return
+	}
+
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	if create.Name == "" {
+		writeError(w, http.StatusBadRequest, "missing name")
+		return
+	}
+	if _, ok := s.endpoints[create.Name]; ok {
+		writeError(w, http.StatusConflict, "endpoint already exists")
+		return
+	}
+
+	endpoint := params.GithubEndpoint{
+		Name:          create.Name,
+		Description:   create.Description,
+		APIBaseURL:    create.APIBaseURL,
+		UploadBaseURL: create.UploadBaseURL,
+		BaseURL:       create.BaseURL,
+		CACertBundle:  create.CACertBundle,
+	}
+	s.endpoints[endpoint.Name] = endpoint
+	writeJSON(w, http.StatusOK, endpoint)
+}
+
+func (s *Server) getEndpoint(w http.ResponseWriter, r *http.Request) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	endpoint, ok := s.endpoints[r.PathValue("name")]
+	if !ok {
+		writeError(w, http.StatusNotFound, "endpoint not found")
+		return
+	}
+	writeJSON(w, http.StatusOK, endpoint)
+}
+
+func (s *Server) updateEndpoint(w http.ResponseWriter, r *http.Request) {
+	var update params.UpdateGithubEndpointParams
+	if !decodeBody(w, r, &update) {
+		return
+	}
+
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	endpoint, ok := s.endpoints[r.PathValue("name")]
+	if !ok {
+		writeError(w, http.StatusNotFound, "endpoint not found")
+		return
+	}
+
+	if update.Description != nil {
+		endpoint.Description = *update.Description
+	}
+	if update.APIBaseURL != nil {
+		endpoint.APIBaseURL = *update.APIBaseURL
+	}
+	if update.UploadBaseURL != nil {
+		endpoint.UploadBaseURL = *update.UploadBaseURL
+	}
+	if update.BaseURL != nil {
+		endpoint.BaseURL = *update.BaseURL
+	}
+	if update.CACertBundle != nil {
+		endpoint.CACertBundle = update.CACertBundle
+	}
+	s.endpoints[endpoint.Name] = endpoint
+	writeJSON(w, http.StatusOK, endpoint)
+}
+
+func (s *Server) deleteEndpoint(w http.ResponseWriter, r *http.Request) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	name := r.PathValue("name")
+	if _, ok := s.endpoints[name]; !ok {
+		writeError(w, http.StatusNotFound, "endpoint not found")
+		return
+	}
+	for _, credentials := range s.credentials {
+		if credentials.Endpoint.Name == name {
+			writeError(w, http.StatusBadRequest, "endpoint has credentials")
+			return
+		}
+	}
+
+	delete(s.endpoints, name)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) listCredentials(w http.ResponseWriter, _ *http.Request) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	credentials := params.Credentials{}
+	for _, c := range sortedValues(s.credentials, func(c params.GithubCredentials) string { return c.Name }) {
+		credentials = append(credentials, s.withEndpoint(c))
+	}
+	writeJSON(w, http.StatusOK, credentials)
+}
+
+func (s *Server) createCredentials(w http.ResponseWriter, r *http.Request) {
+	var create params.CreateGithubCredentialsParams
+	if !decodeBody(w, r, &create) {
+		return
+	}
+
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	if create.Name == "" {
+		writeError(w, http.StatusBadRequest, "missing name")
+		return
+	}
+	if _, ok := s.endpoints[create.Endpoint]; !ok {
+		writeError(w, http.StatusBadRequest, "endpoint not found")
+		return
+	}
+	if _, ok := s.credentialsByName(create.Name); ok {
+		writeError(w, http.StatusConflict, "credentials already exist")
+		return
+	}
+
+	authType := create.AuthType
+	if authType == "" {
+		authType = params.GithubAuthTypePAT
+	}
+
+	s.credentialsID++
+	credentials := params.GithubCredentials{
+		ID:          s.credentialsID,
+		Name:        create.Name,
+		Description: create.Description,
+		AuthType:    authType,
+		Endpoint:    params.GithubEndpoint{Name: create.Endpoint},
+	}
+	s.credentials[credentials.ID] = credentials
+	writeJSON(w, http.StatusOK, s.withEndpoint(credentials))
+}
+
+func (s *Server) getCredentials(w http.ResponseWriter, r *http.Request) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	credentials, ok := s.credentialsByPath(w, r)
+	if !ok {
+		return
+	}
+	writeJSON(w, http.StatusOK, s.withEndpoint(credentials))
+}
+
+func (s *Server) updateCredentials(w http.ResponseWriter, r *http.Request) {
+	var update params.UpdateGithubCredentialsParams
+	if !decodeBody(w, r, &update) {
+		return
+	}
+
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	credentials, ok := s.credentialsByPath(w, r)
+	if !ok {
+		return
+	}
+
+	if update.Name != nil {
+		credentials.Name = *update.Name
+	}
+	if update.Description != nil {
+		credentials.Description = *update.Description
+	}
+	s.credentials[credentials.ID] = credentials
+	writeJSON(w, http.StatusOK, s.withEndpoint(credentials))
+}
+
+func (s *Server) deleteCredentials(w http.ResponseWriter, r *http.Request) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	credentials, ok := s.credentialsByPath(w, r)
+	if !ok {
+		return
+	}
+	for _, entity := range s.entities {
+		if entity.Credentials.ID == credentials.ID {
+			writeError(w, http.StatusBadRequest, "credentials are in use")
+			return
+		}
+	}
+
+	delete(s.credentials, credentials.ID)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) credentialsByPath(w http.ResponseWriter, r *http.Request) (params.GithubCredentials, bool) {
+	id, err := strconv.ParseUint(r.PathValue("id"), 10, 64)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid credentials id")
+		return params.GithubCredentials{}, false
+	}
+	credentials, ok := s.credentials[uint(id)]
+	if !ok {
+		writeError(w, http.StatusNotFound, "credentials not found")
+		return params.GithubCredentials{}, false
+	}
+	return credentials, true
+}
+
+func (s *Server) credentialsByName(name string) (params.GithubCredentials, bool) {
+	for _, credentials := range s.credentials {
+		if credentials.Name == name {
+			return credentials, true
+		}
+	}
+	return params.GithubCredentials{}, false
+}
+
+// withEndpoint resolves the current state of the endpoint of the credentials
+func (s *Server) withEndpoint(credentials params.GithubCredentials) params.GithubCredentials {
+	endpoint := s.endpoints[credentials.Endpoint.Name]
+	credentials.Endpoint = endpoint
+	credentials.APIBaseURL = endpoint.APIBaseURL
+	credentials.UploadBaseURL = endpoint.UploadBaseURL
+	credentials.BaseURL = endpoint.BaseURL
+	credentials.CABundle = endpoint.CACertBundle
+	return credentials
+}
+
+// createEntityParams covers the request bodies to create enterprises, organizations and repositories
+type createEntityParams struct {
+	Owner            string                  `json:"owner"`
+	Name             string                  `json:"name"`
+	CredentialsName  string                  `json:"credentials_name"`
+	WebhookSecret    string                  `json:"webhook_secret"`
+	PoolBalancerType params.PoolBalancerType `json:"pool_balancer_type"`
+}
+
+func (s *Server) listEntities(entityType params.GithubEntityType) http.HandlerFunc {
+	return func(w http.ResponseWriter, _ *http.Request) {
+		s.mux.Lock()
+		defer s.mux.Unlock()
+
+		entities := []interface{}{}
+		for _, entity := range s.sortedEntities(entityType) {
+			entities = append(entities, s.toEntityResponse(entity))
+		}
+		writeJSON(w, http.StatusOK, entities)
+	}
+}
+
+func (s *Server) createEntity(entityType params.GithubEntityType) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var create createEntityParams
+		if !decodeBody(w, r, &create) {
+			return
+		}
+
+		s.mux.Lock()
+		defer s.mux.Unlock()
+
+		if create.Name == "" || (entityType == params.GithubEntityTypeRepository && create.Owner == "") {
+			writeError(w, http.StatusBadRequest, "missing name")
+			return
+		}
+		credentials, ok := s.credentialsByName(create.CredentialsName)
+		if !ok {
+			writeError(w, http.StatusBadRequest, "credentials not found")
+			return
+		}
+		for _, entity := range s.entities {
+			if entity.EntityType == entityType && strings.EqualFold(entity.Owner, create.Owner) && strings.EqualFold(entity.Name, create.Name) {
+				writeError(w, http.StatusConflict, fmt.Sprintf("%s already exists", entityType))
+				return
+			}
+		}
+
+		poolBalancerType := create.PoolBalancerType
+		if poolBalancerType == "" {
+			poolBalancerType = params.PoolBalancerTypeRoundRobin
+		}
+
+		entity := params.GithubEntity{
+			ID:               uuid.NewString(),
+			Owner:            create.Owner,
+			Name:             create.Name,
+			EntityType:       entityType,
+			Credentials:      params.GithubCredentials{ID: credentials.ID},
+			PoolBalancerType: poolBalancerType,
+			WebhookSecret:    create.WebhookSecret,
+		}
+		s.entities[entity.ID] = entity
+		writeJSON(w, http.StatusOK, s.toEntityResponse(entity))
+	}
+}
+
+func (s *Server) getEntity(entityType params.GithubEntityType) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		s.mux.Lock()
+		defer s.mux.Unlock()
+
+		entity, ok := s.entityByPath(w, r, entityType)
+		if !ok {
+			return
+		}
+		writeJSON(w, http.StatusOK, s.toEntityResponse(entity))
+	}
+}
+
+func (s *Server) updateEntity(entityType params.GithubEntityType) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var update params.UpdateEntityParams
+		if !decodeBody(w, r, &update) {
+			return
+		}
+
+		s.mux.Lock()
+		defer s.mux.Unlock()
+
+		entity, ok := s.entityByPath(w, r, entityType)
+		if !ok {
+			return
+		}
+
+		if update.CredentialsName != "" {
+			credentials, ok := s.credentialsByName(update.CredentialsName)
+			if !ok {
+				writeError(w, http.StatusBadRequest, "credentials not found")
+				return
+			}
+			entity.Credentials = params.GithubCredentials{ID: credentials.ID}
+		}
+		if update.WebhookSecret != "" {
+			entity.WebhookSecret = update.WebhookSecret
+		}
+		if update.PoolBalancerType != "" {
+			entity.PoolBalancerType = update.PoolBalancerType
+		}
+		s.entities[entity.ID] = entity
+		writeJSON(w, http.StatusOK, s.toEntityResponse(entity))
+	}
+}
+
+func (s *Server) deleteEntity(entityType params.GithubEntityType) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		s.mux.Lock()
+		defer s.mux.Unlock()
+
+		entity, ok := s.entityByPath(w, r, entityType)
+		if !ok {
+			return
+		}
+		for _, pool := range s.pools {
+			if poolEntityID(pool) == entity.ID {
+				writeError(w, http.StatusBadRequest, fmt.Sprintf("%s has pools", entityType))
+				return
+			}
+		}
+
+		delete(s.entities, entity.ID)
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func (s *Server) entityByPath(w http.ResponseWriter, r *http.Request, entityType params.GithubEntityType) (params.GithubEntity, bool) {
+	entity, ok := s.entities[r.PathValue("entityID")]
+	if !ok || entity.EntityType != entityType {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("%s not found", entityType))
+		return params.GithubEntity{}, false
+	}
+	return entity, true
+}
+
+func (s *Server) sortedEntities(entityType params.GithubEntityType) []params.GithubEntity {
+	entities := []params.GithubEntity{}
+	for _, entity := range sortedValues(s.entities, func(e params.GithubEntity) string { return e.Owner + "/" + e.Name }) {
+		if entity.EntityType == entityType {
+			entities = append(entities, entity)
+		}
+	}
+	return entities
+}
+
+func (s *Server) toEntityResponse(entity params.GithubEntity) interface{} {
+	switch entity.EntityType {
+	case params.GithubEntityTypeEnterprise:
+		return s.toEnterprise(entity)
+	case params.GithubEntityTypeOrganization:
+		return s.toOrganization(entity)
+	default:
+		return s.toRepository(entity)
+	}
+}
+
+func (s *Server) toEnterprise(entity params.GithubEntity) params.Enterprise {
+	credentials := s.withEndpoint(s.credentials[entity.Credentials.ID])
+	return params.Enterprise{
+		ID:                entity.ID,
+		Name:              entity.Name,
+		CredentialsName:   credentials.Name,
+		Credentials:       credentials,
+		CredentialsID:     credentials.ID,
+		PoolManagerStatus: params.PoolManagerStatus{IsRunning: true},
+		PoolBalancerType:  entity.PoolBalancerType,
+		Endpoint:          credentials.Endpoint,
+		WebhookSecret:     entity.WebhookSecret,
+	}
+}
+
+func (s *Server) toOrganization(entity params.GithubEntity) params.Organization {
+	credentials := s.withEndpoint(s.credentials[entity.Credentials.ID])
+	return params.Organization{
+		ID:                entity.ID,
+		Name:              entity.Name,
+		CredentialsName:   credentials.Name,
+		Credentials:       credentials,
+		CredentialsID:     credentials.ID,
+		PoolManagerStatus: params.PoolManagerStatus{IsRunning: true},
+		PoolBalancerType:  entity.PoolBalancerType,
+		Endpoint:          credentials.Endpoint,
+		WebhookSecret:     entity.WebhookSecret,
+	}
+}
+
+func (s *Server) toRepository(entity params.GithubEntity) params.Repository {
+	credentials := s.withEndpoint(s.credentials[entity.Credentials.ID])
+	return params.Repository{
+		ID:                entity.ID,
+		Owner:             entity.Owner,
+		Name:              entity.Name,
+		CredentialsName:   credentials.Name,
+		CredentialsID:     credentials.ID,
+		Credentials:       credentials,
+		PoolManagerStatus: params.PoolManagerStatus{IsRunning: true},
+		PoolBalancerType:  entity.PoolBalancerType,
+		Endpoint:          credentials.Endpoint,
+		WebhookSecret:     entity.WebhookSecret,
+	}
+}
+
+func (s *Server) listPools(entityType params.GithubEntityType) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		s.mux.Lock()
+		defer s.mux.Unlock()
+
+		entityID := ""
+		if entityType != "" {
+			entity, ok := s.entityByPath(w, r, entityType)
+			if !ok {
+				return
+			}
+			entityID = entity.ID
+		}
+
+		pools := params.Pools{}
+		for _, pool := range sortedValues(s.pools, func(p params.Pool) string { return p.ID }) {
+			if entityID == "" || poolEntityID(pool) == entityID {
+				pools = append(pools, s.withInstances(pool))
+			}
+		}
+		writeJSON(w, http.StatusOK, pools)
+	}
+}
+
+func (s *Server) createPool(entityType params.GithubEntityType) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var create params.CreatePoolParams
+		if !decodeBody(w, r, &create) {
+			return
+		}
+
+		s.mux.Lock()
+		defer s.mux.Unlock()
+
+		entity, ok := s.entityByPath(w, r, entityType)
+		if !ok {
+			return
+		}
+		if create.ProviderName == "" || create.Image == "" || create.Flavor == "" {
+			writeError(w, http.StatusBadRequest, "missing provider, image or flavor")
+			return
+		}
+		if create.MinIdleRunners > create.MaxRunners {
+			writeError(w, http.StatusBadRequest, "min_idle_runners cannot be larger than max_runners")
+			return
+		}
+
+		pool := params.Pool{
+			RunnerPrefix:           create.RunnerPrefix,
+			ID:                     uuid.NewString(),
+			ProviderName:           create.ProviderName,
+			MaxRunners:             create.MaxRunners,
+			MinIdleRunners:         create.MinIdleRunners,
+			Image:                  create.Image,
+			Flavor:                 create.Flavor,
+			OSType:                 create.OSType,
+			OSArch:                 create.OSArch,
+			Tags:                   toTags(create.Tags),
+			Enabled:                create.Enabled,
+			RunnerBootstrapTimeout: create.RunnerBootstrapTimeout,
+			ExtraSpecs:             create.ExtraSpecs,
+			GitHubRunnerGroup:      create.GitHubRunnerGroup,
+			Priority:               create.Priority,
+		}
+		switch entityType {
+		case params.GithubEntityTypeEnterprise:
+			pool.EnterpriseID = entity.ID
+			pool.EnterpriseName = entity.Name
+		case params.GithubEntityTypeOrganization:
+			pool.OrgID = entity.ID
+			pool.OrgName = entity.Name
+		case params.GithubEntityTypeRepository:
+			pool.RepoID = entity.ID
+			pool.RepoName = entity.Owner + "/" + entity.Name
+		}
+
+		s.pools[pool.ID] = pool
+		writeJSON(w, http.StatusOK, s.withInstances(pool))
+	}
+}
+
+func (s *Server) getPool(entityType params.GithubEntityType) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		s.mux.Lock()
+		defer s.mux.Unlock()
+
+		pool, ok := s.poolByPath(w, r, entityType)
+		if !ok {
+			return
+		}
+		writeJSON(w, http.StatusOK, s.withInstances(pool))
+	}
+}
+
+func (s *Server) updatePool(entityType params.GithubEntityType) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var update params.UpdatePoolParams
+		if !decodeBody(w, r, &update) {
+			return
+		}
+
+		s.mux.Lock()
+		defer s.mux.Unlock()
+
+		pool, ok := s.poolByPath(w, r, entityType)
+		if !ok {
+			return
+		}
+
+		if update.Prefix != "" {
+			pool.Prefix = update.Prefix
+		}
+		if update.Tags != nil {
+			pool.Tags = toTags(update.Tags)
+		}
+		if update.Enabled != nil {
+			pool.Enabled = *update.Enabled
+		}
+		if update.MaxRunners != nil {
+			pool.MaxRunners = *update.MaxRunners
+		}
+		if update.MinIdleRunners != nil {
+			pool.MinIdleRunners = *update.MinIdleRunners
+		}
+		if update.RunnerBootstrapTimeout != nil {
+			pool.RunnerBootstrapTimeout = *update.RunnerBootstrapTimeout
+		}
+		if update.Image != "" {
+			pool.Image = update.Image
+		}
+		if update.Flavor != "" {
+			pool.Flavor = update.Flavor
+		}
+		if update.OSType != "" {
+			pool.OSType = update.OSType
+		}
+		if update.OSArch != "" {
+			pool.OSArch = update.OSArch
+		}
+		if update.ExtraSpecs != nil {
+			pool.ExtraSpecs = update.ExtraSpecs
+		}
+		if update.GitHubRunnerGroup != nil {
+			pool.GitHubRunnerGroup = *update.GitHubRunnerGroup
+		}
+		if update.Priority != nil {
+			pool.Priority = *update.Priority
+		}
+		if pool.MinIdleRunners > pool.MaxRunners {
+			writeError(w, http.StatusBadRequest, "min_idle_runners cannot be larger than max_runners")
+			return
+		}
+
+		s.pools[pool.ID] = pool
+		writeJSON(w, http.StatusOK, s.withInstances(pool))
+	}
+}
+
+func (s *Server) deletePool(entityType params.GithubEntityType) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		s.mux.Lock()
+		defer s.mux.Unlock()
+
+		pool, ok := s.poolByPath(w, r, entityType)
+		if !ok {
+			return
+		}
+		if len(s.poolInstances(pool.ID)) > 0 {
+			writeError(w, http.StatusBadRequest, "pool has runners")
+			return
+		}
+
+		delete(s.pools, pool.ID)
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func (s *Server) poolByPath(w http.ResponseWriter, r *http.Request, entityType params.GithubEntityType) (params.Pool, bool) {
+	pool, ok := s.pools[r.PathValue("poolID")]
+	if !ok {
+		writeError(w, http.StatusNotFound, "pool not found")
+		return params.Pool{}, false
+	}
+	if entityType != "" {
+		entity, ok := s.entityByPath(w, r, entityType)
+		if !ok {
+			return params.Pool{}, false
+		}
+		if poolEntityID(pool) != entity.ID {
+			writeError(w, http.StatusNotFound, "pool not found")
+			return params.Pool{}, false
+		}
+	}
+	return pool, true
+}
+
+func (s *Server) withInstances(pool params.Pool) params.Pool {
+	pool.Instances = s.poolInstances(pool.ID)
+	return pool
+}
+
+func (s *Server) poolInstances(poolID string) []params.Instance {
+	instances := []params.Instance{}
+	for _, instance := range sortedValues(s.instances, func(i params.Instance) string { return i.Name }) {
+		if instance.PoolID == poolID {
+			instances = append(instances, instance)
+		}
+	}
+	return instances
+}
+
+func poolEntityID(pool params.Pool) string {
+	switch {
+	case pool.EnterpriseID != "":
+		return pool.EnterpriseID
+	case pool.OrgID != "":
+		return pool.OrgID
+	default:
+		return pool.RepoID
+	}
+}
+
+func toTags(tags []string) []params.Tag {
+	result := make([]params.Tag, 0, len(tags))
+	for _, tag := range tags {
+		result = append(result, params.Tag{
+			ID:   uuid.NewSHA1(uuid.NameSpaceOID, []byte(tag)).String(),
+			Name: tag,
+		})
+	}
+	return result
+}
+
+func (s *Server) listInstances(w http.ResponseWriter, _ *http.Request) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	writeJSON(w, http.StatusOK, sortedValues(s.instances, func(i params.Instance) string { return i.Name }))
+}
+
+func (s *Server) listPoolInstances(w http.ResponseWriter, r *http.Request) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	pool, ok := s.poolByPath(w, r, "")
+	if !ok {
+		return
+	}
+	writeJSON(w, http.StatusOK, s.poolInstances(pool.ID))
+}
+
+func (s *Server) getInstance(w http.ResponseWriter, r *http.Request) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	instance, ok := s.instances[r.PathValue("instanceName")]
+	if !ok {
+		writeError(w, http.StatusNotFound, "instance not found")
+		return
+	}
+	writeJSON(w, http.StatusOK, instance)
+}
+
+func (s *Server) deleteInstance(w http.ResponseWriter, r *http.Request) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	name := r.PathValue("instanceName")
+	if _, ok := s.instances[name]; !ok {
+		writeError(w, http.StatusNotFound, "instance not found")
+		return
+	}
+
+	delete(s.instances, name)
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,419 @@
+// SPDX-License-Identifier: MIT
+
+// Package garmfake provides an in-memory GARM API server, so the operator can be tested
+// against the real GARM client without a running GARM instance.
+package garmfake
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cloudbase/garm/params"
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/google/uuid"
+)
+
+const (
+	// DefaultUsername is the username of the admin user if not configured otherwise
+	DefaultUsername = "admin"
+	// DefaultPassword is the password of the admin user if not configured otherwise
+	DefaultPassword = "password"
+	// DefaultVersion is the GARM version which is reported by the server if not configured otherwise
+	DefaultVersion = "v0.1.5"
+	// DefaultTokenTTL is the lifetime of the issued JWTs if not configured otherwise
+	DefaultTokenTTL = 24 * time.Hour
+
+	basePath = "/api/v1"
+)
+
+// Request is a request the server has received
+type Request struct {
+	Method string
+	Path   string
+}
+
+// Fault is injected into the responses of the server for all requests matching Method and Path.
+type Fault struct {
+	// Method is the HTTP method of the affected requests, an empty method matches all requests
+	Method string
+	// Path is the path prefix of the affected requests without the API base path (e.g. /pools),
+	// an empty path matches all requests
+	Path string
+	// StatusCode is returned instead of the actual response, if set
+	StatusCode int
+	// Latency delays the response
+	Latency time.Duration
+	// Times is the number of requests the fault is injected into, zero means until the faults get cleared
+	Times int
+}
+
+func (f *Fault) matches(r *http.Request) bool {
+	if f.Method != "" && f.Method != r.Method {
+		return false
+	}
+	return strings.HasPrefix(strings.TrimPrefix(r.URL.Path, basePath), f.Path)
+}
+
+// Option configures the server
+type Option func(*Server)
+
+// WithCredentials sets the username and password of the admin user
+func WithCredentials(username, password string) Option {
+	return func(s *Server) {
+		s.username = username
+		s.password = password
+	}
+}
+
+// WithTokenTTL sets the lifetime of the issued JWTs
+func WithTokenTTL(ttl time.Duration) Option {
+	return func(s *Server) {
+		s.tokenTTL = ttl
+	}
+}
+
+// WithVersion sets the GARM version which is reported by the controller info
+func WithVersion(version string) Option {
+	return func(s *Server) {
+		s.controllerInfo.Version = version
+	}
+}
+
+// WithControllerID sets the ID of the controller which is reported by the controller info
+func WithControllerID(controllerID uuid.UUID) Option {
+	return func(s *Server) {
+		s.controllerInfo.ControllerID = controllerID
+	}
+}
+
+// WithInitialized starts the server as if the first run and the controller URLs were already done
+func WithInitialized() Option {
+	return func(s *Server) {
+		s.initialized = true
+		s.controllerInfo.MetadataURL = "https://metadata.garm.local"
+		s.controllerInfo.CallbackURL = "https://callback.garm.local"
+		s.controllerInfo.WebhookURL = "https://webhook.garm.local"
+	}
+}
+
+// Server is an in-memory GARM API server
+type Server struct {
+	*httptest.Server
+
+	mux sync.Mutex
+
+	username    string
+	password    string
+	initialized bool
+	tokenTTL    time.Duration
+	signingKey  []byte
+	// only tokens of the current generation are accepted
+	tokenGeneration uint
+
+	controllerInfo params.ControllerInfo
+	endpoints      map[string]params.GithubEndpoint
+	credentials    map[uint]params.GithubCredentials
+	credentialsID  uint
+	entities       map[string]params.GithubEntity
+	pools          map[string]params.Pool
+	instances      map[string]params.Instance
+
+	faults   []*Fault
+	requests []Request
+}
+
+// NewServer starts a new server, which must be closed by the caller
+func NewServer(opts ...Option) *Server {
+	s := &Server{
+		username:   DefaultUsername,
+		password:   DefaultPassword,
+		tokenTTL:   DefaultTokenTTL,
+		signingKey: []byte(uuid.NewString()),
+		controllerInfo: params.ControllerInfo{
+			ControllerID: uuid.New(),
+			Hostname:     "garmfake",
+			Version:      DefaultVersion,
+		},
+		endpoints:   map[string]params.GithubEndpoint{},
+		credentials: map[uint]params.GithubCredentials{},
+		entities:    map[string]params.GithubEntity{},
+		pools:       map[string]params.Pool{},
+		instances:   map[string]params.Instance{},
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	s.Server = httptest.NewServer(s.handler())
+	return s
+}
+
+// InjectFault adds a fault to the responses of the server, the first matching fault wins
+func (s *Server) InjectFault(fault Fault) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	s.faults = append(s.faults, &fault)
+}
+
+// ClearFaults removes all injected faults
+func (s *Server) ClearFaults() {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	s.faults = nil
+}
+
+// ExpireTokens invalidates all JWTs which have been issued so far
+func (s *Server) ExpireTokens() {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	s.tokenGeneration++
+}
+
+// Requests returns all requests the server has received so far
+func (s *Server) Requests() []Request {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	return append([]Request{}, s.requests...)
+}
+
+// CountRequests returns the number of received requests with the given method and path
+func (s *Server) CountRequests(method, path string) int {
+	count := 0
+	for _, r := range s.Requests() {
+		if r.Method == method && r.Path == basePath+path {
+			count++
+		}
+	}
+	return count
+}
+
+// PutInstance creates or replaces a runner instance in the pool given by instance.PoolID,
+// as instances are created by GARM itself and can't be created via the API
+func (s *Server) PutInstance(instance params.Instance) (params.Instance, error) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	pool, ok := s.pools[instance.PoolID]
+	if !ok {
+		return params.Instance{}, fmt.Errorf("pool %s not found", instance.PoolID)
+	}
+
+	if existing, ok := s.instances[instance.Name]; ok && instance.ID == "" {
+		instance.ID = existing.ID
+	}
+	if instance.ID == "" {
+		instance.ID = uuid.NewString()
+	}
+	if instance.OSType == "" {
+		instance.OSType = pool.OSType
+	}
+	if instance.OSArch == "" {
+		instance.OSArch = pool.OSArch
+	}
+	instance.UpdatedAt = time.Now()
+
+	s.instances[instance.Name] = instance
+	return instance, nil
+}
+
+// ControllerInfo returns the current controller info
+func (s *Server) ControllerInfo() params.ControllerInfo {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	return s.controllerInfo
+}
+
+// Endpoints returns all github endpoints sorted by name
+func (s *Server) Endpoints() params.GithubEndpoints {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	return sortedValues(s.endpoints, func(e params.GithubEndpoint) string { return e.Name })
+}
+
+// Credentials returns all github credentials sorted by name
+func (s *Server) Credentials() params.Credentials {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	return sortedValues(s.credentials, func(c params.GithubCredentials) string { return c.Name })
+}
+
+// Enterprises returns all enterprises sorted by name
+func (s *Server) Enterprises() params.Enterprises {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	enterprises := params.Enterprises{}
+	for _, entity := range s.sortedEntities(params.GithubEntityTypeEnterprise) {
+		enterprises = append(enterprises, s.toEnterprise(entity))
+	}
+	return enterprises
+}
+
+// Organizations returns all organizations sorted by name
+func (s *Server) Organizations() params.Organizations {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	organizations := params.Organizations{}
+	for _, entity := range s.sortedEntities(params.GithubEntityTypeOrganization) {
+		organizations = append(organizations, s.toOrganization(entity))
+	}
+	return organizations
+}
+
+// Repositories returns all repositories sorted by owner and name
+func (s *Server) Repositories() params.Repositories {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	repositories := params.Repositories{}
+	for _, entity := range s.sortedEntities(params.GithubEntityTypeRepository) {
+		repositories = append(repositories, s.toRepository(entity))
+	}
+	return repositories
+}
+
+// Pools returns all pools including their instances sorted by ID
+func (s *Server) Pools() params.Pools {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	pools := sortedValues(s.pools, func(p params.Pool) string { return p.ID })
+	for i := range pools {
+		pools[i] = s.withInstances(pools[i])
+	}
+	return pools
+}
+
+// Instances returns all runner instances sorted by name
+func (s *Server) Instances() params.Instances {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	return sortedValues(s.instances, func(i params.Instance) string { return i.Name })
+}
+
+func sortedValues[K comparable, V any](m map[K]V, key func(V) string) []V {
+	values := make([]V, 0, len(m))
+	for _, v := range m {
+		values = append(values, v)
+	}
+	sort.Slice(values, func(i, j int) bool {
+		return key(values[i]) < key(values[j])
+	})
+	return values
+}
+
+func (s *Server) handler() http.Handler {
+	mux := http.NewServeMux()
+	s.registerRoutes(mux)
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fault := s.recordRequest(r)
+		if fault != nil {
+			time.Sleep(fault.Latency)
+			if fault.StatusCode != 0 {
+				writeError(w, fault.StatusCode, "injected fault")
+				return
+			}
+		}
+
+		// all endpoints except the ones to setup garm and to login require a valid token
+		if r.URL.Path != basePath+"/first-run" && r.URL.Path != basePath+"/auth/login" && !s.authenticated(r) {
+			writeError(w, http.StatusUnauthorized, "Authentication failed")
+			return
+		}
+
+		mux.ServeHTTP(w, r)
+	})
+}
+
+// recordRequest records the request and returns the first matching fault
+func (s *Server) recordRequest(r *http.Request) *Fault {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	s.requests = append(s.requests, Request{Method: r.Method, Path: r.URL.Path})
+
+	for i, fault := range s.faults {
+		if !fault.matches(r) {
+			continue
+		}
+		if fault.Times > 0 {
+			fault.Times--
+			if fault.Times == 0 {
+				s.faults = append(s.faults[:i], s.faults[i+1:]...)
+			}
+		}
+		return fault
+	}
+	return nil
+}
+
+type tokenClaims struct {
+	jwt.RegisteredClaims
+	Generation uint `json:"generation"`
+}
+
+func (s *Server) issueToken() (string, error) {
+	now := time.Now()
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, tokenClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   s.username,
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(s.tokenTTL)),
+		},
+		Generation: s.tokenGeneration,
+	})
+	return token.SignedString(s.signingKey)
+}
+
+func (s *Server) authenticated(r *http.Request) bool {
+	bearerToken, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok {
+		return false
+	}
+
+	claims := &tokenClaims{}
+	if _, err := jwt.ParseWithClaims(bearerToken, claims, func(*jwt.Token) (interface{}, error) {
+		return s.signingKey, nil
+	}); err != nil {
+		return false
+	}
+
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	return claims.Generation == s.tokenGeneration
+}
+
+type apiErrorResponse struct {
+	Error   string `json:"error"`
+	Details string `json:"details"`
+}
+
+func writeError(w http.ResponseWriter, statusCode int, details string) {
+	writeJSON(w, statusCode, apiErrorResponse{
+		Error:   http.StatusText(statusCode),
+		Details: details,
+	})
+}
+
+func writeJSON(w http.ResponseWriter, statusCode int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	_ = json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,195 @@
+// SPDX-License-Identifier: MIT
+
+package garmfake
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/cloudbase/garm/client/credentials"
+	"github.com/cloudbase/garm/client/endpoints"
+	"github.com/cloudbase/garm/client/instances"
+	"github.com/cloudbase/garm/client/organizations"
+	"github.com/cloudbase/garm/client/pools"
+	"github.com/cloudbase/garm/params"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	garmClient "github.com/mercedes-benz/garm-operator/pkg/client"
+)
+
+func registerServer(t *testing.T, s *Server) garmClient.GarmClient {
+	t.Helper()
+
+	client, err := garmClient.RegisterServer(t.Name(), garmClient.GarmScopeParams{
+		BaseURL:  s.URL,
+		Username: DefaultUsername,
+		Password: DefaultPassword,
+		Email:    "admin@example.com",
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		garmClient.UnregisterServer(t.Name())
+	})
+	return client
+}
+
+func createOrganization(t *testing.T, client garmClient.GarmClient) params.Organization {
+	t.Helper()
+
+	_, err := garmClient.NewEndpointClient(client).CreateEndpoint(endpoints.NewCreateGithubEndpointParams().WithBody(params.CreateGithubEndpointParams{
+		Name:       "github.com",
+		APIBaseURL: "https://api.github.com",
+		BaseURL:    "https://github.com",
+	}))
+	require.NoError(t, err)
+
+	_, err = garmClient.NewCredentialsClient(client).CreateCredentials(credentials.NewCreateCredentialsParams().WithBody(params.CreateGithubCredentialsParams{
+		Name:     "github-pat",
+		Endpoint: "github.com",
+		AuthType: params.GithubAuthTypePAT,
+		PAT:      params.GithubPAT{OAuth2Token: "my-token"},
+	}))
+	require.NoError(t, err)
+
+	org, err := garmClient.NewOrganizationClient(client).CreateOrganization(organizations.NewCreateOrgParams().WithBody(params.CreateOrgParams{
+		Name:            "my-org",
+		CredentialsName: "github-pat",
+		WebhookSecret:   "my-secret",
+	}))
+	require.NoError(t, err)
+	return org.Payload
+}
+
+func TestServer_FirstRun(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+
+	client := registerServer(t, s)
+	assert.Equal(t, 1, s.CountRequests(http.MethodPost, "/first-run"))
+	assert.Equal(t, 1, s.CountRequests(http.MethodPost, "/auth/login"))
+
+	// the controller urls aren't set after the first run, the client sets some defaults
+	controllerClient := garmClient.NewControllerClient(client)
+	_, err := controllerClient.GetControllerInfo()
+	assert.True(t, garmClient.IsConflictError(err))
+
+	controllerInfo, err := controllerClient.GetControllerInfo()
+	assert.NoError(t, err)
+	assert.Equal(t, DefaultVersion, controllerInfo.Payload.Version)
+	assert.Equal(t, "https://initial.webhook.garm.local", controllerInfo.Payload.WebhookURL)
+
+	// garm is initialized now, so a new login doesn't call the first-run again
+	assert.NoError(t, client.Login())
+	assert.Equal(t, 1, s.CountRequests(http.MethodPost, "/first-run"))
+	assert.Equal(t, 2, s.CountRequests(http.MethodPost, "/auth/login"))
+}
+
+func TestServer_ExpireTokens(t *testing.T) {
+	s := NewServer(WithInitialized())
+	defer s.Close()
+
+	client := registerServer(t, s)
+	organizationClient := garmClient.NewOrganizationClient(client)
+
+	_, err := organizationClient.ListOrganizations(organizations.NewListOrgsParams())
+	assert.NoError(t, err)
+	assert.Equal(t, 1, s.CountRequests(http.MethodPost, "/auth/login"))
+
+	s.ExpireTokens()
+
+	_, err = organizationClient.ListOrganizations(organizations.NewListOrgsParams())
+	assert.NoError(t, err)
+	assert.Equal(t, 2, s.CountRequests(http.MethodPost, "/auth/login"))
+	assert.Equal(t, 3, s.CountRequests(http.MethodGet, "/organizations"))
+}
+
+func TestServer_InjectFault(t *testing.T) {
+	s := NewServer(WithInitialized())
+	defer s.Close()
+
+	client := registerServer(t, s)
+	org := createOrganization(t, client)
+	organizationClient := garmClient.NewOrganizationClient(client)
+
+	s.InjectFault(Fault{
+		Method:     http.MethodGet,
+		Path:       "/organizations/",
+		StatusCode: http.StatusConflict,
+		Times:      1,
+	})
+
+	_, err := organizationClient.GetOrganization(organizations.NewGetOrgParams().WithOrgID(org.ID))
+	assert.True(t, garmClient.IsConflictError(err))
+
+	_, err = organizationClient.GetOrganization(organizations.NewGetOrgParams().WithOrgID(org.ID))
+	assert.NoError(t, err)
+
+	s.InjectFault(Fault{
+		Path:    "/organizations",
+		Latency: 100 * time.Millisecond,
+	})
+
+	start := time.Now()
+	_, err = organizationClient.ListOrganizations(organizations.NewListOrgsParams())
+	assert.NoError(t, err)
+	assert.GreaterOrEqual(t, time.Since(start), 100*time.Millisecond)
+
+	s.ClearFaults()
+}
+
+func TestServer_Pools(t *testing.T) {
+	s := NewServer(WithInitialized())
+	defer s.Close()
+
+	client := registerServer(t, s)
+	org := createOrganization(t, client)
+	poolClient := garmClient.NewPoolClient(client)
+	instanceClient := garmClient.NewInstanceClient(client)
+
+	_, err := poolClient.CreateOrgPool(organizations.NewCreateOrgPoolParams().WithOrgID(org.ID).WithBody(params.CreatePoolParams{
+		ProviderName: "kubernetes_external",
+		MaxRunners:   5,
+		Image:        "ubuntu:22.04",
+		Flavor:       "small",
+		OSType:       "linux",
+		OSArch:       "amd64",
+		Tags:         []string{"ubuntu"},
+		Enabled:      true,
+	}))
+	assert.NoError(t, err)
+
+	garmPools, err := poolClient.ListAllPools(pools.NewListPoolsParams())
+	assert.NoError(t, err)
+	require.Len(t, garmPools.Payload, 1)
+	pool := garmPools.Payload[0]
+	assert.Equal(t, org.ID, pool.OrgID)
+	assert.Equal(t, "my-org", pool.OrgName)
+
+	_, err = s.PutInstance(params.Instance{
+		Name:         "road-runner",
+		PoolID:       pool.ID,
+		Status:       "running",
+		RunnerStatus: params.RunnerIdle,
+	})
+	assert.NoError(t, err)
+
+	poolInstances, err := instanceClient.ListPoolInstances(instances.NewListPoolInstancesParams().WithPoolID(pool.ID))
+	assert.NoError(t, err)
+	assert.Len(t, poolInstances.Payload, 1)
+
+	// pools with runners can't be deleted
+	err = poolClient.DeletePool(pools.NewDeletePoolParams().WithPoolID(pool.ID))
+	assert.Error(t, err)
+
+	err = instanceClient.DeleteInstance(instances.NewDeleteInstanceParams().WithInstanceName("road-runner"))
+	assert.NoError(t, err)
+
+	err = poolClient.DeletePool(pools.NewDeletePoolParams().WithPoolID(pool.ID))
+	assert.NoError(t, err)
+
+	_, err = poolClient.GetPool(pools.NewGetPoolParams().WithPoolID(pool.ID))
+	assert.True(t, garmClient.IsNotFoundError(err))
+	assert.Empty(t, s.Pools())
+}
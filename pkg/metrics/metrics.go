@@ -13,19 +13,79 @@ const (
 	metricNamespace       = "garm_operator"
 	garmClient            = "client"
 	garmClientAPI         = "client_api_requests"
+	garmEventStream       = "event_stream"
+	pool                  = "pool"
+	runner                = "runner"
+	garmServerLabel       = "server"
+)
+
+// reasons of runners reaped by the operator
+const (
+	ReapReasonScaleDown = "scale-down"
+	ReapReasonStale     = "stale"
+	ReapReasonFault     = "fault"
 )
 
 var (
 	// GarmJwtExpiresAt is a Prometheus gauge that tracks the expiration timestamp of the JWT
-	GarmJwtExpiresAt = prometheus.NewGauge(prometheus.GaugeOpts{
-		Namespace: metricNamespace,
-		Subsystem: garmClient,
-		Name:      "jwt_expiration_timestamp_seconds",
-		Help:      "The date after which the obtained JWT expires. Expressed as a Unix Epoch Time",
-		ConstLabels: prometheus.Labels{
-			metricControllerLabel: metricControllerValue,
-		},
-	})
+	GarmJwtExpiresAt = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: metricNamespace,
+			Subsystem: garmClient,
+			Name:      "jwt_expiration_timestamp_seconds",
+			Help:      "The date after which the obtained JWT expires. Expressed as a Unix Epoch Time",
+			ConstLabels: prometheus.Labels{
+				metricControllerLabel: metricControllerValue,
+			},
+		}, []string{garmServerLabel})
+
+	// GarmTokenRefreshes is a Prometheus counter that tracks the number of logins to obtain a new JWT
+	GarmTokenRefreshes = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: metricNamespace,
+			Subsystem: garmClient,
+			Name:      "token_refreshes_total",
+			Help:      "Number of logins to obtain a new JWT",
+			ConstLabels: prometheus.Labels{
+				metricControllerLabel: metricControllerValue,
+			},
+		}, []string{garmServerLabel, "reason"})
+
+	// GarmTokenRefreshErrors is a Prometheus counter that tracks the number of failed logins to obtain a new JWT
+	GarmTokenRefreshErrors = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: metricNamespace,
+			Subsystem: garmClient,
+			Name:      "token_refresh_errors_total",
+			Help:      "Number of failed logins to obtain a new JWT",
+			ConstLabels: prometheus.Labels{
+				metricControllerLabel: metricControllerValue,
+			},
+		}, []string{garmServerLabel, "reason"})
+
+	// GarmRequestRetries is a Prometheus counter that tracks the number of retried requests to GARM
+	GarmRequestRetries = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: metricNamespace,
+			Subsystem: garmClient,
+			Name:      "request_retries_total",
+			Help:      "Number of requests to GARM which have been retried after a transient failure",
+			ConstLabels: prometheus.Labels{
+				metricControllerLabel: metricControllerValue,
+			},
+		}, []string{garmServerLabel})
+
+	// GarmCircuitBreakerOpen is a Prometheus gauge that tracks whether the circuit breaker of a GARM server is open
+	GarmCircuitBreakerOpen = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: metricNamespace,
+			Subsystem: garmClient,
+			Name:      "circuit_breaker_open",
+			Help:      "Whether the circuit breaker of the GARM server is open (1) or closed (0)",
+			ConstLabels: prometheus.Labels{
+				metricControllerLabel: metricControllerValue,
+			},
+		}, []string{garmServerLabel})
 
 	// TotalGarmCalls is a Prometheus counter that tracks the total number of GARM API calls
 	TotalGarmCalls = prometheus.NewCounterVec(
@@ -37,7 +97,7 @@ var (
 			ConstLabels: prometheus.Labels{
 				metricControllerLabel: metricControllerValue,
 			},
-		}, []string{"method"})
+		}, []string{garmServerLabel, "method"})
 
 	// GarmCallErrors is a Prometheus counter that tracks the number of GARM API calls that failed
 	GarmCallErrors = prometheus.NewCounterVec(
@@ -49,11 +109,133 @@ var (
 			ConstLabels: prometheus.Labels{
 				metricControllerLabel: metricControllerValue,
 			},
-		}, []string{"method"})
+		}, []string{garmServerLabel, "method"})
+
+	// EventStreamConnected is a Prometheus gauge that tracks if the GARM event stream is connected
+	EventStreamConnected = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: metricNamespace,
+			Subsystem: garmEventStream,
+			Name:      "connected",
+			Help:      "Whether the GARM event stream is connected (1) or not (0)",
+			ConstLabels: prometheus.Labels{
+				metricControllerLabel: metricControllerValue,
+			},
+		}, []string{garmServerLabel})
+
+	// EventStreamReconnects is a Prometheus counter that tracks the number of reconnects to the GARM event stream
+	EventStreamReconnects = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: metricNamespace,
+			Subsystem: garmEventStream,
+			Name:      "reconnects_total",
+			Help:      "Number of reconnects to the GARM event stream",
+			ConstLabels: prometheus.Labels{
+				metricControllerLabel: metricControllerValue,
+			},
+		}, []string{garmServerLabel})
+
+	// EventStreamEvents is a Prometheus counter that tracks the number of events received over the GARM event stream
+	EventStreamEvents = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: metricNamespace,
+			Subsystem: garmEventStream,
+			Name:      "events_total",
+			Help:      "Number of events received over the GARM event stream",
+			ConstLabels: prometheus.Labels{
+				metricControllerLabel: metricControllerValue,
+			},
+		}, []string{garmServerLabel, "entity_type", "operation"})
+
+	// PoolDrifts is a Prometheus counter that tracks the number of fields of GARM pools which differ from their Pool spec
+	PoolDrifts = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: metricNamespace,
+			Subsystem: pool,
+			Name:      "drifts_total",
+			Help:      "Number of detected fields of GARM pools which differ from their Pool spec",
+			ConstLabels: prometheus.Labels{
+				metricControllerLabel: metricControllerValue,
+			},
+		}, []string{"namespace", "pool", "field", "policy"})
+
+	// PoolRunners is a Prometheus gauge that tracks the number of runners of a pool by their state
+	PoolRunners = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: metricNamespace,
+			Subsystem: pool,
+			Name:      "runners",
+			Help:      "Number of runners of a pool by their state",
+			ConstLabels: prometheus.Labels{
+				metricControllerLabel: metricControllerValue,
+			},
+		}, []string{"namespace", "pool", "scope_kind", "scope_name", "provider", "state"})
+
+	// PoolManagerUp is a Prometheus gauge that tracks whether the GARM pool manager of an enterprise, organization or repository is running
+	PoolManagerUp = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: metricNamespace,
+			Name:      "pool_manager_up",
+			Help:      "Whether the GARM pool manager of an enterprise, organization or repository is running (1) or not (0)",
+			ConstLabels: prometheus.Labels{
+				metricControllerLabel: metricControllerValue,
+			},
+		}, []string{"namespace", "scope_kind", "scope_name"})
+
+	// RunnerBootstrapDuration is a Prometheus histogram that tracks the time runners take from their creation until they are idle
+	RunnerBootstrapDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: metricNamespace,
+			Subsystem: runner,
+			Name:      "bootstrap_duration_seconds",
+			Help:      "Time runners take from their creation until they are idle",
+			Buckets:   prometheus.ExponentialBuckets(15, 2, 10),
+			ConstLabels: prometheus.Labels{
+				metricControllerLabel: metricControllerValue,
+			},
+		}, []string{"namespace", "pool", "provider"})
+
+	// RunnerIdleDuration is a Prometheus histogram that tracks the time runners are idle until they pick up a job or get deleted
+	RunnerIdleDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: metricNamespace,
+			Subsystem: runner,
+			Name:      "idle_duration_seconds",
+			Help:      "Time runners are idle until they pick up a job or get deleted",
+			Buckets:   prometheus.ExponentialBuckets(60, 2, 12),
+			ConstLabels: prometheus.Labels{
+				metricControllerLabel: metricControllerValue,
+			},
+		}, []string{"namespace", "pool", "provider"})
+
+	// RunnersReaped is a Prometheus counter that tracks the number of runners deleted by the operator
+	RunnersReaped = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: metricNamespace,
+			Subsystem: runner,
+			Name:      "reaped_total",
+			Help:      "Number of runners deleted by the operator",
+			ConstLabels: prometheus.Labels{
+				metricControllerLabel: metricControllerValue,
+			},
+		}, []string{"namespace", "pool", "reason"})
 )
 
 func init() {
 	metrics.Registry.MustRegister(GarmJwtExpiresAt)
+	metrics.Registry.MustRegister(GarmTokenRefreshes)
+	metrics.Registry.MustRegister(GarmTokenRefreshErrors)
+	metrics.Registry.MustRegister(GarmRequestRetries)
+	metrics.Registry.MustRegister(GarmCircuitBreakerOpen)
 	metrics.Registry.MustRegister(TotalGarmCalls)
 	metrics.Registry.MustRegister(GarmCallErrors)
+	metrics.Registry.MustRegister(EventStreamConnected)
+	metrics.Registry.MustRegister(EventStreamReconnects)
+	metrics.Registry.MustRegister(EventStreamEvents)
+	metrics.Registry.MustRegister(PoolDrifts)
+	metrics.Registry.MustRegister(PoolRunners)
+	metrics.Registry.MustRegister(PoolManagerUp)
+	metrics.Registry.MustRegister(RunnerBootstrapDuration)
+	metrics.Registry.MustRegister(RunnerIdleDuration)
+	metrics.Registry.MustRegister(RunnersReaped)
 }
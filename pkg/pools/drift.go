@@ -0,0 +1,70 @@
+// SPDX-License-Identifier: MIT
+
+package pools
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/cloudbase/garm/params"
+)
+
+// FieldDrift is a field of a garm pool whose value differs from the value derived from the Pool spec
+type FieldDrift struct {
+	// Field is the json name of the field
+	Field string
+	// Expected is the json encoded value derived from the Pool spec
+	Expected string
+	// Actual is the json encoded value of the pool in garm
+	Actual string
+}
+
+func (d FieldDrift) String() string {
+	return fmt.Sprintf("%s: expected %s, actual %s", d.Field, d.Expected, d.Actual)
+}
+
+// Diff returns all fields which differ between the expected and the actual garm pool
+func Diff(expected, actual params.Pool) []FieldDrift {
+	return diff(reflect.ValueOf(expected), reflect.ValueOf(actual))
+}
+
+// DriftedFields returns the field names of the given drifts
+func DriftedFields(drifts []FieldDrift) []string {
+	fields := make([]string, 0, len(drifts))
+	for _, d := range drifts {
+		fields = append(fields, d.Field)
+	}
+	return fields
+}
+
+func diff(expected, actual reflect.Value) []FieldDrift {
+	drifts := []FieldDrift{}
+	for i := range expected.NumField() {
+		field := expected.Type().Field(i)
+		if field.Anonymous && field.Type.Kind() == reflect.Struct {
+			drifts = append(drifts, diff(expected.Field(i), actual.Field(i))...)
+			continue
+		}
+
+		expectedValue, actualValue := expected.Field(i).Interface(), actual.Field(i).Interface()
+		if !reflect.DeepEqual(expectedValue, actualValue) {
+			name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+			drifts = append(drifts, FieldDrift{
+				Field:    name,
+				Expected: encode(expectedValue),
+				Actual:   encode(actualValue),
+			})
+		}
+	}
+	return drifts
+}
+
+func encode(v interface{}) string {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprintf("%v", v)
+	}
+	return string(b)
+}
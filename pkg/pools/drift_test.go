@@ -0,0 +1,59 @@
+// SPDX-License-Identifier: MIT
+
+package pools
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/cloudbase/garm/params"
+)
+
+func TestDiff(t *testing.T) {
+	tests := []struct {
+		name     string
+		expected params.Pool
+		actual   params.Pool
+		want     []FieldDrift
+	}{
+		{
+			name:     "no drift",
+			expected: params.Pool{ID: "pool-id", MaxRunners: 5, Tags: []params.Tag{{Name: "linux"}}},
+			actual:   params.Pool{ID: "pool-id", MaxRunners: 5, Tags: []params.Tag{{Name: "linux"}}},
+			want:     []FieldDrift{},
+		},
+		{
+			name: "drift in embedded and regular fields",
+			expected: params.Pool{
+				RunnerPrefix: params.RunnerPrefix{Prefix: "road-runner"},
+				MaxRunners:   5,
+				ExtraSpecs:   json.RawMessage(`{"cpu":2}`),
+			},
+			actual: params.Pool{
+				RunnerPrefix: params.RunnerPrefix{Prefix: "garm"},
+				MaxRunners:   3,
+			},
+			want: []FieldDrift{
+				{Field: "runner_prefix", Expected: `"road-runner"`, Actual: `"garm"`},
+				{Field: "max_runners", Expected: "5", Actual: "3"},
+				{Field: "extra_specs", Expected: `{"cpu":2}`, Actual: "null"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Diff(tt.expected, tt.actual); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Diff() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDriftedFields(t *testing.T) {
+	drifts := []FieldDrift{{Field: "max_runners"}, {Field: "tags"}}
+	want := []string{"max_runners", "tags"}
+	if got := DriftedFields(drifts); !reflect.DeepEqual(got, want) {
+		t.Errorf("DriftedFields() = %v, want %v", got, want)
+	}
+}
@@ -20,6 +20,9 @@ import (
 	"github.com/mercedes-benz/garm-operator/pkg/filter"
 )
 
+// ErrDuplicatePool is returned if a pool can't be bound to a single garm pool
+var ErrDuplicatePool = errors.New("duplicate garm pool")
+
 func GetGarmPoolBySpecs(ctx context.Context, garmClient garmClient.PoolClient, pool *garmoperatorv1beta1.Pool, image *garmoperatorv1beta1.Image, gitHubScopeRef garmoperatorv1beta1.GitHubScope) (*params.Pool, error) {
 	log := log.FromContext(ctx)
 	log.Info("Getting existing garm pools by pool.spec")
@@ -51,10 +54,9 @@ func GetGarmPoolBySpecs(ctx context.Context, garmClient garmClient.PoolClient, p
 		"githubScopeRefName", githubScopeRefName,
 	).Info(fmt.Sprintf("%d garm pools with same spec found", len(filteredGarmPools)))
 
-	//nolint TODO: @rafalgalaw - can this happen?
-	// i guess it's blocked by the fact that we can't create a pool with the same spec on garm side
+	// this can happen if the pools were created in garm directly
 	if len(filteredGarmPools) > 1 {
-		return nil, errors.New("can not create pool, multiple instances matching flavor, image and provider found in garm")
+		return nil, fmt.Errorf("%w: %d garm pools matching scope, image, flavor and provider found", ErrDuplicatePool, len(filteredGarmPools))
 	}
 
 	// pool with the same specs already exists
@@ -67,18 +69,41 @@ func GetGarmPoolBySpecs(ctx context.Context, garmClient garmClient.PoolClient, p
 	return nil, nil
 }
 
+// GetGarmPoolByID returns the garm pool with the given ID if it belongs to the given GitHub scope
+func GetGarmPoolByID(ctx context.Context, garmClient garmClient.PoolClient, poolID string, gitHubScopeRef garmoperatorv1beta1.GitHubScope) (*params.Pool, error) {
+	log := log.FromContext(ctx)
+	log.Info("Getting existing garm pool by id", "id", poolID)
+
+	scope, err := garmoperatorv1beta1.ToGitHubScopeKind(gitHubScopeRef.GetKind())
+	if err != nil {
+		return nil, err
+	}
+
+	garmPool, err := garmClient.GetPool(pools.NewGetPoolParams().WithPoolID(poolID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get garm pool %s: %w", poolID, err)
+	}
+
+	if !MatchesGitHubScope(scope, gitHubScopeRef.GetID())(garmPool.Payload) {
+		return nil, fmt.Errorf("garm pool %s doesn't belong to %s %s", poolID, scope, gitHubScopeRef.GetName())
+	}
+
+	return &garmPool.Payload, nil
+}
+
 func UpdatePool(ctx context.Context, garmClient garmClient.PoolClient, pool *garmoperatorv1beta1.Pool, image *garmoperatorv1beta1.Image) error {
 	log := log.FromContext(ctx).
 		WithName("UpdatePool")
 
 	log.Info("updating pool", "pool", pool.Name, "id", pool.Status.ID)
 
+	minIdleRunners := pool.GarmMinIdleRunners()
 	poolParams := params.UpdatePoolParams{
 		RunnerPrefix: params.RunnerPrefix{
 			Prefix: pool.Spec.RunnerPrefix,
 		},
 		MaxRunners:             &pool.Spec.MaxRunners,
-		MinIdleRunners:         &pool.Spec.MinIdleRunners,
+		MinIdleRunners:         &minIdleRunners,
 		Flavor:                 pool.Spec.Flavor,
 		OSType:                 pool.Spec.OSType,
 		OSArch:                 pool.Spec.OSArch,
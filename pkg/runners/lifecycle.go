@@ -0,0 +1,139 @@
+// SPDX-License-Identifier: MIT
+
+package runners
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/cloudbase/garm/params"
+
+	garmoperatorv1beta1 "github.com/mercedes-benz/garm-operator/api/v1beta1"
+)
+
+// JobTracker counts the distinct jobs each runner has been observed running.
+// As garm only exposes the current job of a runner, jobs are counted by observing the runners over time.
+type JobTracker struct {
+	mu      sync.Mutex
+	runners map[string]*runnerJobs
+}
+
+type runnerJobs struct {
+	poolID string
+	jobIDs map[int64]struct{}
+}
+
+// NewJobTracker returns an empty JobTracker
+func NewJobTracker() *JobTracker {
+	return &JobTracker{
+		runners: map[string]*runnerJobs{},
+	}
+}
+
+// Observe records the current jobs of the given runners
+func (t *JobTracker) Observe(instances ...params.Instance) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for _, runner := range instances {
+		if runner.Job == nil || runner.Job.ID == 0 {
+			continue
+		}
+		if t.runners[runner.Name] == nil {
+			t.runners[runner.Name] = &runnerJobs{poolID: runner.PoolID, jobIDs: map[int64]struct{}{}}
+		}
+		t.runners[runner.Name].jobIDs[runner.Job.ID] = struct{}{}
+	}
+}
+
+// Jobs returns the number of distinct jobs the runner has been observed running
+func (t *JobTracker) Jobs(name string) int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.runners[name] == nil {
+		return 0
+	}
+	return len(t.runners[name].jobIDs)
+}
+
+// Forget removes the observed jobs of all runners of the given pool which aren't part of the given runners anymore
+func (t *JobTracker) Forget(poolID string, instances []params.Instance) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	existing := make(map[string]bool, len(instances))
+	for _, runner := range instances {
+		existing[runner.Name] = true
+	}
+
+	for name, runner := range t.runners {
+		if runner.poolID == poolID && !existing[name] {
+			delete(t.runners, name)
+		}
+	}
+}
+
+// CreatedAt returns the time the runner has been created, which is the time of its first status message.
+// The second return value is false if the runner hasn't sent any status message yet.
+func CreatedAt(runner params.Instance) (time.Time, bool) {
+	if len(runner.StatusMessages) == 0 {
+		return time.Time{}, false
+	}
+
+	createdAt := runner.StatusMessages[0].CreatedAt
+	for _, msg := range runner.StatusMessages[1:] {
+		if msg.CreatedAt.Before(createdAt) {
+			createdAt = msg.CreatedAt
+		}
+	}
+	return createdAt, true
+}
+
+// ExpiredRunners returns the idle runners which exceed the given lifecycle, the oldest ones first.
+// Runners which are running a job are never returned, they expire as soon as they are idle again.
+func ExpiredRunners(lifecycle *garmoperatorv1beta1.RunnerLifecycle, idleRunners []params.Instance, jobs *JobTracker) []params.Instance {
+	expiredRunners := []params.Instance{}
+	if lifecycle == nil {
+		return expiredRunners
+	}
+
+	for _, runner := range idleRunners {
+		createdAt, created := CreatedAt(runner)
+		switch {
+		case lifecycle.MaxIdleAge != nil && time.Since(runner.UpdatedAt) > lifecycle.MaxIdleAge.Duration,
+			lifecycle.MaxLifetime != nil && created && time.Since(createdAt) > lifecycle.MaxLifetime.Duration,
+			lifecycle.MaxJobs > 0 && jobs != nil && jobs.Jobs(runner.Name) >= int(lifecycle.MaxJobs):
+			expiredRunners = append(expiredRunners, runner)
+		}
+	}
+
+	sort.SliceStable(expiredRunners, func(i, j int) bool {
+		return expiredRunners[i].UpdatedAt.Before(expiredRunners[j].UpdatedAt)
+	})
+
+	return expiredRunners
+}
+
+// RecyclableRunners returns the batch of expired runners which can be recycled right now.
+// A new batch is only recycled if the pool has no pending runners, so the replacements of the previous batch are up
+// before the next runners are deleted. The batch never drops the idle runners of the pool below minIdleRunners.
+func RecyclableRunners(batchSize, minIdleRunners int, counts RunnerCounts, expiredRunners []params.Instance) []params.Instance {
+	batchSize = min(batchSize, counts.Idle-minIdleRunners)
+	if counts.Pending > 0 || batchSize <= 0 {
+		return []params.Instance{}
+	}
+
+	if len(expiredRunners) > batchSize {
+		return expiredRunners[:batchSize]
+	}
+	return expiredRunners
+}
+
+// RecycleSurge returns the number of idle runners garm has to keep on top of minIdleRunners,
+// so that a batch of runners can be recycled without dropping below minIdleRunners.
+// The surge is limited by maxRunners, a pool which can't surge can only recycle its surplus idle runners.
+func RecycleSurge(batchSize, minIdleRunners, maxRunners int) int {
+	return max(min(batchSize, maxRunners-minIdleRunners), 0)
+}
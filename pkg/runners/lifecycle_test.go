@@ -0,0 +1,244 @@
+// SPDX-License-Identifier: MIT
+
+package runners
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/cloudbase/garm/params"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	garmoperatorv1beta1 "github.com/mercedes-benz/garm-operator/api/v1beta1"
+)
+
+func TestJobTracker(t *testing.T) {
+	jobs := NewJobTracker()
+
+	jobs.Observe(
+		params.Instance{Name: "runner1", PoolID: "pool1", Job: &params.Job{ID: 1}},
+		params.Instance{Name: "runner2", PoolID: "pool1"},
+	)
+	// the same job is only counted once
+	jobs.Observe(params.Instance{Name: "runner1", PoolID: "pool1", Job: &params.Job{ID: 1}})
+	jobs.Observe(params.Instance{Name: "runner1", PoolID: "pool1", Job: &params.Job{ID: 2}})
+	jobs.Observe(params.Instance{Name: "runner3", PoolID: "pool2", Job: &params.Job{ID: 3}})
+
+	if got := jobs.Jobs("runner1"); got != 2 {
+		t.Errorf("Jobs(runner1) = %d, want 2", got)
+	}
+	if got := jobs.Jobs("runner2"); got != 0 {
+		t.Errorf("Jobs(runner2) = %d, want 0", got)
+	}
+
+	// runner1 is gone, runner3 belongs to another pool
+	jobs.Forget("pool1", []params.Instance{{Name: "runner2"}})
+
+	if got := jobs.Jobs("runner1"); got != 0 {
+		t.Errorf("Jobs(runner1) after Forget = %d, want 0", got)
+	}
+	if got := jobs.Jobs("runner3"); got != 1 {
+		t.Errorf("Jobs(runner3) after Forget = %d, want 1", got)
+	}
+}
+
+func TestExpiredRunners(t *testing.T) {
+	now := time.Now()
+	jobs := NewJobTracker()
+	jobs.Observe(
+		params.Instance{Name: "busy-runner", Job: &params.Job{ID: 1}},
+		params.Instance{Name: "busy-runner", Job: &params.Job{ID: 2}},
+	)
+
+	idleRunners := []params.Instance{
+		{
+			Name:      "fresh-runner",
+			UpdatedAt: now.Add(-5 * time.Minute),
+			StatusMessages: []params.StatusMessage{
+				{CreatedAt: now.Add(-10 * time.Minute)},
+			},
+		},
+		{
+			Name:      "old-runner",
+			UpdatedAt: now.Add(-10 * time.Minute),
+			StatusMessages: []params.StatusMessage{
+				{CreatedAt: now.Add(-1 * time.Hour)},
+				{CreatedAt: now.Add(-25 * time.Hour)},
+			},
+		},
+		{
+			Name:      "long-idle-runner",
+			UpdatedAt: now.Add(-3 * time.Hour),
+		},
+		{
+			Name:      "busy-runner",
+			UpdatedAt: now.Add(-1 * time.Minute),
+		},
+	}
+
+	tests := []struct {
+		name      string
+		lifecycle *garmoperatorv1beta1.RunnerLifecycle
+		want      []string
+	}{
+		{
+			name:      "no lifecycle",
+			lifecycle: nil,
+			want:      []string{},
+		},
+		{
+			name: "max idle age",
+			lifecycle: &garmoperatorv1beta1.RunnerLifecycle{
+				MaxIdleAge: &metav1.Duration{Duration: 2 * time.Hour},
+			},
+			want: []string{"long-idle-runner"},
+		},
+		{
+			name: "max lifetime ignores runners without status messages",
+			lifecycle: &garmoperatorv1beta1.RunnerLifecycle{
+				MaxLifetime: &metav1.Duration{Duration: 24 * time.Hour},
+			},
+			want: []string{"old-runner"},
+		},
+		{
+			name: "max jobs",
+			lifecycle: &garmoperatorv1beta1.RunnerLifecycle{
+				MaxJobs: 2,
+			},
+			want: []string{"busy-runner"},
+		},
+		{
+			name: "all policies - oldest runners first",
+			lifecycle: &garmoperatorv1beta1.RunnerLifecycle{
+				MaxIdleAge:  &metav1.Duration{Duration: 2 * time.Hour},
+				MaxLifetime: &metav1.Duration{Duration: 24 * time.Hour},
+				MaxJobs:     2,
+			},
+			want: []string{"long-idle-runner", "old-runner", "busy-runner"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := []string{}
+			for _, runner := range ExpiredRunners(tt.lifecycle, idleRunners, jobs) {
+				got = append(got, runner.Name)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ExpiredRunners() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRecyclableRunners(t *testing.T) {
+	expiredRunners := []params.Instance{
+		{Name: "runner1"},
+		{Name: "runner2"},
+		{Name: "runner3"},
+	}
+
+	tests := []struct {
+		name           string
+		batchSize      int
+		minIdleRunners int
+		counts         RunnerCounts
+		want           []params.Instance
+	}{
+		{
+			name:           "batch of expired runners",
+			batchSize:      2,
+			minIdleRunners: 3,
+			counts:         RunnerCounts{Idle: 5, Total: 5},
+			want:           expiredRunners[:2],
+		},
+		{
+			name:           "batch larger than expired runners",
+			batchSize:      5,
+			minIdleRunners: 3,
+			counts:         RunnerCounts{Idle: 8, Total: 8},
+			want:           expiredRunners,
+		},
+		{
+			name:           "batch limited by surplus idle runners",
+			batchSize:      2,
+			minIdleRunners: 3,
+			counts:         RunnerCounts{Idle: 4, Total: 4},
+			want:           expiredRunners[:1],
+		},
+		{
+			name:           "idle runners equal min idle runners",
+			batchSize:      2,
+			minIdleRunners: 3,
+			counts:         RunnerCounts{Idle: 3, Total: 3},
+			want:           []params.Instance{},
+		},
+		{
+			name:           "single idle runner equals min idle runners",
+			batchSize:      1,
+			minIdleRunners: 1,
+			counts:         RunnerCounts{Idle: 1, Total: 1},
+			want:           []params.Instance{},
+		},
+		{
+			name:           "previous batch not replaced yet",
+			batchSize:      2,
+			minIdleRunners: 3,
+			counts:         RunnerCounts{Idle: 2, Pending: 1, Total: 3},
+			want:           []params.Instance{},
+		},
+		{
+			name:           "less idle runners than min idle runners",
+			batchSize:      2,
+			minIdleRunners: 4,
+			counts:         RunnerCounts{Idle: 3, Total: 3},
+			want:           []params.Instance{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := RecyclableRunners(tt.batchSize, tt.minIdleRunners, tt.counts, expiredRunners); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("RecyclableRunners() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRecycleSurge(t *testing.T) {
+	tests := []struct {
+		name           string
+		batchSize      int
+		minIdleRunners int
+		maxRunners     int
+		want           int
+	}{
+		{
+			name:           "surge by batch size",
+			batchSize:      2,
+			minIdleRunners: 1,
+			maxRunners:     5,
+			want:           2,
+		},
+		{
+			name:           "surge limited by max runners",
+			batchSize:      3,
+			minIdleRunners: 4,
+			maxRunners:     5,
+			want:           1,
+		},
+		{
+			name:           "no surge if min idle runners equal max runners",
+			batchSize:      2,
+			minIdleRunners: 5,
+			maxRunners:     5,
+			want:           0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := RecycleSurge(tt.batchSize, tt.minIdleRunners, tt.maxRunners); got != tt.want {
+				t.Errorf("RecycleSurge() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
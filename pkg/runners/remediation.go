@@ -0,0 +1,63 @@
+// SPDX-License-Identifier: MIT
+
+package runners
+
+import (
+	"time"
+
+	garmProviderParams "github.com/cloudbase/garm-provider-common/params"
+	"github.com/cloudbase/garm/params"
+)
+
+// DefaultRunnerBootstrapTimeout is the bootstrap timeout garm applies to pools which don't define one
+const DefaultRunnerBootstrapTimeout = 20 * time.Minute
+
+// FaultyRunners returns a list of runners which report a fault of their provider and aren't being deleted yet
+func FaultyRunners(instances []params.Instance) []params.Instance {
+	faultyRunners := []params.Instance{}
+
+	for _, runner := range instances {
+		if len(runner.ProviderFault) == 0 {
+			continue
+		}
+
+		switch runner.Status {
+		case garmProviderParams.InstancePendingDelete, garmProviderParams.InstancePendingForceDelete, garmProviderParams.InstanceDeleting:
+		default:
+			faultyRunners = append(faultyRunners, runner)
+		}
+	}
+
+	return faultyRunners
+}
+
+// StuckRunners returns a list of runners which are still being created or bootstrapped after the bootstrap timeout.
+// Runners which report a provider fault are not part of the list, as they are returned by FaultyRunners.
+func StuckRunners(bootstrapTimeout time.Duration, instances []params.Instance) []params.Instance {
+	stuckRunners := []params.Instance{}
+
+	for _, runner := range instances {
+		if len(runner.ProviderFault) > 0 {
+			continue
+		}
+
+		switch {
+		case runner.Status == garmProviderParams.InstancePendingCreate,
+			runner.Status == garmProviderParams.InstanceCreating,
+			runner.RunnerStatus == params.RunnerPending,
+			runner.RunnerStatus == params.RunnerInstalling:
+		default:
+			continue
+		}
+
+		createdAt, ok := CreatedAt(runner)
+		if !ok {
+			createdAt = runner.UpdatedAt
+		}
+		if time.Since(createdAt) > bootstrapTimeout {
+			stuckRunners = append(stuckRunners, runner)
+		}
+	}
+
+	return stuckRunners
+}
@@ -0,0 +1,87 @@
+// SPDX-License-Identifier: MIT
+
+package runners
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	garmProviderParams "github.com/cloudbase/garm-provider-common/params"
+	"github.com/cloudbase/garm/params"
+)
+
+func TestFaultyRunners(t *testing.T) {
+	instances := []params.Instance{
+		{
+			Name:   "healthy-runner",
+			Status: garmProviderParams.InstanceRunning,
+		},
+		{
+			Name:          "faulty-runner",
+			Status:        garmProviderParams.InstanceError,
+			ProviderFault: []byte("quota exceeded"),
+		},
+		{
+			Name:          "deleting-faulty-runner",
+			Status:        garmProviderParams.InstancePendingDelete,
+			ProviderFault: []byte("quota exceeded"),
+		},
+	}
+
+	got := []string{}
+	for _, runner := range FaultyRunners(instances) {
+		got = append(got, runner.Name)
+	}
+	want := []string{"faulty-runner"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("FaultyRunners() = %v, want %v", got, want)
+	}
+}
+
+func TestStuckRunners(t *testing.T) {
+	now := time.Now()
+	instances := []params.Instance{
+		{
+			Name:         "idle-runner",
+			Status:       garmProviderParams.InstanceRunning,
+			RunnerStatus: params.RunnerIdle,
+			UpdatedAt:    now.Add(-1 * time.Hour),
+		},
+		{
+			Name:      "creating-runner",
+			Status:    garmProviderParams.InstanceCreating,
+			UpdatedAt: now.Add(-5 * time.Minute),
+		},
+		{
+			Name:      "stuck-pending-create-runner",
+			Status:    garmProviderParams.InstancePendingCreate,
+			UpdatedAt: now.Add(-1 * time.Hour),
+		},
+		{
+			Name:         "stuck-installing-runner",
+			Status:       garmProviderParams.InstanceRunning,
+			RunnerStatus: params.RunnerInstalling,
+			UpdatedAt:    now.Add(-1 * time.Minute),
+			StatusMessages: []params.StatusMessage{
+				{CreatedAt: now.Add(-40 * time.Minute)},
+				{CreatedAt: now.Add(-1 * time.Minute)},
+			},
+		},
+		{
+			Name:          "faulty-pending-create-runner",
+			Status:        garmProviderParams.InstancePendingCreate,
+			UpdatedAt:     now.Add(-1 * time.Hour),
+			ProviderFault: []byte("quota exceeded"),
+		},
+	}
+
+	got := []string{}
+	for _, runner := range StuckRunners(30*time.Minute, instances) {
+		got = append(got, runner.Name)
+	}
+	want := []string{"stuck-pending-create-runner", "stuck-installing-runner"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("StuckRunners() = %v, want %v", got, want)
+	}
+}
@@ -4,6 +4,7 @@ package runners
 
 import (
 	"context"
+	"strings"
 	"time"
 
 	garmProviderParams "github.com/cloudbase/garm-provider-common/params"
@@ -50,6 +51,76 @@ func IdleRunners(ctx context.Context, instances []params.Instance) []params.Inst
 	return idleRunners
 }
 
+// ActiveRunners returns a list of runners that are in github state active, which means they are running a job
+func ActiveRunners(instances []params.Instance) []params.Instance {
+	activeRunners := []params.Instance{}
+
+	for _, runner := range instances {
+		if runner.RunnerStatus == params.RunnerActive {
+			activeRunners = append(activeRunners, runner)
+		}
+	}
+
+	return activeRunners
+}
+
+// InactiveRunners returns a list of runners that are not running a job
+func InactiveRunners(instances []params.Instance) []params.Instance {
+	inactiveRunners := []params.Instance{}
+
+	for _, runner := range instances {
+		if runner.RunnerStatus != params.RunnerActive {
+			inactiveRunners = append(inactiveRunners, runner)
+		}
+	}
+
+	return inactiveRunners
+}
+
+// ExcludeRunners returns a list of runners without the runners with the given (lowercase) names
+func ExcludeRunners(instances []params.Instance, names map[string]bool) []params.Instance {
+	runners := []params.Instance{}
+
+	for _, runner := range instances {
+		if !names[strings.ToLower(runner.Name)] {
+			runners = append(runners, runner)
+		}
+	}
+
+	return runners
+}
+
+// RunnerCounts is the number of runners of a pool by their state
+type RunnerCounts struct {
+	Idle    int
+	Active  int
+	Pending int
+	Failed  int
+	Total   int
+}
+
+// CountRunners returns the number of runners by their state.
+// Runners which are stopped or about to be deleted are only part of the total count.
+func CountRunners(instances []params.Instance) RunnerCounts {
+	counts := RunnerCounts{Total: len(instances)}
+
+	for _, runner := range instances {
+		switch {
+		case runner.RunnerStatus == params.RunnerIdle:
+			counts.Idle++
+		case runner.RunnerStatus == params.RunnerActive:
+			counts.Active++
+		case runner.RunnerStatus == params.RunnerFailed, runner.Status == garmProviderParams.InstanceError:
+			counts.Failed++
+		case runner.RunnerStatus == params.RunnerPending, runner.RunnerStatus == params.RunnerInstalling,
+			runner.Status == garmProviderParams.InstancePendingCreate, runner.Status == garmProviderParams.InstanceCreating:
+			counts.Pending++
+		}
+	}
+
+	return counts
+}
+
 // OldIdleRunners returns a list of runners that are older than minRunnerAge
 func OldIdleRunners(minRunnerAge time.Duration, instances []params.Instance) []params.Instance {
 	oldIdleRunners := []params.Instance{}
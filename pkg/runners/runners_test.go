@@ -171,6 +171,59 @@ func TestExtractIdleRunners(t *testing.T) {
 	}
 }
 
+func TestExtractActiveRunners(t *testing.T) {
+	instances := []params.Instance{
+		{
+			RunnerStatus: params.RunnerActive,
+			Status:       garmProviderParams.InstanceRunning,
+			Name:         "runner1",
+		},
+		{
+			RunnerStatus: params.RunnerInstalling,
+			Status:       garmProviderParams.InstanceRunning,
+			Name:         "runner2",
+		},
+		{
+			RunnerStatus: params.RunnerIdle,
+			Status:       garmProviderParams.InstanceRunning,
+			Name:         "runner3",
+		},
+	}
+
+	tests := []struct {
+		name         string
+		instances    []params.Instance
+		wantActive   []params.Instance
+		wantInactive []params.Instance
+	}{
+		{
+			name:         "no runners",
+			instances:    []params.Instance{},
+			wantActive:   []params.Instance{},
+			wantInactive: []params.Instance{},
+		},
+		{
+			name:       "active and inactive runners",
+			instances:  instances,
+			wantActive: []params.Instance{instances[0]},
+			wantInactive: []params.Instance{
+				instances[1],
+				instances[2],
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ActiveRunners(tt.instances); !reflect.DeepEqual(got, tt.wantActive) {
+				t.Errorf("ActiveRunners() = %v, want %v", got, tt.wantActive)
+			}
+			if got := InactiveRunners(tt.instances); !reflect.DeepEqual(got, tt.wantInactive) {
+				t.Errorf("InactiveRunners() = %v, want %v", got, tt.wantInactive)
+			}
+		})
+	}
+}
+
 func TestExtractDeletableRunners(t *testing.T) {
 	type args struct {
 		ctx       context.Context
@@ -563,3 +616,83 @@ func TestRunnersToDelete(t *testing.T) {
 		})
 	}
 }
+
+func TestCountRunners(t *testing.T) {
+	tests := []struct {
+		name      string
+		instances []params.Instance
+		want      RunnerCounts
+	}{
+		{
+			name:      "no runners",
+			instances: []params.Instance{},
+			want:      RunnerCounts{},
+		},
+		{
+			name: "runners in all states",
+			instances: []params.Instance{
+				{
+					Name:         "idle-runner",
+					Status:       garmProviderParams.InstanceRunning,
+					RunnerStatus: params.RunnerIdle,
+				},
+				{
+					Name:         "active-runner",
+					Status:       garmProviderParams.InstanceRunning,
+					RunnerStatus: params.RunnerActive,
+				},
+				{
+					Name:         "installing-runner",
+					Status:       garmProviderParams.InstanceRunning,
+					RunnerStatus: params.RunnerInstalling,
+				},
+				{
+					Name:   "creating-runner",
+					Status: garmProviderParams.InstanceCreating,
+				},
+				{
+					Name:         "failed-runner",
+					Status:       garmProviderParams.InstanceRunning,
+					RunnerStatus: params.RunnerFailed,
+				},
+				{
+					Name:   "error-runner",
+					Status: garmProviderParams.InstanceError,
+				},
+				{
+					Name:         "deleting-runner",
+					Status:       garmProviderParams.InstanceDeleting,
+					RunnerStatus: params.RunnerTerminated,
+				},
+			},
+			want: RunnerCounts{
+				Idle:    1,
+				Active:  1,
+				Pending: 2,
+				Failed:  2,
+				Total:   7,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CountRunners(tt.instances); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("CountRunners() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExcludeRunners(t *testing.T) {
+	instances := []params.Instance{
+		{Name: "Runner-1"},
+		{Name: "runner-2"},
+		{Name: "runner-3"},
+	}
+
+	got := ExcludeRunners(instances, map[string]bool{"runner-1": true, "runner-3": true})
+	want := []params.Instance{{Name: "runner-2"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ExcludeRunners() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,194 @@
+// SPDX-License-Identifier: MIT
+
+package schedule
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Cron is a parsed standard cron expression with the five fields
+// minute, hour, day of month, month and day of week.
+type Cron struct {
+	minute uint64
+	hour   uint64
+	dom    uint64
+	month  uint64
+	dow    uint64
+
+	// domRestricted and dowRestricted are used to follow the cron semantic where a
+	// day matches if either day of month or day of week matches, when both are restricted.
+	domRestricted bool
+	dowRestricted bool
+}
+
+type bounds struct {
+	min, max uint
+}
+
+var (
+	minuteBounds = bounds{0, 59}
+	hourBounds   = bounds{0, 23}
+	domBounds    = bounds{1, 31}
+	monthBounds  = bounds{1, 12}
+	dowBounds    = bounds{0, 7}
+)
+
+// ParseCron parses a cron expression like "0 7 * * 1-5".
+// Each field supports "*", single values, ranges ("1-5"), lists ("1,3,5") and steps ("*/15", "0-30/10").
+// Day of week accepts 0 and 7 as sunday.
+func ParseCron(spec string) (*Cron, error) {
+	fields := strings.Fields(spec)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("expected 5 fields in cron expression %q, got %d", spec, len(fields))
+	}
+
+	var err error
+	c := &Cron{}
+
+	if c.minute, err = parseField(fields[0], minuteBounds); err != nil {
+		return nil, fmt.Errorf("invalid minute field: %w", err)
+	}
+	if c.hour, err = parseField(fields[1], hourBounds); err != nil {
+		return nil, fmt.Errorf("invalid hour field: %w", err)
+	}
+	if c.dom, err = parseField(fields[2], domBounds); err != nil {
+		return nil, fmt.Errorf("invalid day of month field: %w", err)
+	}
+	if c.month, err = parseField(fields[3], monthBounds); err != nil {
+		return nil, fmt.Errorf("invalid month field: %w", err)
+	}
+	if c.dow, err = parseField(fields[4], dowBounds); err != nil {
+		return nil, fmt.Errorf("invalid day of week field: %w", err)
+	}
+
+	// sunday can be specified as 0 or 7
+	if c.dow&(1<<7) != 0 {
+		c.dow |= 1
+	}
+
+	c.domRestricted = fields[2] != "*"
+	c.dowRestricted = fields[4] != "*"
+
+	return c, nil
+}
+
+func parseField(field string, b bounds) (uint64, error) {
+	var bits uint64
+	for _, part := range strings.Split(field, ",") {
+		rangeBits, err := parseRange(part, b)
+		if err != nil {
+			return 0, err
+		}
+		bits |= rangeBits
+	}
+	return bits, nil
+}
+
+func parseRange(expr string, b bounds) (uint64, error) {
+	rangeExpr, stepExpr, hasStep := strings.Cut(expr, "/")
+
+	step := uint(1)
+	if hasStep {
+		s, err := strconv.ParseUint(stepExpr, 10, 8)
+		if err != nil || s == 0 {
+			return 0, fmt.Errorf("invalid step %q", stepExpr)
+		}
+		step = uint(s)
+	}
+
+	var start, end uint
+	switch {
+	case rangeExpr == "*":
+		start, end = b.min, b.max
+	case strings.Contains(rangeExpr, "-"):
+		lowExpr, highExpr, _ := strings.Cut(rangeExpr, "-")
+		low, err := parseValue(lowExpr, b)
+		if err != nil {
+			return 0, err
+		}
+		high, err := parseValue(highExpr, b)
+		if err != nil {
+			return 0, err
+		}
+		if low > high {
+			return 0, fmt.Errorf("invalid range %q", rangeExpr)
+		}
+		start, end = low, high
+	default:
+		value, err := parseValue(rangeExpr, b)
+		if err != nil {
+			return 0, err
+		}
+		start, end = value, value
+		// "5/10" means every 10th value starting at 5
+		if hasStep {
+			end = b.max
+		}
+	}
+
+	var bits uint64
+	for i := start; i <= end; i += step {
+		bits |= 1 << i
+	}
+	return bits, nil
+}
+
+func parseValue(expr string, b bounds) (uint, error) {
+	value, err := strconv.ParseUint(expr, 10, 8)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value %q", expr)
+	}
+	if uint(value) < b.min || uint(value) > b.max {
+		return 0, fmt.Errorf("value %d out of range [%d-%d]", value, b.min, b.max)
+	}
+	return uint(value), nil
+}
+
+// Next returns the first activation of the cron expression which is strictly after t.
+// The activation is calculated in the location of t.
+// A zero time is returned if no activation can be found within the next five years.
+func (c *Cron) Next(t time.Time) time.Time {
+	loc := t.Location()
+
+	t = t.Truncate(time.Minute).Add(time.Minute)
+	limit := t.AddDate(5, 0, 0)
+
+	for t.Before(limit) {
+		if c.month&(1<<uint(t.Month())) == 0 {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, loc)
+			continue
+		}
+
+		if !c.dayMatches(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, loc)
+			continue
+		}
+
+		if c.hour&(1<<uint(t.Hour())) == 0 {
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, loc)
+			continue
+		}
+
+		if c.minute&(1<<uint(t.Minute())) == 0 {
+			t = t.Truncate(time.Minute).Add(time.Minute)
+			continue
+		}
+
+		return t
+	}
+
+	return time.Time{}
+}
+
+func (c *Cron) dayMatches(t time.Time) bool {
+	domMatch := c.dom&(1<<uint(t.Day())) != 0
+	dowMatch := c.dow&(1<<uint(t.Weekday())) != 0
+
+	if c.domRestricted && c.dowRestricted {
+		return domMatch || dowMatch
+	}
+	return domMatch && dowMatch
+}
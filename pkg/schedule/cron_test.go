@@ -0,0 +1,196 @@
+// SPDX-License-Identifier: MIT
+
+package schedule
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseCron(t *testing.T) {
+	tests := []struct {
+		name    string
+		spec    string
+		wantErr bool
+	}{
+		{
+			name: "every minute",
+			spec: "* * * * *",
+		},
+		{
+			name: "working days",
+			spec: "0 7 * * 1-5",
+		},
+		{
+			name: "lists and steps",
+			spec: "*/15 6,18 1-15/2 1,6 0",
+		},
+		{
+			name: "sunday as 7",
+			spec: "0 0 * * 7",
+		},
+		{
+			name:    "too few fields",
+			spec:    "0 7 * *",
+			wantErr: true,
+		},
+		{
+			name:    "value out of range",
+			spec:    "60 7 * * *",
+			wantErr: true,
+		},
+		{
+			name:    "invalid range",
+			spec:    "0 7 * * 5-1",
+			wantErr: true,
+		},
+		{
+			name:    "invalid step",
+			spec:    "*/0 7 * * *",
+			wantErr: true,
+		},
+		{
+			name:    "no number",
+			spec:    "0 7 * * mon",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseCron(tt.spec)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseCron() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCron_Next(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		spec string
+		from time.Time
+		want time.Time
+	}{
+		{
+			name: "next minute",
+			spec: "* * * * *",
+			from: time.Date(2024, 1, 1, 10, 0, 30, 0, time.UTC),
+			want: time.Date(2024, 1, 1, 10, 1, 0, 0, time.UTC),
+		},
+		{
+			name: "strictly after",
+			spec: "0 7 * * *",
+			from: time.Date(2024, 1, 1, 7, 0, 0, 0, time.UTC),
+			want: time.Date(2024, 1, 2, 7, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "working days skip weekend",
+			spec: "0 7 * * 1-5",
+			from: time.Date(2024, 1, 5, 8, 0, 0, 0, time.UTC), // friday
+			want: time.Date(2024, 1, 8, 7, 0, 0, 0, time.UTC), // monday
+		},
+		{
+			name: "step in minutes",
+			spec: "*/15 * * * *",
+			from: time.Date(2024, 1, 1, 10, 16, 0, 0, time.UTC),
+			want: time.Date(2024, 1, 1, 10, 30, 0, 0, time.UTC),
+		},
+		{
+			name: "day of month or day of week",
+			spec: "0 0 15 * 1",
+			from: time.Date(2024, 1, 9, 0, 0, 0, 0, time.UTC), // tuesday
+			want: time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "next year",
+			spec: "0 0 1 1 *",
+			from: time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC),
+			want: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "respects location",
+			spec: "0 7 * * *",
+			from: time.Date(2024, 1, 1, 8, 0, 0, 0, berlin),
+			want: time.Date(2024, 1, 2, 7, 0, 0, 0, berlin),
+		},
+		{
+			name: "never",
+			spec: "0 0 31 2 *",
+			from: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			want: time.Time{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := ParseCron(tt.spec)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := c.Next(tt.from); !got.Equal(tt.want) {
+				t.Errorf("Next() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWindow(t *testing.T) {
+	start, err := ParseCron("0 7 * * 1-5")
+	if err != nil {
+		t.Fatal(err)
+	}
+	window := Window{Start: start, Duration: 12 * time.Hour}
+
+	tests := []struct {
+		name           string
+		at             time.Time
+		wantActive     bool
+		wantTransition time.Time
+	}{
+		{
+			name:           "before window",
+			at:             time.Date(2024, 1, 8, 6, 0, 0, 0, time.UTC),
+			wantActive:     false,
+			wantTransition: time.Date(2024, 1, 8, 7, 0, 0, 0, time.UTC),
+		},
+		{
+			name:           "at window start",
+			at:             time.Date(2024, 1, 8, 7, 0, 0, 0, time.UTC),
+			wantActive:     true,
+			wantTransition: time.Date(2024, 1, 8, 19, 0, 0, 0, time.UTC),
+		},
+		{
+			name:           "within window",
+			at:             time.Date(2024, 1, 8, 12, 0, 0, 0, time.UTC),
+			wantActive:     true,
+			wantTransition: time.Date(2024, 1, 8, 19, 0, 0, 0, time.UTC),
+		},
+		{
+			name:           "at window end",
+			at:             time.Date(2024, 1, 8, 19, 0, 0, 0, time.UTC),
+			wantActive:     false,
+			wantTransition: time.Date(2024, 1, 9, 7, 0, 0, 0, time.UTC),
+		},
+		{
+			name:           "weekend",
+			at:             time.Date(2024, 1, 6, 12, 0, 0, 0, time.UTC),
+			wantActive:     false,
+			wantTransition: time.Date(2024, 1, 8, 7, 0, 0, 0, time.UTC),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, active := window.ActiveAt(tt.at); active != tt.wantActive {
+				t.Errorf("ActiveAt() = %v, want %v", active, tt.wantActive)
+			}
+			if got := window.NextTransition(tt.at); !got.Equal(tt.wantTransition) {
+				t.Errorf("NextTransition() = %v, want %v", got, tt.wantTransition)
+			}
+		})
+	}
+}
@@ -0,0 +1,37 @@
+// SPDX-License-Identifier: MIT
+
+package schedule
+
+import (
+	"time"
+)
+
+// Window is a recurring time window which starts at every activation
+// of a cron expression and stays active for the given duration.
+type Window struct {
+	Start    *Cron
+	Duration time.Duration
+}
+
+// ActiveAt returns the start time of the window occurrence which is active at t.
+// The returned bool is false if the window isn't active at t.
+func (w Window) ActiveAt(t time.Time) (time.Time, bool) {
+	start := w.Start.Next(t.Add(-w.Duration))
+	if start.IsZero() || start.After(t) {
+		return time.Time{}, false
+	}
+	return start, true
+}
+
+// NextTransition returns the next point in time after t at which the window either starts or ends.
+func (w Window) NextTransition(t time.Time) time.Time {
+	next := w.Start.Next(t)
+
+	if start, active := w.ActiveAt(t); active {
+		if end := start.Add(w.Duration); next.IsZero() || end.Before(next) {
+			return end
+		}
+	}
+
+	return next
+}
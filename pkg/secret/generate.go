@@ -0,0 +1,136 @@
+// SPDX-License-Identifier: MIT
+
+package secret
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	garmoperatorv1beta1 "github.com/mercedes-benz/garm-operator/api/v1beta1"
+	"github.com/mercedes-benz/garm-operator/pkg/annotations"
+	"github.com/mercedes-benz/garm-operator/pkg/client/key"
+)
+
+// generatedSecretLength is the number of random bytes of a generated secret
+const generatedSecretLength = 32
+
+// GeneratedSecret is the value of a secret which is generated by the operator.
+type GeneratedSecret struct {
+	Value string
+	// Rotated reports whether a new value has been generated.
+	Rotated bool
+	// RotateAfter is the time until the next scheduled rotation. It is zero if no rotation is scheduled.
+	RotateAfter time.Duration
+}
+
+// FetchWebhookSecret fetches the webhook secret of a GitHub scope. If spec enables the generation of the webhook secret,
+// it gets generated by EnsureGenerated and rotated on schedule or if the scope has the `rotate-webhook-secret` annotation.
+func FetchWebhookSecret(ctx context.Context, c client.Client, scope client.Object, ref *garmoperatorv1beta1.SecretRef, spec *garmoperatorv1beta1.WebhookSecretSpec) (GeneratedSecret, error) {
+	if spec == nil || !spec.Generate {
+		value, err := FetchRef(ctx, c, ref, scope.GetNamespace())
+		return GeneratedSecret{Value: value}, err
+	}
+
+	var rotationInterval time.Duration
+	if spec.RotationInterval != nil {
+		rotationInterval = spec.RotationInterval.Duration
+	}
+
+	return EnsureGenerated(ctx, c, scope, ref, rotationInterval, annotations.HasAnnotation(scope, key.RotateWebhookSecretAnnotation))
+}
+
+// EnsureGenerated returns the value of the secret referenced by ref, which is generated by the operator and controlled by owner.
+// The secret gets created with a random value if it doesn't exist. The value gets rotated if rotate is set or
+// the rotationInterval has passed since it has been generated. A rotationInterval of zero disables the scheduled rotation.
+func EnsureGenerated(ctx context.Context, c client.Client, owner client.Object, ref *garmoperatorv1beta1.SecretRef, rotationInterval time.Duration, rotate bool) (GeneratedSecret, error) {
+	secret := &corev1.Secret{}
+	err := c.Get(
+		ctx,
+		client.ObjectKey{
+			Name:      ref.Name,
+			Namespace: owner.GetNamespace(),
+		},
+		secret)
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			return GeneratedSecret{}, fmt.Errorf("error fetching secret %s/%s: %v", owner.GetNamespace(), ref.Name, err)
+		}
+
+		secret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      ref.Name,
+				Namespace: owner.GetNamespace(),
+			},
+		}
+		if err := controllerutil.SetControllerReference(owner, secret, c.Scheme()); err != nil {
+			return GeneratedSecret{}, err
+		}
+
+		value, err := generate(secret, ref.Key)
+		if err != nil {
+			return GeneratedSecret{}, err
+		}
+		if err := c.Create(ctx, secret); err != nil {
+			return GeneratedSecret{}, fmt.Errorf("error creating secret %s/%s: %v", owner.GetNamespace(), ref.Name, err)
+		}
+
+		return GeneratedSecret{Value: value, Rotated: true, RotateAfter: rotationInterval}, nil
+	}
+
+	if !metav1.IsControlledBy(secret, owner) {
+		return GeneratedSecret{}, fmt.Errorf("secret %s/%s is not generated by %s", owner.GetNamespace(), ref.Name, owner.GetName())
+	}
+
+	value, ok := secret.Data[ref.Key]
+	if ok && !rotate {
+		if rotationInterval == 0 {
+			return GeneratedSecret{Value: string(value)}, nil
+		}
+
+		// a missing or invalid timestamp results in an immediate rotation
+		generatedAt, _ := time.Parse(time.RFC3339, secret.Annotations[key.GeneratedAtAnnotation])
+		if rotateAfter := time.Until(generatedAt.Add(rotationInterval)); rotateAfter > 0 {
+			return GeneratedSecret{Value: string(value), RotateAfter: rotateAfter}, nil
+		}
+	}
+
+	newValue, err := generate(secret, ref.Key)
+	if err != nil {
+		return GeneratedSecret{}, err
+	}
+	if err := c.Update(ctx, secret); err != nil {
+		return GeneratedSecret{}, fmt.Errorf("error updating secret %s/%s: %v", owner.GetNamespace(), ref.Name, err)
+	}
+
+	return GeneratedSecret{Value: newValue, Rotated: true, RotateAfter: rotationInterval}, nil
+}
+
+// generate sets a new random value for dataKey in the secret and records the time it has been generated at.
+func generate(secret *corev1.Secret, dataKey string) (string, error) {
+	b := make([]byte, generatedSecretLength)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("error generating secret: %v", err)
+	}
+	value := hex.EncodeToString(b)
+
+	if secret.Data == nil {
+		secret.Data = map[string][]byte{}
+	}
+	secret.Data[dataKey] = []byte(value)
+
+	if secret.Annotations == nil {
+		secret.Annotations = map[string]string{}
+	}
+	secret.Annotations[key.GeneratedAtAnnotation] = time.Now().UTC().Format(time.RFC3339)
+
+	return value, nil
+}
@@ -0,0 +1,157 @@
+// SPDX-License-Identifier: MIT
+
+package secret
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	garmoperatorv1beta1 "github.com/mercedes-benz/garm-operator/api/v1beta1"
+	"github.com/mercedes-benz/garm-operator/pkg/client/key"
+)
+
+func newGeneratedSecret(t *testing.T, scheme *runtime.Scheme, owner client.Object, value string, generatedAt time.Time) *corev1.Secret {
+	t.Helper()
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "webhook-secret",
+			Namespace: owner.GetNamespace(),
+			Annotations: map[string]string{
+				key.GeneratedAtAnnotation: generatedAt.UTC().Format(time.RFC3339),
+			},
+		},
+		Data: map[string][]byte{
+			"webhookSecret": []byte(value),
+		},
+	}
+	require.NoError(t, controllerutil.SetControllerReference(owner, secret, scheme))
+	return secret
+}
+
+func TestEnsureGenerated(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	require.NoError(t, garmoperatorv1beta1.AddToScheme(scheme))
+
+	owner := &garmoperatorv1beta1.Organization{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-org",
+			Namespace: "default",
+			UID:       "a0f2c5a4-6bd4-4c69-8ab6-6a3a5d3b2a6e",
+		},
+	}
+	ref := &garmoperatorv1beta1.SecretRef{
+		Name: "webhook-secret",
+		Key:  "webhookSecret",
+	}
+
+	tests := []struct {
+		name             string
+		objects          []client.Object
+		rotationInterval time.Duration
+		rotate           bool
+		wantValue        string
+		wantRotated      bool
+		wantRotateAfter  time.Duration
+		wantErr          bool
+	}{
+		{
+			name:        "secret doesn't exist - generate",
+			wantRotated: true,
+		},
+		{
+			name:             "secret doesn't exist - generate and schedule rotation",
+			rotationInterval: 24 * time.Hour,
+			wantRotated:      true,
+			wantRotateAfter:  24 * time.Hour,
+		},
+		{
+			name: "secret exists - keep value",
+			objects: []client.Object{
+				newGeneratedSecret(t, scheme, owner, "foobar", time.Now().Add(-48*time.Hour)),
+			},
+			wantValue: "foobar",
+		},
+		{
+			name: "secret exists - keep value until rotation is due",
+			objects: []client.Object{
+				newGeneratedSecret(t, scheme, owner, "foobar", time.Now().Add(-1*time.Hour)),
+			},
+			rotationInterval: 24 * time.Hour,
+			wantValue:        "foobar",
+			wantRotateAfter:  23 * time.Hour,
+		},
+		{
+			name: "secret exists - rotate after interval",
+			objects: []client.Object{
+				newGeneratedSecret(t, scheme, owner, "foobar", time.Now().Add(-25*time.Hour)),
+			},
+			rotationInterval: 24 * time.Hour,
+			wantRotated:      true,
+			wantRotateAfter:  24 * time.Hour,
+		},
+		{
+			name: "secret exists - rotate on request",
+			objects: []client.Object{
+				newGeneratedSecret(t, scheme, owner, "foobar", time.Now()),
+			},
+			rotate:      true,
+			wantRotated: true,
+		},
+		{
+			name: "secret exists but isn't generated",
+			objects: []client.Object{
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "webhook-secret",
+						Namespace: "default",
+					},
+					Data: map[string][]byte{
+						"webhookSecret": []byte("foobar"),
+					},
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(tt.objects...).Build()
+
+			got, err := EnsureGenerated(context.Background(), c, owner, ref, tt.rotationInterval, tt.rotate)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.wantRotated, got.Rotated)
+			assert.InDelta(t, tt.wantRotateAfter.Seconds(), got.RotateAfter.Seconds(), 5)
+			if !tt.wantRotated {
+				assert.Equal(t, tt.wantValue, got.Value)
+				return
+			}
+
+			// a new value is generated and stored in the secret owned by the owner
+			assert.Len(t, got.Value, 2*generatedSecretLength)
+			assert.NotEqual(t, "foobar", got.Value)
+
+			secret := &corev1.Secret{}
+			require.NoError(t, c.Get(context.Background(), client.ObjectKey{Namespace: "default", Name: "webhook-secret"}, secret))
+			assert.Equal(t, got.Value, string(secret.Data["webhookSecret"]))
+			assert.True(t, metav1.IsControlledBy(secret, owner))
+			assert.Contains(t, secret.Annotations, key.GeneratedAtAnnotation)
+		})
+	}
+}
@@ -62,3 +62,20 @@ func getGithubDefaultTags(osArch providerParams.OSArch, osType providerParams.OS
 		ghOSType,
 	}, nil
 }
+
+// RemoveGithubDefaultTags removes the tags which github adds to all self hosted runners from the given tags
+func RemoveGithubDefaultTags(tags []string, osArch providerParams.OSArch, osType providerParams.OSType) ([]string, error) {
+	githubDefaultTags, err := getGithubDefaultTags(osArch, osType)
+	if err != nil {
+		return []string{}, err
+	}
+
+	result := []string{}
+	for _, tag := range tags {
+		if !slices.Contains(githubDefaultTags, tag) {
+			result = append(result, tag)
+		}
+	}
+
+	return result, nil
+}